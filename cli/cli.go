@@ -154,6 +154,14 @@ func ExecuteWith(ctx context.Context, ru *run.Run, args []string) error {
 			} else {
 				// It's just a normal command like "sq ls" or such.
 
+				if cmd.Flags().Lookup(flag.Arg) != nil {
+					// The command, e.g. "sq run", also supports
+					// the "--arg name value" mechanism.
+					if args, err = preprocessFlagArgVars(args); err != nil {
+						return err
+					}
+				}
+
 				// Explicitly set the args on rootCmd as this makes
 				// cobra happy when this func is executed via tests.
 				// Haven't explored the reason why.
@@ -202,6 +210,17 @@ func newCommandTree(ru *run.Run) (rootCmd *cobra.Command) {
 
 	addCmd(ru, rootCmd, slqCmd)
 
+	// The subcommands of slqCmd must not inherit its help func.
+	slqFmtCmd := addCmd(ru, slqCmd, newSLQFmtCmd())
+	slqFmtCmd.SetHelpFunc(rootCmd.HelpFunc())
+
+	addCmd(ru, rootCmd, newRunCmd())
+	queryCmd := addCmd(ru, rootCmd, newQueryCmd())
+	addCmd(ru, queryCmd, newQueryAddCmd())
+	addCmd(ru, queryCmd, newQueryListCmd())
+	addCmd(ru, queryCmd, newQueryShowCmd())
+	addCmd(ru, queryCmd, newQueryRemoveCmd())
+
 	addCmd(ru, rootCmd, newSrcAddCmd())
 	addCmd(ru, rootCmd, newSrcCommand())
 	addCmd(ru, rootCmd, newGroupCommand())
@@ -231,6 +250,7 @@ func newCommandTree(ru *run.Run) (rootCmd *cobra.Command) {
 	addCmd(ru, configCmd, newConfigLocationCmd())
 	addCmd(ru, configCmd, newConfigEditCmd())
 
+	addCmd(ru, rootCmd, newLSPCmd())
 	addCmd(ru, rootCmd, newCompletionCmd())
 	addCmd(ru, rootCmd, newVersionCmd())
 	addCmd(ru, rootCmd, newManCmd())
@@ -0,0 +1,452 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neilotoole/sq/cli/buildinfo"
+	"github.com/neilotoole/sq/cli/lsp"
+	"github.com/neilotoole/sq/cli/run"
+	"github.com/neilotoole/sq/libsq"
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/source"
+)
+
+// lspCmdShowSQL is the name of the LSP command that
+// returns the SQL generated for the query.
+const lspCmdShowSQL = "sq.showSQL"
+
+func newLSPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "Run SLQ language server",
+		Long: `Run a Language Server Protocol (LSP) server for SLQ, communicating via stdin
+and stdout. The server is intended to be launched by an editor, for files such
+as "query.slq". Each file is treated as a single SLQ query. The server provides:
+
+  - Diagnostics: syntax errors in the query.
+  - Completion of handles, tables, columns and functions.
+  - Hover docs: the type of a column, or the columns of a table.
+  - The "sq.showSQL" command, which returns the SQL generated for the
+    query, followed by the values of any bound parameters. The command's
+    argument is the URI of the document.
+
+Tables and columns are completed using the metadata of the sources
+in the sq config.`,
+		Args: cobra.NoArgs,
+		RunE: execLSP,
+		Example: `  # Typically the editor launches the server, but it can be run directly
+  $ sq lsp
+
+  # Use a particular config with the server
+  $ sq lsp --config ~/.config/sq/work.sq.yml`,
+	}
+
+	return cmd
+}
+
+func execLSP(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+	ru := run.FromContext(ctx)
+
+	srv := lsp.NewServer(lg.FromContext(ctx), "sq", buildinfo.Get().Version,
+		ru.Stdin, ru.Out, &slqLanguage{ru: ru})
+	return srv.Serve(ctx)
+}
+
+var _ lsp.Handler = (*slqLanguage)(nil)
+
+// slqLanguage implements lsp.Handler for SLQ.
+type slqLanguage struct {
+	ru *run.Run
+}
+
+// Diagnose implements lsp.Handler.
+func (l *slqLanguage) Diagnose(ctx context.Context, doc *lsp.Document) []lsp.Diagnostic {
+	if strings.TrimSpace(doc.Text) == "" {
+		return nil
+	}
+
+	_, err := ast.Parse(lg.FromContext(ctx), doc.Text)
+	if err == nil {
+		return nil
+	}
+
+	diag := lsp.Diagnostic{Severity: lsp.SeverityError, Source: "sq", Message: err.Error()}
+
+	var qErr *ast.QueryError
+	if errors.As(err, &qErr) {
+		diag.Code = qErr.Kind
+		diag.Message = qErr.Msg
+		if hint := qErr.Hint(); hint != "" {
+			diag.Message += ": " + hint
+		}
+
+		start := queryErrorOffset(doc.Text, qErr)
+		diag.Range = lsp.Range{
+			Start: doc.Position(start),
+			End:   doc.Position(start + len(qErr.Text)),
+		}
+	}
+
+	return []lsp.Diagnostic{diag}
+}
+
+// queryErrorOffset returns the byte offset in text of the
+// position (line and rune column) of qErr.
+func queryErrorOffset(text string, qErr *ast.QueryError) int {
+	offset := 0
+	for line := 1; line < qErr.Line; line++ {
+		i := strings.IndexByte(text[offset:], '\n')
+		if i < 0 {
+			return len(text)
+		}
+		offset += i + 1
+	}
+
+	col := 1
+	for i := range text[offset:] {
+		if col == qErr.Column {
+			return offset + i
+		}
+		col++
+	}
+	return len(text)
+}
+
+// Complete implements lsp.Handler. The completion candidates depend on
+// the word being typed: for "@sak", the handles and @HANDLE.TABLE; for
+// ".act", the tables of the active source, and the columns of the tables
+// in the query; for ".actor.fir", the columns of table "actor"; and
+// otherwise, the SLQ functions and keywords.
+func (l *slqLanguage) Complete(ctx context.Context, doc *lsp.Document, pos lsp.Position) []lsp.CompletionItem {
+	// As for shell completion, we don't want the user
+	// to wait forever for metadata.
+	ctx, cancelFn := context.WithTimeout(ctx, OptShellCompletionTimeout.Get(l.ru.Config.Options))
+	defer cancelFn()
+
+	offset := doc.Offset(pos)
+	start := lspWordStart(doc.Text, offset)
+	word := doc.Text[start:offset]
+	edit := lsp.Range{Start: doc.Position(start), End: pos}
+
+	var items []lsp.CompletionItem
+	add := func(label string, kind lsp.CompletionItemKind, detail string) {
+		items = append(items, lsp.CompletionItem{
+			Label:    label,
+			Kind:     kind,
+			Detail:   detail,
+			TextEdit: &lsp.TextEdit{Range: edit, NewText: label},
+		})
+	}
+
+	c := &handleTableCompleter{}
+	switch {
+	case word == "":
+		suggestions, _ := c.completeEither(ctx, l.ru, nil, word)
+		for _, s := range suggestions {
+			if strings.HasPrefix(s, "@") {
+				add(s, lsp.CompletionKindModule, "source")
+			} else {
+				add(s, lsp.CompletionKindStruct, "table")
+			}
+		}
+	case word[0] == '@':
+		suggestions, _ := c.completeHandle(ctx, l.ru, nil, word)
+		for _, s := range suggestions {
+			if strings.ContainsRune(s, '.') {
+				add(s, lsp.CompletionKindStruct, "table")
+			} else {
+				add(s, lsp.CompletionKindModule, "source")
+			}
+		}
+		return items
+	case word[0] == '.':
+		tblName, colPrefix, qualified := strings.Cut(word[1:], ".")
+		if !qualified {
+			colPrefix = tblName
+			suggestions, _ := c.completeTableOnly(ctx, l.ru, nil, word)
+			for _, s := range suggestions {
+				add(s, lsp.CompletionKindStruct, "table")
+			}
+		}
+
+		seen := map[string]struct{}{}
+		for _, ref := range l.tableRefs(ctx, doc.Text, offset) {
+			if qualified && tblName != ref.name && tblName != ref.alias {
+				continue
+			}
+
+			tblMeta, err := l.tableMetadata(ctx, ref.handle, ref.name)
+			if err != nil {
+				lg.FromContext(ctx).Warn("LSP: failed to get table metadata",
+					lga.Src, ref.handle, lga.Table, ref.name, lga.Err, err)
+				continue
+			}
+
+			for _, col := range tblMeta.Columns {
+				label := "." + col.Name
+				if qualified {
+					label = "." + tblName + label
+				}
+
+				if _, ok := seen[label]; ok || !strings.HasPrefix(col.Name, colPrefix) {
+					continue
+				}
+				seen[label] = struct{}{}
+				add(label, lsp.CompletionKindField, col.ColumnType+" ("+ref.handle+"."+ref.name+")")
+			}
+		}
+		return items
+	}
+
+	for _, kw := range ast.Keywords() {
+		if strings.HasPrefix(kw, word) {
+			add(kw, lsp.CompletionKindKeyword, "")
+		}
+	}
+	return items
+}
+
+// Hover implements lsp.Handler. For a handle, it returns the source's
+// driver and location; for a table, the table's columns; and for a
+// column, the column's type.
+func (l *slqLanguage) Hover(ctx context.Context, doc *lsp.Document, pos lsp.Position) *lsp.Hover {
+	ctx, cancelFn := context.WithTimeout(ctx, OptShellCompletionTimeout.Get(l.ru.Config.Options))
+	defer cancelFn()
+
+	offset := doc.Offset(pos)
+	start, end := lspWordStart(doc.Text, offset), lspWordEnd(doc.Text, offset)
+	word := doc.Text[start:end]
+	if len(word) < 2 {
+		return nil
+	}
+
+	var content string
+	if word[0] == '@' {
+		handle, tblName, _ := strings.Cut(word, ".")
+		if tblName == "" {
+			src, err := l.ru.Config.Collection.Get(handle)
+			if err != nil {
+				return nil
+			}
+			content = fmt.Sprintf("**%s**\n\nDriver: `%s`\n\nLocation: `%s`",
+				src.Handle, src.Type, src.RedactedLocation())
+		} else {
+			content = l.hoverTable(ctx, handle, tblName)
+		}
+	} else if word[0] == '.' {
+		content = l.hoverSelector(ctx, doc, offset, word)
+	}
+
+	if content == "" {
+		return nil
+	}
+
+	return &lsp.Hover{
+		Contents: lsp.MarkupContent{Kind: "markdown", Value: content},
+		Range:    &lsp.Range{Start: doc.Position(start), End: doc.Position(end)},
+	}
+}
+
+// hoverSelector returns the hover content for a selector, such as
+// ".actor", ".first_name" or ".actor.first_name".
+func (l *slqLanguage) hoverSelector(ctx context.Context, doc *lsp.Document, offset int, word string) string {
+	refs := l.tableRefs(ctx, doc.Text, offset)
+	tblName, colName, qualified := strings.Cut(word[1:], ".")
+	if !qualified {
+		colName = tblName
+		for _, ref := range refs {
+			if tblName == ref.name || tblName == ref.alias {
+				return l.hoverTable(ctx, ref.handle, ref.name)
+			}
+		}
+	}
+
+	for _, ref := range refs {
+		if qualified && tblName != ref.name && tblName != ref.alias {
+			continue
+		}
+
+		tblMeta, err := l.tableMetadata(ctx, ref.handle, ref.name)
+		if err != nil {
+			continue
+		}
+
+		for _, col := range tblMeta.Columns {
+			if col.Name != colName {
+				continue
+			}
+
+			var sb strings.Builder
+			fmt.Fprintf(&sb, "**%s** `%s`", col.Name, col.ColumnType)
+			if col.PrimaryKey {
+				sb.WriteString(" primary key")
+			}
+			if !col.Nullable {
+				sb.WriteString(" not null")
+			}
+			fmt.Fprintf(&sb, "\n\nColumn of `%s.%s`", ref.handle, ref.name)
+			if col.Comment != "" {
+				sb.WriteString("\n\n" + col.Comment)
+			}
+			return sb.String()
+		}
+	}
+
+	return ""
+}
+
+// hoverTable returns the hover content for the table, which lists
+// the table's columns.
+func (l *slqLanguage) hoverTable(ctx context.Context, handle, tblName string) string {
+	tblMeta, err := l.tableMetadata(ctx, handle, tblName)
+	if err != nil {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "**%s.%s** (%s, %d rows)\n", handle, tblMeta.Name, tblMeta.TableType, tblMeta.RowCount)
+	if tblMeta.Comment != "" {
+		sb.WriteString("\n" + tblMeta.Comment + "\n")
+	}
+	sb.WriteString("\n")
+	for _, col := range tblMeta.Columns {
+		fmt.Fprintf(&sb, "- %s `%s`\n", col.Name, col.ColumnType)
+	}
+	return sb.String()
+}
+
+// Commands implements lsp.Handler.
+func (l *slqLanguage) Commands() []string {
+	return []string{lspCmdShowSQL}
+}
+
+// ExecuteCommand implements lsp.Handler.
+func (l *slqLanguage) ExecuteCommand(ctx context.Context, command string, doc *lsp.Document) (any, error) {
+	if command != lspCmdShowSQL {
+		return nil, errz.Errorf("unknown command: %s", command)
+	}
+
+	query := strings.TrimSpace(doc.Text)
+	if query == "" {
+		return nil, errz.New(msgEmptyQueryString)
+	}
+
+	sql, sqlArgs, err := libsq.SLQ2SQL(ctx, run.NewQueryContext(l.ru, nil), query)
+	if err != nil {
+		return nil, err
+	}
+	return printableSQL(sql, sqlArgs), nil
+}
+
+// lspTableRef is a reference to a table in a query.
+type lspTableRef struct {
+	handle string
+	name   string
+	alias  string
+}
+
+// tableRefs returns the tables referenced by the query text. While the
+// user is typing, the query is typically not valid. In that case, the
+// query is truncated before the segment that contains offset (and then
+// before each preceding segment) until the remainder can be parsed.
+func (l *slqLanguage) tableRefs(ctx context.Context, text string, offset int) []lspTableRef {
+	log := lg.FromContext(ctx)
+
+	a, err := ast.Parse(log, text)
+	if err != nil {
+		text = text[:offset]
+	}
+	for err != nil {
+		i := strings.LastIndexByte(text, '|')
+		if i < 0 {
+			return nil
+		}
+		text = text[:i]
+		a, err = ast.Parse(log, text)
+	}
+
+	insp := ast.NewInspector(a)
+	defaultHandle := insp.FindFirstHandle()
+	if defaultHandle == "" {
+		defaultHandle = l.ru.Config.Collection.ActiveHandle()
+	}
+
+	var refs []lspTableRef
+	addRef := func(tblSel *ast.TblSelectorNode) {
+		ref := lspTableRef{handle: tblSel.Handle(), name: tblSel.TblName(), alias: tblSel.Alias()}
+		if ref.handle == "" {
+			ref.handle = defaultHandle
+		}
+		if ref.handle != "" && !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+
+	for _, node := range insp.FindNodes(reflect.TypeOf((*ast.TblSelectorNode)(nil))) {
+		addRef(node.(*ast.TblSelectorNode))
+	}
+
+	joins, err := insp.FindJoins()
+	if err != nil {
+		return refs
+	}
+	for _, join := range joins {
+		addRef(join.Table())
+	}
+
+	return refs
+}
+
+// tableMetadata returns the metadata for the table in the source
+// with handle.
+func (l *slqLanguage) tableMetadata(ctx context.Context, handle, tblName string) (*source.TableMetadata, error) {
+	src, err := l.ru.Config.Collection.Get(handle)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := l.ru.Databases.Open(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.TableMetadata(ctx, tblName)
+}
+
+// lspWordStart returns the offset in text of the start of the word
+// (e.g. "@sakila.actor" or ".first_name") that ends at offset.
+func lspWordStart(text string, offset int) int {
+	for offset > 0 && isLSPWordByte(text[offset-1]) {
+		offset--
+	}
+	return offset
+}
+
+// lspWordEnd returns the offset in text of the end of the word
+// that contains offset.
+func lspWordEnd(text string, offset int) int {
+	for offset < len(text) && isLSPWordByte(text[offset]) {
+		offset++
+	}
+	return offset
+}
+
+func isLSPWordByte(b byte) bool {
+	switch {
+	case b >= 'a' && b <= 'z', b >= 'A' && b <= 'Z', b >= '0' && b <= '9':
+		return true
+	default:
+		return b == '_' || b == '@' || b == '.' || b == '/'
+	}
+}
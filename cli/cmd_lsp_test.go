@@ -0,0 +1,109 @@
+package cli_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/testrun"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+)
+
+// TestCmdLSP tests "sq lsp", driving the server with a
+// sequence of LSP messages on stdin.
+func TestCmdLSP(t *testing.T) {
+	const uri = "file:///query.slq"
+
+	type message = map[string]any
+	textDoc := message{"uri": uri}
+	msgs := []message{
+		{"id": 1, "method": "initialize", "params": message{}},
+		{"method": "textDocument/didOpen", "params": message{
+			"textDocument": message{"uri": uri, "version": 1, "text": "@sakila_sl3 | .actor | wher(.actor_id > 1)"},
+		}},
+		{"method": "textDocument/didChange", "params": message{
+			"textDocument":   message{"uri": uri, "version": 2},
+			"contentChanges": []message{{"text": "@sakila_sl3 | .actor | .actor.fir"}},
+		}},
+		{"id": 2, "method": "textDocument/completion", "params": message{
+			"textDocument": textDoc, "position": message{"line": 0, "character": 33},
+		}},
+		{"method": "textDocument/didChange", "params": message{
+			"textDocument":   message{"uri": uri, "version": 3},
+			"contentChanges": []message{{"text": "@sakila_sl3 | .actor | where(.actor_id < 3) | .first_name"}},
+		}},
+		{"id": 3, "method": "textDocument/hover", "params": message{
+			"textDocument": textDoc, "position": message{"line": 0, "character": 50},
+		}},
+		{"id": 4, "method": "workspace/executeCommand", "params": message{
+			"command": "sq.showSQL", "arguments": []string{uri},
+		}},
+		{"id": 5, "method": "shutdown"},
+		{"method": "exit"},
+	}
+
+	fpath := filepath.Join(t.TempDir(), "lsp.in")
+	f, err := os.Create(fpath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+
+	for _, msg := range msgs {
+		msg["jsonrpc"] = "2.0"
+		b, err := json.Marshal(msg)
+		require.NoError(t, err)
+		_, err = fmt.Fprintf(f, "Content-Length: %d\r\n\r\n%s", len(b), b)
+		require.NoError(t, err)
+	}
+	_, err = f.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	th := testh.New(t)
+	tr := testrun.New(context.Background(), t, nil).Add(*th.Source(sakila.SL3))
+	tr.Run.Stdin = f
+	require.NoError(t, tr.Exec("lsp"))
+
+	var got []string
+	br := bufio.NewReader(tr.Out)
+	for {
+		header, err := textproto.NewReader(br).ReadMIMEHeader()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		length, err := strconv.Atoi(header.Get("Content-Length"))
+		require.NoError(t, err)
+		b := make([]byte, length)
+		_, err = io.ReadFull(br, b)
+		require.NoError(t, err)
+		got = append(got, string(b))
+	}
+
+	require.Len(t, got, 8)
+	require.Contains(t, got[0], `"executeCommandProvider":{"commands":["sq.showSQL"]}`)
+	require.Contains(t, got[1], `"code":"syntax error"`)
+	require.Contains(t, got[1], `did you mean \"where\"?`)
+	require.Contains(t, got[1], `"range":{"start":{"line":0,"character":23},"end":{"line":0,"character":27}}`)
+	require.Contains(t, got[2], `"diagnostics":[]`)
+	require.Contains(t, got[3], `"label":".actor.first_name"`)
+	require.Contains(t, got[3], `"detail":"VARCHAR(45) (@sakila_sl3.actor)"`)
+	require.Contains(t, got[5], "**first_name** `VARCHAR(45)`")
+
+	var resp struct {
+		Result string `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(got[6]), &resp))
+	require.Equal(t, `SELECT "first_name" FROM "actor" WHERE "actor_id" < 3`, resp.Result)
+
+	require.Contains(t, got[7], `"id":5`)
+}
@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/neilotoole/sq/cli/config"
+	"github.com/neilotoole/sq/cli/flag"
+	"github.com/neilotoole/sq/cli/run"
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+)
+
+func newQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Args:  cobra.NoArgs,
+		Short: "Manage saved queries",
+		Long: `Manage saved queries. A saved query is a named SLQ query, stored in config,
+that is executed via "sq run NAME". A saved query can have args, e.g.
+"$min_amount", whose values are supplied via --arg when the query is run.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		Example: `  # Save a query
+  $ sq query add top_customers '@sakila | .payment | .customer_id, sum(.amount):total | group_by(.customer_id) | where(sum(.amount) > $min_amount)'
+
+  # List saved queries
+  $ sq query ls
+
+  # Show a saved query
+  $ sq query show top_customers
+
+  # Run a saved query
+  $ sq run top_customers --arg min_amount 100
+
+  # Remove a saved query
+  $ sq query rm top_customers`,
+	}
+
+	return cmd
+}
+
+func newQueryAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add NAME QUERY",
+		Short: "Save a query",
+		Long: `Save a SLQ query as NAME. The query's args, e.g. "$min_amount", are
+recorded, and a value for each must be supplied via --arg when the query is
+run. If a query with NAME already exists, use --force to overwrite it.`,
+		Args: cobra.ExactArgs(2),
+		RunE: execQueryAdd,
+		Example: `  # Save a query
+  $ sq query add actors '@sakila | .actor | .first_name, .last_name'
+
+  # Save a query with an arg
+  $ sq query add big_payments '@sakila | .payment | where(.amount > $min_amount)'
+
+  # Overwrite an existing query
+  $ sq query add -f actors '@sakila | .actor'`,
+	}
+
+	addTextFlags(cmd)
+	cmd.Flags().BoolP(flag.JSON, flag.JSONShort, false, flag.JSONUsage)
+	cmd.Flags().BoolP(flag.Compact, flag.CompactShort, false, flag.CompactUsage)
+	cmd.Flags().BoolP(flag.YAML, flag.YAMLShort, false, flag.YAMLUsage)
+	cmd.Flags().BoolP(flag.QueryAddForce, flag.QueryAddForceShort, false, flag.QueryAddForceUsage)
+	return cmd
+}
+
+func execQueryAdd(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	ru := run.FromContext(ctx)
+	name, slq := args[0], strings.TrimSpace(args[1])
+
+	if _, ok := ru.Config.Queries[name]; ok && !cmdFlagChanged(cmd, flag.QueryAddForce) {
+		return errz.Errorf("query %s already exists: use --%s to overwrite", name, flag.QueryAddForce)
+	}
+
+	if slq == "" {
+		return errz.New(msgEmptyQueryString)
+	}
+
+	a, err := ast.Parse(lg.FromContext(ctx), slq)
+	if err != nil {
+		return err
+	}
+
+	q := &config.Query{SLQ: slq, Args: ast.NewInspector(a).FindArgs()}
+	if err = config.ValidQuery(name, q); err != nil {
+		return err
+	}
+
+	if ru.Config.Queries == nil {
+		ru.Config.Queries = map[string]*config.Query{}
+	}
+	ru.Config.Queries[name] = q
+
+	if err = ru.ConfigStore.Save(ctx, ru.Config); err != nil {
+		return err
+	}
+
+	return ru.Writers.Query.Query(name, q)
+}
+
+func newQueryListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "List saved queries",
+		Long:  "List saved queries. Use --verbose to also show each query's args.",
+		Args:  cobra.NoArgs,
+		RunE:  execQueryList,
+		Example: `  # List saved queries
+  $ sq query ls
+
+  # Also show args
+  $ sq query ls -v
+
+  # List saved queries in JSON
+  $ sq query ls -j`,
+	}
+
+	addTextFlags(cmd)
+	cmd.Flags().BoolP(flag.JSON, flag.JSONShort, false, flag.JSONUsage)
+	cmd.Flags().BoolP(flag.Compact, flag.CompactShort, false, flag.CompactUsage)
+	cmd.Flags().BoolP(flag.YAML, flag.YAMLShort, false, flag.YAMLUsage)
+	return cmd
+}
+
+func execQueryList(cmd *cobra.Command, _ []string) error {
+	ru := run.FromContext(cmd.Context())
+	return ru.Writers.Query.Queries(ru.Config.Queries)
+}
+
+func newQueryShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "show NAME",
+		Short:             "Show saved query",
+		Long:              "Show saved query. Use --verbose to also show the query's args.",
+		Args:              cobra.ExactArgs(1),
+		RunE:              execQueryShow,
+		ValidArgsFunction: completeSavedQuery(1),
+		Example: `  # Print the text of a saved query
+  $ sq query show top_customers
+
+  # Show the query with its args
+  $ sq query show -v top_customers`,
+	}
+
+	addTextFlags(cmd)
+	cmd.Flags().BoolP(flag.JSON, flag.JSONShort, false, flag.JSONUsage)
+	cmd.Flags().BoolP(flag.Compact, flag.CompactShort, false, flag.CompactUsage)
+	cmd.Flags().BoolP(flag.YAML, flag.YAMLShort, false, flag.YAMLUsage)
+	return cmd
+}
+
+func execQueryShow(cmd *cobra.Command, args []string) error {
+	ru := run.FromContext(cmd.Context())
+	q, err := getSavedQuery(ru.Config, args[0])
+	if err != nil {
+		return err
+	}
+
+	return ru.Writers.Query.Query(args[0], q)
+}
+
+func newQueryRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "rm NAME [NAME...]",
+		Short:             "Remove saved query",
+		Long:              "Remove one or more saved queries.",
+		Args:              cobra.MinimumNArgs(1),
+		RunE:              execQueryRemove,
+		ValidArgsFunction: completeSavedQuery(0),
+		Example: `  # Remove a saved query
+  $ sq query rm top_customers
+
+  # Remove multiple saved queries
+  $ sq query rm actors big_payments`,
+	}
+
+	addTextFlags(cmd)
+	cmd.Flags().BoolP(flag.JSON, flag.JSONShort, false, flag.JSONUsage)
+	cmd.Flags().BoolP(flag.Compact, flag.CompactShort, false, flag.CompactUsage)
+	cmd.Flags().BoolP(flag.YAML, flag.YAMLShort, false, flag.YAMLUsage)
+	return cmd
+}
+
+func execQueryRemove(cmd *cobra.Command, args []string) error {
+	ru := run.FromContext(cmd.Context())
+
+	names := lo.Uniq(args)
+	for _, name := range names {
+		if _, err := getSavedQuery(ru.Config, name); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range names {
+		delete(ru.Config.Queries, name)
+	}
+
+	if err := ru.ConfigStore.Save(cmd.Context(), ru.Config); err != nil {
+		return err
+	}
+
+	return ru.Writers.Query.Removed(names...)
+}
+
+// getSavedQuery returns the saved query with name from cfg. If there's
+// no such query, the returned error suggests the names of near matches.
+func getSavedQuery(cfg *config.Config, name string) (*config.Query, error) {
+	q, ok := cfg.Queries[name]
+	if ok && q != nil {
+		return q, nil
+	}
+
+	msg := "saved query not found: " + name
+	if suggestions := stringz.SuggestionsFor(name, lo.Keys(cfg.Queries)); len(suggestions) > 0 {
+		msg += ": did you mean " + strings.Join(stringz.SurroundSlice(suggestions, `"`), " or ") + "?"
+	}
+
+	return nil, errz.NotExist(errz.New(msg))
+}
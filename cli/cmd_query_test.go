@@ -0,0 +1,106 @@
+package cli_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/testrun"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+)
+
+// TestCmdQuery tests saved queries: "sq query add|ls|show|rm",
+// and "sq run".
+func TestCmdQuery(t *testing.T) {
+	const slq = `@sakila_sl3 | .actor | where(.actor_id > $min_id && .actor_id <= $max_id) | .actor_id`
+
+	th := testh.New(t)
+	src := th.Source(sakila.SL3)
+	ctx := context.Background()
+
+	tr := testrun.New(ctx, t, nil).Add(*src)
+	require.NoError(t, tr.Exec("query", "add", "actor_ids", slq, "-j"))
+	m := tr.BindMap()
+	require.Equal(t, "actor_ids", m["name"])
+	require.Equal(t, slq, m["slq"])
+	require.Equal(t, []any{"min_id", "max_id"}, m["args"])
+
+	tr = testrun.New(ctx, t, tr)
+	require.Error(t, tr.Exec("query", "add", "actor_ids", "@sakila_sl3 | .actor"),
+		"should fail because the query already exists")
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec("query", "add", "--force", "actor_ids", slq))
+
+	tr = testrun.New(ctx, t, tr)
+	require.Error(t, tr.Exec("query", "add", "bad", "@sakila_sl3 | .actor | where("))
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec("query", "add", "actors", "@sakila_sl3 | .actor | count"))
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec("query", "ls", "-j"))
+	a := tr.BindSliceMap()
+	require.Len(t, a, 2)
+	require.Equal(t, "actor_ids", a[0]["name"])
+	require.Equal(t, "actors", a[1]["name"])
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec("query", "show", "actor_ids"))
+	require.Equal(t, slq+"\n", tr.Out.String())
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec("run", "actor_ids", "--arg", "min_id", "2", "--arg", "max_id", "4", "--csv", "-H"))
+	require.Equal(t, [][]string{{"3"}, {"4"}}, tr.BindCSV())
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec("run", "actors", "--csv", "-H"))
+	require.Equal(t, [][]string{{"200"}}, tr.BindCSV())
+
+	for _, args := range [][]string{
+		{"run", "actor_ids", "--arg", "min_id", "2"},                                            // missing arg
+		{"run", "actor_ids", "--arg", "min_id", "2", "--arg", "max_id", "4", "--arg", "x", "1"}, // unknown arg
+		{"run", "actors", "--arg", "x", "1"},                                                    // unknown arg
+		{"run", "actor_idz"},                                                                    // unknown query
+		{"query", "show", "actor_idz"},
+		{"query", "rm", "actors", "actor_idz"},
+	} {
+		tr = testrun.New(ctx, t, tr)
+		require.Error(t, tr.Exec(args...), args)
+	}
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec("query", "rm", "actors", "actor_ids"))
+	require.Empty(t, tr.Run.Config.Queries)
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec("query", "ls", "-j"))
+	require.Empty(t, tr.BindSliceMap())
+}
+
+// TestCmdQuery_complete tests shell completion of saved
+// query names, and of the keys of --arg for "sq run".
+func TestCmdQuery_complete(t *testing.T) {
+	ctx := context.Background()
+	tr := testrun.New(ctx, t, nil)
+	require.NoError(t, tr.Exec("query", "add", "payments", ".payment | where(.amount > $min && .amount < $max)"))
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec("query", "add", "actors", ".actor"))
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec(cobra.ShellCompRequestCmd, "run", ""))
+	require.True(t, strings.HasPrefix(tr.Out.String(), "actors\npayments\n:"), tr.Out.String())
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec(cobra.ShellCompRequestCmd, "query", "show", "pay"))
+	require.True(t, strings.HasPrefix(tr.Out.String(), "payments\n:"), tr.Out.String())
+
+	tr = testrun.New(ctx, t, tr)
+	require.NoError(t, tr.Exec(cobra.ShellCompRequestCmd, "run", "payments", "--arg", ""))
+	require.True(t, strings.HasPrefix(tr.Out.String(), "min\nmax\n:"), tr.Out.String())
+}
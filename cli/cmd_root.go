@@ -69,6 +69,12 @@ See docs and more: https://sq.io`,
   # Insert query results into a table in another data source.
   $ sq --insert=@pg1.person '@my1.person | .username, .email'
 
+  # Show the database's query plan, instead of executing the query.
+  $ sq --explain '@sakila_pg.actor | where(.actor_id > 100)'
+
+  # Print the SQL for Postgres, without connecting to any source.
+  $ sq --print-sql --dialect postgres '.actor | where(.actor_id > 100)'
+
   # Execute a database-native SQL query, specifying the source.
   $ sq sql --src=@pg1 'SELECT uid, username, email FROM person LIMIT 2'
 
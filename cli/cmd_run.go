@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+
+	"github.com/neilotoole/sq/cli/config"
+	"github.com/neilotoole/sq/cli/flag"
+	"github.com/neilotoole/sq/cli/run"
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+func newRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run NAME [--arg KEY VALUE]...",
+		Short: "Execute saved query",
+		Long: `Execute the saved query NAME. A value must be supplied via --arg for each
+of the query's args, and only for those args. Otherwise, the query is
+executed just like "sq QUERY", and the same output flags apply.
+
+Use "sq query" to manage saved queries.`,
+		Args:              cobra.ExactArgs(1),
+		RunE:              execRun,
+		ValidArgsFunction: completeSavedQuery(1),
+		Example: `  # Execute saved query "actors"
+  $ sq run actors
+
+  # Supply a value for the query's "min_amount" arg
+  $ sq run top_customers --arg min_amount 100
+
+  # Output JSON
+  $ sq run top_customers --arg min_amount 100 -j
+
+  # Insert the results into a table
+  $ sq run actors --insert @sakila_pg.actor_copy`,
+	}
+
+	addQueryCmdFlags(cmd)
+	addPrintSQLFlags(cmd)
+
+	cmd.Flags().StringArray(flag.Arg, nil, flag.ArgUsage)
+	panicOn(cmd.RegisterFlagCompletionFunc(flag.Arg, completeSavedQueryArg))
+
+	return cmd
+}
+
+func execRun(cmd *cobra.Command, args []string) error {
+	ru := run.FromContext(cmd.Context())
+
+	name := args[0]
+	q, err := getSavedQuery(ru.Config, name)
+	if err != nil {
+		return err
+	}
+
+	mArgs, err := extractFlagArgsValues(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err = checkSavedQueryArgs(name, q, mArgs); err != nil {
+		return err
+	}
+
+	return execQuery(cmd, []string{q.SLQ}, mArgs)
+}
+
+// checkSavedQueryArgs returns an error if mArgs, the --arg values,
+// doesn't hold a value for each of the args of saved query q, or
+// if it holds a value for an arg that q doesn't have.
+func checkSavedQueryArgs(name string, q *config.Query, mArgs map[string]string) error {
+	var missing []string
+	for _, arg := range q.Args {
+		if _, ok := mArgs[arg]; !ok {
+			missing = append(missing, arg)
+		}
+	}
+
+	if len(missing) > 0 {
+		return errz.Errorf("query %s: missing --%s value for: %s",
+			name, flag.Arg, strings.Join(missing, ", "))
+	}
+
+	unknown, _ := lo.Difference(lo.Keys(mArgs), q.Args)
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		if len(q.Args) == 0 {
+			return errz.Errorf("query %s: has no args, but got --%s: %s",
+				name, flag.Arg, strings.Join(unknown, ", "))
+		}
+
+		return errz.Errorf("query %s: unknown --%s: %s: expected: %s",
+			name, flag.Arg, strings.Join(unknown, ", "), strings.Join(q.Args, ", "))
+	}
+
+	return nil
+}
@@ -40,6 +40,7 @@ func newSLQCmd() *cobra.Command {
 	}
 
 	addQueryCmdFlags(cmd)
+	addPrintSQLFlags(cmd)
 
 	cmd.Flags().StringArray(flag.Arg, nil, flag.ArgUsage)
 
@@ -61,10 +62,28 @@ func execSLQ(cmd *cobra.Command, args []string) error {
 		return errz.New(msg)
 	}
 
+	mArgs, err := extractFlagArgsValues(cmd)
+	if err != nil {
+		return err
+	}
+
+	return execQuery(cmd, args, mArgs)
+}
+
+// execQuery executes the SLQ query in args, with the --arg values
+// in mArgs. The results are printed, or, if flag --insert is set,
+// inserted into the destination table.
+func execQuery(cmd *cobra.Command, args []string, mArgs map[string]string) error {
 	ctx := cmd.Context()
 	ru := run.FromContext(ctx)
 	coll := ru.Config.Collection
 
+	if cmdFlagChanged(cmd, flag.Dialect) {
+		// The SQL is rendered offline, so there's no
+		// need to look at stdin or the sources.
+		return execSLQTranspile(cmd, ru, args, mArgs)
+	}
+
 	// check if there's input on stdin
 	src, err := checkStdinSource(ctx, ru)
 	if err != nil {
@@ -100,19 +119,27 @@ func execSLQ(cmd *cobra.Command, args []string) error {
 		// active source, so we allow progress to continue.
 	}
 
-	mArgs, err := extractFlagArgsValues(cmd)
-	if err != nil {
+	if err = applyCollectionOptions(cmd, coll); err != nil {
 		return err
 	}
 
-	if err = applyCollectionOptions(cmd, coll); err != nil {
+	slq, err := preprocessUserSLQ(ctx, ru, args)
+	if err != nil {
 		return err
 	}
 
+	if cmdFlagIsSetTrue(cmd, flag.Explain) {
+		return execSLQExplain(ctx, ru, slq, mArgs)
+	}
+
+	if cmdFlagIsSetTrue(cmd, flag.PrintSQL) {
+		return execSLQPrintSQL(ctx, ru, slq, mArgs)
+	}
+
 	if !cmdFlagChanged(cmd, flag.Insert) {
 		// The user didn't specify the --insert=@src.tbl flag,
 		// so we just want to print the records.
-		return execSLQPrint(ctx, ru, mArgs)
+		return execSLQPrint(ctx, ru, slq, mArgs)
 	}
 
 	// Instead of printing the records, they will be
@@ -136,21 +163,16 @@ func execSLQ(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	return execSLQInsert(ctx, ru, mArgs, destSrc, destTbl)
+	return execSLQInsert(ctx, ru, slq, mArgs, destSrc, destTbl)
 }
 
 // execSQLInsert executes the SLQ and inserts resulting records
 // into destTbl in destSrc.
-func execSLQInsert(ctx context.Context, ru *run.Run, mArgs map[string]string,
+func execSLQInsert(ctx context.Context, ru *run.Run, slq string, mArgs map[string]string,
 	destSrc *source.Source, destTbl string,
 ) error {
 	qc := run.NewQueryContext(ru, mArgs)
 
-	slq, err := preprocessUserSLQ(ctx, ru, ru.Args)
-	if err != nil {
-		return err
-	}
-
 	ctx, cancelFn := context.WithCancel(ctx)
 	defer cancelFn()
 
@@ -186,16 +208,26 @@ func execSLQInsert(ctx context.Context, ru *run.Run, mArgs map[string]string,
 }
 
 // execSLQPrint executes the SLQ query, and prints output to writer.
-func execSLQPrint(ctx context.Context, ru *run.Run, mArgs map[string]string) error {
+func execSLQPrint(ctx context.Context, ru *run.Run, slq string, mArgs map[string]string) error {
 	qc := run.NewQueryContext(ru, mArgs)
 
-	slq, err := preprocessUserSLQ(ctx, ru, ru.Args)
-	if err != nil {
-		return err
+	recw := output.NewRecordWriterAdapter(ctx, ru.Writers.Record)
+	execErr := libsq.ExecuteSLQ(ctx, qc, slq, recw)
+	_, waitErr := recw.Wait()
+	if execErr != nil {
+		return execErr
 	}
 
+	return waitErr
+}
+
+// execSLQExplain prints the DB's execution plan for the SLQ
+// query to writer, instead of executing the query.
+func execSLQExplain(ctx context.Context, ru *run.Run, slq string, mArgs map[string]string) error {
+	qc := run.NewQueryContext(ru, mArgs)
+
 	recw := output.NewRecordWriterAdapter(ctx, ru.Writers.Record)
-	execErr := libsq.ExecuteSLQ(ctx, qc, slq, recw)
+	execErr := libsq.ExplainSLQ(ctx, qc, slq, recw)
 	_, waitErr := recw.Wait()
 	if execErr != nil {
 		return execErr
@@ -204,6 +236,77 @@ func execSLQPrint(ctx context.Context, ru *run.Run, mArgs map[string]string) err
 	return waitErr
 }
 
+// execSLQPrintSQL prints the SQL rendered from the SLQ query, instead
+// of executing the query. The SQL is that which would be executed
+// against the query's target DB, and thus may contain bound parameter
+// placeholders, whose values are printed after it: see printableSQL.
+func execSLQPrintSQL(ctx context.Context, ru *run.Run, slq string, mArgs map[string]string) error {
+	qc := run.NewQueryContext(ru, mArgs)
+
+	sql, sqlArgs, err := libsq.SLQ2SQL(ctx, qc, slq)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(ru.Out, printableSQL(sql, sqlArgs))
+	return errz.Err(err)
+}
+
+// printableSQL returns sql followed, if sqlArgs is non-empty, by a SQL
+// comment line listing the values of sql's bound parameter placeholders,
+// in order. For example:
+//
+//	SELECT "film_id" FROM "film" WHERE "rating" = $1
+//	-- args: 'R'
+func printableSQL(sql string, sqlArgs []any) string {
+	if len(sqlArgs) == 0 {
+		return sql
+	}
+
+	vals := make([]string, len(sqlArgs))
+	for i, arg := range sqlArgs {
+		if s, ok := arg.(string); ok {
+			vals[i] = stringz.SingleQuote(s)
+			continue
+		}
+		vals[i] = fmt.Sprint(arg)
+	}
+
+	return sql + "\n-- args: " + strings.Join(vals, ", ")
+}
+
+// execSLQTranspile prints the SQL rendered from the SLQ query in args
+// for the driver specified by flag --dialect, without opening any source.
+func execSLQTranspile(cmd *cobra.Command, ru *run.Run, args []string, mArgs map[string]string) error {
+	if !cmdFlagIsSetTrue(cmd, flag.PrintSQL) {
+		return errz.Errorf("flag --%s requires --%s", flag.Dialect, flag.PrintSQL)
+	}
+
+	typ, _ := cmd.Flags().GetString(flag.Dialect)
+	drvr, err := ru.DriverRegistry.DriverFor(source.DriverType(strings.TrimSpace(typ)))
+	if err != nil {
+		return errz.Wrapf(err, "invalid --%s value", flag.Dialect)
+	}
+
+	sqlDrvr, ok := drvr.(driver.SQLDriver)
+	if !ok || !drvr.DriverMetadata().IsSQL {
+		return errz.Errorf("invalid --%s value: driver {%s} is not a SQL driver", flag.Dialect, typ)
+	}
+
+	slq := strings.TrimSpace(strings.Join(args, " "))
+	if slq == "" {
+		return errz.New(msgEmptyQueryString)
+	}
+
+	sql, err := libsq.TranspileSLQ(cmd.Context(), sqlDrvr, slq, mArgs)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(ru.Out, sql)
+	return errz.Err(err)
+}
+
 // preprocessUserSLQ does a bit of validation and munging on the
 // SLQ input (provided in args), returning the SLQ query. This
 // function is something of a hangover from the early days of
@@ -305,13 +408,9 @@ func preprocessUserSLQ(ctx context.Context, ru *run.Run, args []string) (string,
 			return "", errz.Errorf("invalid data source: %s", handle)
 		}
 
-		// Check that the handle actual exists
-		_, err := coll.Get(handle)
-		if err != nil {
-			return "", err
-		}
-
-		// All is good, return the query.
+		// Note that the existence of the handle is checked when
+		// the query is executed, which reports near matches for
+		// an unknown handle.
 		query := strings.Join(args, " ")
 		return query, nil
 	}
@@ -348,6 +447,9 @@ func addQueryCmdFlags(cmd *cobra.Command) {
 	panicOn(cmd.RegisterFlagCompletionFunc(flag.Insert,
 		(&handleTableCompleter{onlySQL: true, handleRequired: true}).complete))
 
+	cmd.Flags().Bool(flag.Explain, false, flag.ExplainUsage)
+	cmd.MarkFlagsMutuallyExclusive(flag.Explain, flag.Insert)
+
 	cmd.Flags().String(flag.ActiveSrc, "", flag.ActiveSrcUsage)
 	panicOn(cmd.RegisterFlagCompletionFunc(flag.ActiveSrc, completeHandle(0)))
 
@@ -361,6 +463,16 @@ func addQueryCmdFlags(cmd *cobra.Command) {
 	panicOn(cmd.RegisterFlagCompletionFunc(flag.CSVDelim, completeStrings(-1, csv.NamedDelims()...)))
 }
 
+// addPrintSQLFlags adds the --print-sql and --dialect flags to cmd,
+// which must be a command that executes a SLQ query.
+func addPrintSQLFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flag.PrintSQL, false, flag.PrintSQLUsage)
+	cmd.MarkFlagsMutuallyExclusive(flag.PrintSQL, flag.Explain, flag.Insert)
+
+	cmd.Flags().String(flag.Dialect, "", flag.DialectUsage)
+	panicOn(cmd.RegisterFlagCompletionFunc(flag.Dialect, completeSQLDriverType))
+}
+
 // addResultFormatFlags adds the individual flags that control result
 // output format, e.g. --text, --json, --csv, etc. It does not add
 // the --format flag, because not every command treats that flag the same.
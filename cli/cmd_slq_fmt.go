@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neilotoole/sq/cli/flag"
+	"github.com/neilotoole/sq/cli/run"
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+)
+
+func newSLQFmtCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fmt [QUERY]",
+		Short: "Format SLQ query",
+		Long: `Format SLQ query. The query is printed in canonical form, with consistent
+spacing and quoting. If the query is too wide, each segment is printed on its
+own line. If QUERY is not supplied, the query is read from stdin.
+
+With --check, the formatted query is not printed. Instead, an error is returned
+if the query isn't already in canonical form. This is useful in CI.
+
+Note that comments are not preserved.`,
+		RunE: execSLQFmt,
+		Example: `  # Format a query
+  $ sq slq fmt '@sakila|.actor|where(.actor_id>10)|.first_name'
+  @sakila | .actor | where(.actor_id > 10) | .first_name
+
+  # Format a query from a file
+  $ sq slq fmt < query.slq
+
+  # Fail if the query isn't formatted
+  $ sq slq fmt --check < query.slq`,
+	}
+
+	cmd.Flags().Bool(flag.FmtCheck, false, flag.FmtCheckUsage)
+	return cmd
+}
+
+func execSLQFmt(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	ru := run.FromContext(ctx)
+
+	var input string
+	if len(args) > 0 {
+		input = strings.Join(args, " ")
+	} else {
+		if isTerminal(ru.Stdin) {
+			return errz.New("no query: supply QUERY arg, or pipe the query to stdin")
+		}
+
+		b, err := io.ReadAll(ru.Stdin)
+		if err != nil {
+			return errz.Wrap(err, "failed to read query from stdin")
+		}
+		input = string(b)
+	}
+
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return errz.New(msgEmptyQueryString)
+	}
+
+	a, err := ast.Parse(lg.FromContext(ctx), input)
+	if err != nil {
+		return err
+	}
+
+	formatted := ast.Format(a)
+	if check, _ := cmd.Flags().GetBool(flag.FmtCheck); check {
+		if formatted != input {
+			return errz.New("query is not formatted: format it using \"sq slq fmt\"")
+		}
+		return nil
+	}
+
+	_, err = fmt.Fprintln(ru.Out, formatted)
+	return errz.Err(err)
+}
@@ -0,0 +1,49 @@
+package cli_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/testrun"
+)
+
+// TestCmdSLQFmt tests "sq slq fmt QUERY".
+func TestCmdSLQFmt(t *testing.T) {
+	const (
+		query = `@sakila|.actor|where(.actor_id>10)|.first_name:"given name"`
+		want  = `@sakila | .actor | where(.actor_id > 10) | .first_name:"given name"`
+	)
+
+	tr := testrun.New(context.Background(), t, nil)
+	require.NoError(t, tr.Exec("slq", "fmt", query))
+	require.Equal(t, want+"\n", tr.Out.String())
+
+	tr = testrun.New(context.Background(), t, nil)
+	require.NoError(t, tr.Exec("slq", "fmt", "--check", want))
+	require.Empty(t, tr.Out.String())
+
+	tr = testrun.New(context.Background(), t, nil)
+	require.Error(t, tr.Exec("slq", "fmt", "--check", query))
+
+	tr = testrun.New(context.Background(), t, nil)
+	require.Error(t, tr.Exec("slq", "fmt", ".actor | where("))
+}
+
+// TestCmdSLQFmt_Stdin tests "sq slq fmt < query.slq".
+func TestCmdSLQFmt_Stdin(t *testing.T) {
+	fpath := filepath.Join(t.TempDir(), "query.slq")
+	require.NoError(t, os.WriteFile(fpath, []byte("@sakila|.actor|count\n"), 0o600))
+
+	f, err := os.Open(fpath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+
+	tr := testrun.New(context.Background(), t, nil)
+	tr.Run.Stdin = f
+	require.NoError(t, tr.Exec("slq", "fmt"))
+	require.Equal(t, "@sakila | .actor | count\n", tr.Out.String())
+}
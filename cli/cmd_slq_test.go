@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/neilotoole/sq/libsq/core/stringz"
+	"github.com/neilotoole/sq/libsq/driver"
 	"github.com/neilotoole/sq/libsq/source"
 	"github.com/neilotoole/sq/testh"
 	"github.com/neilotoole/sq/testh/sakila"
@@ -131,6 +132,97 @@ func TestCmdSLQ_OutputFlag(t *testing.T) {
 	require.Equal(t, sakila.TblActorCount, len(recs))
 }
 
+// TestCmdSLQ_Explain verifies that flag --explain outputs the query
+// plan instead of the query results.
+func TestCmdSLQ_Explain(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Source(sakila.SL3)
+	tr := testrun.New(th.Context, t, nil).Add(*src)
+	err := tr.Exec("slq", "--explain", "--json", src.Handle+".actor | where(.actor_id > 100)")
+	require.NoError(t, err)
+
+	recs := tr.BindSliceMap()
+	require.Len(t, recs, 1)
+	require.Equal(t, float64(1), recs[0]["step"])
+	require.Equal(t, src.Handle, recs[0]["source"])
+	require.Contains(t, recs[0]["sql"], "actor_id")
+	require.Nil(t, recs[0]["copy_to"])
+	require.Contains(t, recs[0]["plan"], "QUERY PLAN")
+
+	tr = testrun.New(th.Context, t, nil).Add(*src)
+	err = tr.Exec("slq", "--explain", "--insert", src.Handle+".actor_copy", src.Handle+".actor")
+	require.Error(t, err, "--explain and --insert are mutually exclusive")
+}
+
+// TestCmdSLQ_Explain_CrossSource verifies that --explain describes
+// the copy steps of a cross-source join, but doesn't execute them:
+// with join.strategy "auto", no table is copied into the SQL source.
+func TestCmdSLQ_Explain_CrossSource(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src, csvSrc := th.Source(sakila.SL3), th.Source(sakila.CSVActor)
+	md, err := th.SourceMetadata(src)
+	require.NoError(t, err)
+	wantTbls := md.TableNames()
+
+	tr := testrun.New(th.Context, t, nil).Add(*src, *csvSrc)
+	require.NoError(t, tr.Exec("config", "set", driver.OptJoinStrategy.Key(), driver.JoinStrategyAuto))
+
+	tr = testrun.New(th.Context, t, tr)
+	err = tr.Exec("slq", "--explain", "--json",
+		src.Handle+".actor | join("+csvSrc.Handle+".data, .actor.actor_id == .data.actor_id)")
+	require.NoError(t, err)
+
+	recs := tr.BindSliceMap()
+	require.Len(t, recs, 2)
+	require.Equal(t, csvSrc.Handle, recs[0]["source"])
+	require.Contains(t, recs[0]["copy_to"], src.Handle+".data")
+	require.NotNil(t, recs[0]["plan"])
+	require.Equal(t, src.Handle, recs[1]["source"])
+	require.Nil(t, recs[1]["copy_to"])
+	require.Nil(t, recs[1]["plan"])
+
+	md, err = th.SourceMetadata(src)
+	require.NoError(t, err)
+	require.Equal(t, wantTbls, md.TableNames())
+}
+
+// TestCmdSLQ_PrintSQL verifies that flag --print-sql outputs the SQL
+// rendered from the query, and that with flag --dialect, the SQL is
+// rendered without the query's source being configured.
+func TestCmdSLQ_PrintSQL(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Source(sakila.SL3)
+	tr := testrun.New(th.Context, t, nil).Add(*src)
+	err := tr.Exec("slq", "--print-sql", src.Handle+".actor | .actor_id")
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "actor_id" FROM "actor"`+"\n", tr.Out.String())
+
+	// The values of any bound parameters are printed after the SQL.
+	tr = testrun.New(th.Context, t, nil).Add(*src)
+	err = tr.Exec("slq", "--print-sql", src.Handle+`.actor | where(.first_name == "TOM") | .actor_id`)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT "actor_id" FROM "actor" WHERE "first_name" = ?`+"\n-- args: 'TOM'\n", tr.Out.String())
+
+	tr = testrun.New(th.Context, t, nil)
+	err = tr.Exec("slq", "--print-sql", "--dialect", "mysql", "@not_configured.actor | .actor_id")
+	require.NoError(t, err)
+	require.Equal(t, "SELECT `actor_id` FROM `actor`\n", tr.Out.String())
+
+	tr = testrun.New(th.Context, t, nil)
+	err = tr.Exec("slq", "--dialect", "mysql", ".actor")
+	require.Error(t, err, "--dialect requires --print-sql")
+
+	tr = testrun.New(th.Context, t, nil)
+	err = tr.Exec("slq", "--print-sql", "--dialect", "csv", ".actor")
+	require.Error(t, err, "csv is not a SQL driver")
+}
+
 func TestCmdSLQ_Join_cross_source(t *testing.T) {
 	const queryTpl = `%s.customer | join(%s.address, .address_id) | where(.customer_id == %d) | .[0] | .customer_id, .email, .city_id` //nolint:lll
 	handles := sakila.SQLLatest()
@@ -48,7 +48,10 @@ flag is set, sq attempts to determine the appropriate mode.`,
   $ sq sql --exec --src=@sakila_pg12 'DROP TABLE actor'
 
   # Select from active source and write results to @sakila_ms17.actor
-  $ sq sql 'SELECT * FROM actor' --insert=@sakila_ms17.actor`,
+  $ sq sql 'SELECT * FROM actor' --insert=@sakila_ms17.actor
+
+  # Show the DB's query plan, instead of executing the query
+  $ sq sql --explain 'SELECT * FROM actor WHERE actor_id > 100'`,
 	}
 
 	addQueryCmdFlags(cmd)
@@ -57,6 +60,7 @@ flag is set, sq attempts to determine the appropriate mode.`,
 	cmd.Flags().Bool(flag.SQLQuery, false, flag.SQLQueryUsage)
 	// User explicitly wants to execute the SQL using sql.DB.Exec
 	cmd.Flags().Bool(flag.SQLExec, false, flag.SQLExecUsage)
+	cmd.MarkFlagsMutuallyExclusive(flag.Explain, flag.SQLExec)
 
 	return cmd
 }
@@ -88,6 +92,10 @@ func execSQL(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if cmdFlagIsSetTrue(cmd, flag.Explain) {
+		return execSQLExplain(cmd.Context(), ru, activeSrc)
+	}
+
 	if !cmdFlagChanged(cmd, flag.Insert) {
 		// The user didn't specify the --insert=@src.tbl flag,
 		// so we just want to print the records.
@@ -132,6 +140,23 @@ func execSQLPrint(ctx context.Context, ru *run.Run, fromSrc *source.Source) erro
 	return err
 }
 
+// execSQLExplain prints the DB's execution plan for
+// the SQL to the configured writer.
+func execSQLExplain(ctx context.Context, ru *run.Run, fromSrc *source.Source) error {
+	dbase, err := ru.Databases.Open(ctx, fromSrc)
+	if err != nil {
+		return err
+	}
+
+	recw := output.NewRecordWriterAdapter(ctx, ru.Writers.Record)
+	err = libsq.ExplainSQL(ctx, dbase, recw, ru.Args[0])
+	if err != nil {
+		return err
+	}
+	_, err = recw.Wait() // Wait for the writer to finish processing
+	return err
+}
+
 // execSQLInsert executes the SQL and inserts resulting records
 // into destTbl in destSrc.
 func execSQLInsert(ctx context.Context, ru *run.Run,
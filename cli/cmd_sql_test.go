@@ -190,3 +190,24 @@ func TestCmdSQL_StdinQuery(t *testing.T) {
 		})
 	}
 }
+
+// TestCmdSQL_Explain tests "sq sql --explain QUERY".
+func TestCmdSQL_Explain(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Source(sakila.SL3)
+	tr := testrun.New(th.Context, t, nil).Add(*src)
+	err := tr.Exec("sql", "--explain", "--json", "SELECT * FROM actor WHERE actor_id > 100")
+	require.NoError(t, err)
+
+	recs := tr.BindSliceMap()
+	require.Len(t, recs, 1)
+	require.Equal(t, src.Handle, recs[0]["source"])
+	require.Equal(t, "SELECT * FROM actor WHERE actor_id > 100", recs[0]["sql"])
+	require.Contains(t, recs[0]["plan"], "QUERY PLAN")
+
+	tr = testrun.New(th.Context, t, nil).Add(*src)
+	err = tr.Exec("sql", "--explain", "--exec", "DELETE FROM actor")
+	require.Error(t, err, "--explain and --exec are mutually exclusive")
+}
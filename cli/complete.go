@@ -25,6 +25,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 
+	"github.com/neilotoole/sq/libsq/driver"
 	"github.com/neilotoole/sq/libsq/source"
 )
 
@@ -111,6 +112,46 @@ func completeGroup(max int) completionFunc {
 	}
 }
 
+// completeSavedQuery is a completionFunc that suggests the names of
+// saved queries. The max arg is the maximum number of completions. Set
+// to 0 for no limit.
+func completeSavedQuery(max int) completionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if max > 0 && len(args) >= max {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		ru := getRun(cmd)
+		names := lo.Keys(ru.Config.Queries)
+		names = lo.Reject(names, func(item string, index int) bool {
+			return !strings.HasPrefix(item, toComplete)
+		})
+
+		names, _ = lo.Difference(names, args)
+		slices.Sort(names)
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeSavedQueryArg is a completionFunc for the --arg flag of
+// "sq run NAME". It suggests the keys of the args of query NAME.
+func completeSavedQueryArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ru := getRun(cmd)
+	q, ok := ru.Config.Queries[args[0]]
+	if !ok || q == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	keys := lo.Reject(q.Args, func(item string, index int) bool {
+		return !strings.HasPrefix(item, toComplete)
+	})
+	return keys, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
+}
+
 // completeHandleOrGroup returns the matching list of handles+groups.
 func completeHandleOrGroup(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	switch {
@@ -163,6 +204,26 @@ func completeDriverType(cmd *cobra.Command, _ []string, _ string) ([]string, cob
 	return types, cobra.ShellCompDirectiveNoFileComp
 }
 
+// completeSQLDriverType is a completionFunc that suggests SQL drivers.
+func completeSQLDriverType(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	ru := getRun(cmd)
+	if ru.Databases == nil {
+		if err := preRun(cmd, ru); err != nil {
+			lg.Unexpected(logFrom(cmd), err)
+			return nil, cobra.ShellCompDirectiveError
+		}
+	}
+
+	var types []string
+	for _, drvr := range ru.DriverRegistry.Drivers() {
+		if md := drvr.DriverMetadata(); md.IsSQL {
+			types = append(types, string(md.Type))
+		}
+	}
+
+	return types, cobra.ShellCompDirectiveNoFileComp
+}
+
 // completeOptKey is a completionFunc that completes keys for options.Opt.
 // If flag.ConfigSrc is set on cmd, the returned completions are limited to
 // Opt keys appropriate to that source. For example, if the source is Excel,
@@ -242,6 +303,8 @@ func completeOptValue(cmd *cobra.Command, args []string, toComplete string) ([]s
 			return a, cobra.ShellCompDirectiveDefault
 		case OptDatetimeFormat.Key(), OptTimeFormat.Key(), OptDateFormat.Key():
 			return timez.NamedLayouts(), cobra.ShellCompDirectiveNoFileComp
+		case driver.OptJoinStrategy.Key():
+			a = []string{driver.JoinStrategyAuto, driver.JoinStrategyScratch, driver.JoinStrategyLargest}
 		}
 
 	case LogLevelOpt:
@@ -2,6 +2,9 @@
 package config
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/neilotoole/sq/cli/buildinfo"
 	"github.com/neilotoole/sq/libsq/core/options"
 
@@ -43,6 +46,9 @@ type Config struct {
 	// Collection is the set of data sources.
 	Collection *source.Collection `yaml:"collection" json:"collection"`
 
+	// Queries holds the saved queries, keyed by name.
+	Queries map[string]*Query `yaml:"queries,omitempty" json:"queries,omitempty"`
+
 	// Ext holds sq config extensions, such as user driver config.
 	Ext Ext `yaml:"-" json:"-"`
 }
@@ -52,6 +58,19 @@ func (c *Config) String() string {
 	return stringz.SprintJSON(c)
 }
 
+// Query is a saved SLQ query, which is executed via "sq run NAME".
+type Query struct {
+	// SLQ is the text of the query, e.g.
+	//
+	//	@sakila | .payment | where(.amount > $min_amount)
+	SLQ string `yaml:"slq" json:"slq"`
+
+	// Args holds the keys of the query's args, e.g. "min_amount".
+	// A value for each arg must be supplied via --arg when the
+	// query is executed.
+	Args []string `yaml:"args,omitempty" json:"args,omitempty"`
+}
+
 // Ext holds additional config (extensions) loaded from other
 // config files, e.g. ~/.config/sq/ext/*.sq.yml.
 type Ext struct {
@@ -85,5 +104,35 @@ func Valid(cfg *Config) error {
 		}
 	}
 
+	for name, q := range cfg.Queries {
+		if err := ValidQuery(name, q); err != nil {
+			return errz.Wrap(err, "config: invalid '.queries'")
+		}
+	}
+
+	return nil
+}
+
+// ValidQuery returns an error if the saved query q with name is not valid.
+// The query text itself is not parsed.
+func ValidQuery(name string, q *Query) error {
+	if err := stringz.ValidIdent(name); err != nil {
+		return errz.Errorf("invalid query name: %s", name)
+	}
+
+	if q == nil || strings.TrimSpace(q.SLQ) == "" {
+		return errz.Errorf("query %s: empty query", name)
+	}
+
+	for i, arg := range q.Args {
+		if err := stringz.ValidIdent(arg); err != nil {
+			return errz.Errorf("query %s: invalid arg: %s", name, arg)
+		}
+
+		if slices.Contains(q.Args[:i], arg) {
+			return errz.Errorf("query %s: duplicate arg: %s", name, arg)
+		}
+	}
+
 	return nil
 }
@@ -40,6 +40,15 @@ const (
 
 	Help = "help"
 
+	Explain      = "explain"
+	ExplainUsage = "Show the DB's query plan instead of executing the query"
+
+	PrintSQL      = "print-sql"
+	PrintSQLUsage = "Print the SQL rendered from the query instead of executing it"
+
+	Dialect      = "dialect"
+	DialectUsage = "Render SQL for DRIVER, without connecting to a source (use with --print-sql)"
+
 	Insert      = "insert"
 	InsertUsage = "Insert query results into @HANDLE.TABLE. If not existing, TABLE will be created."
 
@@ -127,6 +136,13 @@ const (
 	Arg      = "arg"
 	ArgUsage = "Set a string value to a variable"
 
+	QueryAddForce      = "force"
+	QueryAddForceShort = "f"
+	QueryAddForceUsage = "Overwrite the saved query if it already exists"
+
+	FmtCheck      = "check"
+	FmtCheckUsage = "Don't print the query; fail if it isn't already formatted"
+
 	Config      = "config"
 	ConfigUsage = "Load config from here"
 
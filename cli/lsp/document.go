@@ -0,0 +1,71 @@
+package lsp
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Document is a text document that is open in the editor.
+type Document struct {
+	// URI identifies the document, e.g. "file:///home/me/query.slq".
+	URI string
+
+	// Version is the document version, which the
+	// editor increments after each change.
+	Version int
+
+	// Text is the document's content.
+	Text string
+}
+
+// Offset returns the byte offset in doc.Text of pos. If pos is beyond
+// the end of its line, the offset of the end of the line is returned;
+// if pos is beyond the last line, len(doc.Text) is returned.
+func (doc *Document) Offset(pos Position) int {
+	offset := 0
+	for line := 0; line < pos.Line; line++ {
+		i := strings.IndexByte(doc.Text[offset:], '\n')
+		if i < 0 {
+			return len(doc.Text)
+		}
+		offset += i + 1
+	}
+
+	// Position.Character is measured in UTF-16 code units.
+	for units := 0; units < pos.Character && offset < len(doc.Text); {
+		r, size := utf8.DecodeRuneInString(doc.Text[offset:])
+		if r == '\n' {
+			break
+		}
+
+		units++
+		if r >= 0x10000 {
+			// A surrogate pair.
+			units++
+		}
+		offset += size
+	}
+
+	return offset
+}
+
+// Position returns the position in doc.Text of the byte offset. It
+// is the inverse of Document.Offset.
+func (doc *Document) Position(offset int) Position {
+	offset = min(max(offset, 0), len(doc.Text))
+
+	var pos Position
+	for _, r := range doc.Text[:offset] {
+		switch {
+		case r == '\n':
+			pos.Line++
+			pos.Character = 0
+		case r >= 0x10000:
+			pos.Character += 2
+		default:
+			pos.Character++
+		}
+	}
+
+	return pos
+}
@@ -0,0 +1,373 @@
+// Package lsp implements a Language Server Protocol server, which
+// communicates with an editor via JSON-RPC over a stream (typically
+// stdin and stdout). The language-specific functionality is provided
+// by a Handler. See:
+// https://microsoft.github.io/language-server-protocol/
+package lsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+)
+
+// Handler provides the language-specific functionality of a Server.
+type Handler interface {
+	// Diagnose returns the problems in doc, such as syntax errors.
+	Diagnose(ctx context.Context, doc *Document) []Diagnostic
+
+	// Complete returns the completions at pos in doc.
+	Complete(ctx context.Context, doc *Document, pos Position) []CompletionItem
+
+	// Hover returns information about the text at pos in doc. It
+	// returns nil if there's no information.
+	Hover(ctx context.Context, doc *Document, pos Position) *Hover
+
+	// Commands returns the names of the commands that
+	// ExecuteCommand supports.
+	Commands() []string
+
+	// ExecuteCommand executes the named command against doc,
+	// returning the command's result.
+	ExecuteCommand(ctx context.Context, command string, doc *Document) (any, error)
+}
+
+// JSON-RPC error codes.
+const (
+	codeParseError     = -32700
+	codeInvalidParams  = -32602
+	codeMethodNotFound = -32601
+	codeInternalError  = -32603
+)
+
+// Server is a Language Server Protocol server. Create a Server
+// with NewServer, and invoke Server.Serve to process requests.
+type Server struct {
+	log     *slog.Logger
+	name    string
+	version string
+	handler Handler
+
+	in  *bufio.Reader
+	out io.Writer
+
+	// mu guards writes to out.
+	mu sync.Mutex
+
+	// docs holds the open documents, keyed by URI.
+	docs map[string]*Document
+}
+
+// NewServer returns a new Server that reads requests from in, and
+// writes responses to out, using handler to process the requests.
+// Args name and version identify the server to the editor.
+func NewServer(log *slog.Logger, name, version string, in io.Reader, out io.Writer, handler Handler) *Server {
+	return &Server{
+		log:     log,
+		name:    name,
+		version: version,
+		handler: handler,
+		in:      bufio.NewReader(in),
+		out:     out,
+		docs:    map[string]*Document{},
+	}
+}
+
+// request is a JSON-RPC request or notification. A notification
+// doesn't have an ID.
+type request struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// rpcError is a JSON-RPC error.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implements error.
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// Serve processes requests until the editor sends the "exit"
+// notification, ctx is done, or the input stream is closed.
+func (s *Server) Serve(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return errz.Err(err)
+		}
+
+		b, err := s.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		var req request
+		if err = json.Unmarshal(b, &req); err != nil {
+			s.log.Warn("LSP: invalid message", lga.Err, err)
+			_ = s.reply(nil, nil, &rpcError{Code: codeParseError, Message: err.Error()})
+			continue
+		}
+
+		if req.Method == "exit" {
+			return nil
+		}
+
+		result, err := s.dispatch(ctx, &req)
+		if req.ID == nil {
+			// It's a notification, so there's no reply.
+			if err != nil {
+				s.log.Warn("LSP: notification failed", lga.Method, req.Method, lga.Err, err)
+			}
+			continue
+		}
+
+		var rpcErr *rpcError
+		if err != nil {
+			s.log.Warn("LSP: request failed", lga.Method, req.Method, lga.Err, err)
+			if !errors.As(err, &rpcErr) {
+				rpcErr = &rpcError{Code: codeInternalError, Message: err.Error()}
+			}
+		}
+
+		if err = s.reply(req.ID, result, rpcErr); err != nil {
+			return err
+		}
+	}
+}
+
+// dispatch invokes the method of req, returning the result for a request,
+// or nil for a notification.
+func (s *Server) dispatch(ctx context.Context, req *request) (any, error) {
+	s.log.Debug("LSP: received", lga.Method, req.Method)
+
+	switch req.Method {
+	case "initialize":
+		return s.initialize(), nil
+	case "shutdown":
+		return nil, nil
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		doc := &Document{
+			URI:     params.TextDocument.URI,
+			Version: params.TextDocument.Version,
+			Text:    params.TextDocument.Text,
+		}
+		s.docs[doc.URI] = doc
+		return nil, s.publishDiagnostics(ctx, doc)
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		doc, err := s.doc(params.TextDocument.URI)
+		if err != nil {
+			return nil, err
+		}
+
+		// The server requests full sync, so the last change
+		// holds the complete text of the document.
+		if n := len(params.ContentChanges); n > 0 {
+			doc.Text = params.ContentChanges[n-1].Text
+		}
+		doc.Version = params.TextDocument.Version
+		return nil, s.publishDiagnostics(ctx, doc)
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		delete(s.docs, params.TextDocument.URI)
+		return nil, s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		})
+	case "textDocument/completion":
+		doc, pos, err := s.docPosition(req)
+		if err != nil {
+			return nil, err
+		}
+
+		items := s.handler.Complete(ctx, doc, pos)
+		if items == nil {
+			items = []CompletionItem{}
+		}
+		return items, nil
+	case "textDocument/hover":
+		doc, pos, err := s.docPosition(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if hover := s.handler.Hover(ctx, doc, pos); hover != nil {
+			return hover, nil
+		}
+		return nil, nil
+	case "workspace/executeCommand":
+		var params executeCommandParams
+		if err := unmarshalParams(req, &params); err != nil {
+			return nil, err
+		}
+
+		// Each command expects the URI of the document as its argument.
+		var uri string
+		if len(params.Arguments) > 0 {
+			uri, _ = params.Arguments[0].(string)
+		}
+
+		doc, err := s.doc(uri)
+		if err != nil {
+			return nil, err
+		}
+		return s.handler.ExecuteCommand(ctx, params.Command, doc)
+	case "initialized", "$/cancelRequest", "$/setTrace", "workspace/didChangeConfiguration":
+		return nil, nil
+	default:
+		return nil, &rpcError{Code: codeMethodNotFound, Message: "method not supported: " + req.Method}
+	}
+}
+
+// initialize returns the result of the "initialize" request,
+// which describes the server's capabilities.
+func (s *Server) initialize() any {
+	const textDocumentSyncFull = 1
+	return map[string]any{
+		"capabilities": map[string]any{
+			"textDocumentSync": textDocumentSyncFull,
+			"completionProvider": map[string]any{
+				"triggerCharacters": []string{"@", "."},
+			},
+			"hoverProvider": true,
+			"executeCommandProvider": map[string]any{
+				"commands": s.handler.Commands(),
+			},
+		},
+		"serverInfo": map[string]any{
+			"name":    s.name,
+			"version": s.version,
+		},
+	}
+}
+
+// doc returns the open document with uri.
+func (s *Server) doc(uri string) (*Document, error) {
+	doc, ok := s.docs[uri]
+	if !ok {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "document not open: " + uri}
+	}
+	return doc, nil
+}
+
+// docPosition returns the document and position of a request
+// whose params are textDocumentPositionParams.
+func (s *Server) docPosition(req *request) (*Document, Position, error) {
+	var params textDocumentPositionParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, Position{}, err
+	}
+
+	doc, err := s.doc(params.TextDocument.URI)
+	if err != nil {
+		return nil, Position{}, err
+	}
+	return doc, params.Position, nil
+}
+
+// publishDiagnostics sends the diagnostics for doc to the editor.
+func (s *Server) publishDiagnostics(ctx context.Context, doc *Document) error {
+	diags := s.handler.Diagnose(ctx, doc)
+	if diags == nil {
+		diags = []Diagnostic{}
+	}
+
+	return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         doc.URI,
+		Version:     doc.Version,
+		Diagnostics: diags,
+	})
+}
+
+func unmarshalParams(req *request, v any) error {
+	if err := json.Unmarshal(req.Params, v); err != nil {
+		return &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("%s: invalid params: %v", req.Method, err)}
+	}
+	return nil
+}
+
+// read reads the content of the next message. Each message has a
+// header, which includes the content length, separated from the
+// content by a blank line.
+func (s *Server) read() ([]byte, error) {
+	header, err := textproto.NewReader(s.in).ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errz.Err(err)
+		}
+		return nil, errz.Wrap(err, "read message header")
+	}
+
+	length, err := strconv.Atoi(strings.TrimSpace(header.Get("Content-Length")))
+	if err != nil || length < 0 {
+		return nil, errz.Errorf("invalid message header: Content-Length: %q", header.Get("Content-Length"))
+	}
+
+	b := make([]byte, length)
+	if _, err = io.ReadFull(s.in, b); err != nil {
+		return nil, errz.Wrap(err, "read message content")
+	}
+	return b, nil
+}
+
+// reply sends the response to the request with id.
+func (s *Server) reply(id json.RawMessage, result any, rpcErr *rpcError) error {
+	resp := map[string]any{"jsonrpc": "2.0", "id": id}
+	if rpcErr != nil {
+		resp["error"] = rpcErr
+	} else {
+		resp["result"] = result
+	}
+	return s.write(resp)
+}
+
+// notify sends a notification to the editor.
+func (s *Server) notify(method string, params any) error {
+	return s.write(map[string]any{"jsonrpc": "2.0", "method": method, "params": params})
+}
+
+func (s *Server) write(msg any) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return errz.Err(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err = fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(b), b); err != nil {
+		return errz.Wrap(err, "write message")
+	}
+	return nil
+}
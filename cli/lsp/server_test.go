@@ -0,0 +1,182 @@
+package lsp_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/neilotoole/slogt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/lsp"
+)
+
+var _ lsp.Handler = (*fakeHandler)(nil)
+
+// fakeHandler is an lsp.Handler that reports a diagnostic if the
+// document contains "bad", and completes the word "actor".
+type fakeHandler struct{}
+
+func (fakeHandler) Diagnose(_ context.Context, doc *lsp.Document) []lsp.Diagnostic {
+	i := strings.Index(doc.Text, "bad")
+	if i < 0 {
+		return nil
+	}
+	return []lsp.Diagnostic{{
+		Range:    lsp.Range{Start: doc.Position(i), End: doc.Position(i + 3)},
+		Severity: lsp.SeverityError,
+		Message:  "bad input",
+	}}
+}
+
+func (fakeHandler) Complete(_ context.Context, _ *lsp.Document, _ lsp.Position) []lsp.CompletionItem {
+	return []lsp.CompletionItem{{Label: "actor", Kind: lsp.CompletionKindStruct}}
+}
+
+func (fakeHandler) Hover(_ context.Context, doc *lsp.Document, pos lsp.Position) *lsp.Hover {
+	return &lsp.Hover{Contents: lsp.MarkupContent{Kind: "plaintext", Value: fmt.Sprint(doc.Offset(pos))}}
+}
+
+func (fakeHandler) Commands() []string {
+	return []string{"upper"}
+}
+
+func (fakeHandler) ExecuteCommand(_ context.Context, _ string, doc *lsp.Document) (any, error) {
+	return strings.ToUpper(doc.Text), nil
+}
+
+func TestServer(t *testing.T) {
+	const uri = "file:///query.slq"
+	in := frame(t,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`,
+		`{"jsonrpc":"2.0","method":"initialized","params":{}}`,
+		`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"`+uri+`","version":1,"text":"@sakila | bad"}}}`,
+		`{"jsonrpc":"2.0","method":"textDocument/didChange","params":{"textDocument":{"uri":"`+uri+`","version":2},"contentChanges":[{"text":"@sakila\n| .actor"}]}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"textDocument/completion","params":{"textDocument":{"uri":"`+uri+`"},"position":{"line":1,"character":3}}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"textDocument/hover","params":{"textDocument":{"uri":"`+uri+`"},"position":{"line":1,"character":3}}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"workspace/executeCommand","params":{"command":"upper","arguments":["`+uri+`"]}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"textDocument/hover","params":{"textDocument":{"uri":"file:///other.slq"},"position":{"line":0,"character":0}}}`,
+		`{"jsonrpc":"2.0","id":6,"method":"textDocument/formatting","params":{}}`,
+		`{"jsonrpc":"2.0","id":7,"method":"shutdown"}`,
+		`{"jsonrpc":"2.0","method":"exit"}`,
+		`{"jsonrpc":"2.0","id":8,"method":"shutdown"}`,
+	)
+
+	out := &bytes.Buffer{}
+	srv := lsp.NewServer(slogt.New(t), "sq", "v1.0.0", in, out, fakeHandler{})
+	require.NoError(t, srv.Serve(context.Background()))
+
+	msgs := readMessages(t, out)
+	require.Len(t, msgs, 9, "the request after exit should not be processed")
+
+	require.Equal(t, "sq", dig(msgs[0], "result", "serverInfo", "name"))
+	require.Equal(t, []any{"upper"}, dig(msgs[0], "result", "capabilities", "executeCommandProvider", "commands"))
+
+	require.Equal(t, "textDocument/publishDiagnostics", msgs[1]["method"])
+	diags := dig(msgs[1], "params", "diagnostics").([]any)
+	require.Len(t, diags, 1)
+	require.Equal(t, "bad input", dig(diags[0].(map[string]any), "message"))
+	require.Equal(t, float64(10), dig(diags[0].(map[string]any), "range", "start", "character"))
+
+	// After the change, the problem is fixed.
+	require.Equal(t, float64(2), dig(msgs[2], "params", "version"))
+	require.Empty(t, dig(msgs[2], "params", "diagnostics"))
+
+	require.Equal(t, float64(2), msgs[3]["id"])
+	require.Equal(t, "actor", dig(msgs[3]["result"].([]any)[0].(map[string]any), "label"))
+
+	require.Equal(t, "11", dig(msgs[4], "result", "contents", "value"))
+	require.Equal(t, "@SAKILA\n| .ACTOR", msgs[5]["result"])
+
+	// The document isn't open.
+	require.Equal(t, float64(-32602), dig(msgs[6], "error", "code"))
+
+	// The method isn't supported.
+	require.Equal(t, float64(-32601), dig(msgs[7], "error", "code"))
+
+	require.Equal(t, float64(7), msgs[8]["id"])
+	require.Contains(t, msgs[8], "result")
+	require.Nil(t, msgs[8]["result"])
+}
+
+func TestDocument(t *testing.T) {
+	// "é" is two bytes in UTF-8 and one UTF-16 code unit;
+	// "😀" is four bytes in UTF-8 and two UTF-16 code units.
+	doc := &lsp.Document{Text: "@sakila\n| .é😀x\n"}
+
+	testCases := []struct {
+		pos    lsp.Position
+		offset int
+	}{
+		{lsp.Position{Line: 0, Character: 0}, 0},
+		{lsp.Position{Line: 0, Character: 7}, 7},
+		{lsp.Position{Line: 1, Character: 0}, 8},
+		{lsp.Position{Line: 1, Character: 3}, 11},
+		{lsp.Position{Line: 1, Character: 4}, 13},
+		{lsp.Position{Line: 1, Character: 6}, 17},
+		{lsp.Position{Line: 1, Character: 7}, 18},
+		{lsp.Position{Line: 2, Character: 0}, 19},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.offset, doc.Offset(tc.pos), "%+v", tc.pos)
+		require.Equal(t, tc.pos, doc.Position(tc.offset), "%d", tc.offset)
+	}
+
+	// Beyond the end of a line, or of the document.
+	require.Equal(t, 7, doc.Offset(lsp.Position{Line: 0, Character: 100}))
+	require.Equal(t, len(doc.Text), doc.Offset(lsp.Position{Line: 5}))
+}
+
+// frame returns a reader of msgs, each with an LSP header.
+func frame(t *testing.T, msgs ...string) io.Reader {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	for _, msg := range msgs {
+		require.True(t, json.Valid([]byte(msg)), msg)
+		fmt.Fprintf(buf, "Content-Length: %d\r\n\r\n%s", len(msg), msg)
+	}
+	return buf
+}
+
+// readMessages reads the LSP messages from r.
+func readMessages(t *testing.T, r io.Reader) []map[string]any {
+	t.Helper()
+	br := bufio.NewReader(r)
+
+	var msgs []map[string]any
+	for {
+		header, err := textproto.NewReader(br).ReadMIMEHeader()
+		if err == io.EOF {
+			return msgs
+		}
+		require.NoError(t, err)
+
+		length, err := strconv.Atoi(header.Get("Content-Length"))
+		require.NoError(t, err)
+
+		b := make([]byte, length)
+		_, err = io.ReadFull(br, b)
+		require.NoError(t, err)
+
+		var msg map[string]any
+		require.NoError(t, json.Unmarshal(b, &msg))
+		msgs = append(msgs, msg)
+	}
+}
+
+// dig returns the value at path in m.
+func dig(m map[string]any, path ...string) any {
+	var v any = m
+	for _, key := range path {
+		v = v.(map[string]any)[key]
+	}
+	return v
+}
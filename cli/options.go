@@ -163,6 +163,7 @@ func RegisterDefaultOpts(reg *options.Registry) {
 		driver.OptConnMaxLifetime,
 		driver.OptConnOpenTimeout,
 		driver.OptMaxRetryInterval,
+		driver.OptJoinStrategy,
 		driver.OptTuningErrgroupLimit,
 		driver.OptTuningRecChanSize,
 		OptTuningFlushThreshold,
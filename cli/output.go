@@ -256,6 +256,7 @@ func newWriters(cmd *cobra.Command, o options.Options, out, errOut io.Writer,
 		Error:    tablew.NewErrorWriter(errOut2, pr),
 		Version:  tablew.NewVersionWriter(out2, pr),
 		Config:   tablew.NewConfigWriter(out2, pr),
+		Query:    tablew.NewQueryWriter(out2, pr),
 	}
 
 	if OptErrorFormat.Get(o) == format.JSON {
@@ -276,6 +277,7 @@ func newWriters(cmd *cobra.Command, o options.Options, out, errOut io.Writer,
 		w.Version = jsonw.NewVersionWriter(out2, pr)
 		w.Ping = jsonw.NewPingWriter(out2, pr)
 		w.Config = jsonw.NewConfigWriter(out2, pr)
+		w.Query = jsonw.NewQueryWriter(out2, pr)
 
 	case format.Text:
 		// Don't delete this case, it's actually needed due to
@@ -289,6 +291,7 @@ func newWriters(cmd *cobra.Command, o options.Options, out, errOut io.Writer,
 
 	case format.YAML:
 		w.Config = yamlw.NewConfigWriter(out2, pr)
+		w.Query = yamlw.NewQueryWriter(out2, pr)
 		w.Metadata = yamlw.NewMetadataWriter(out2, pr)
 		w.Source = yamlw.NewSourceWriter(out2, pr)
 		w.Version = yamlw.NewVersionWriter(out2, pr)
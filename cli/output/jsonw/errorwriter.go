@@ -1,10 +1,12 @@
 package jsonw
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 
+	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/core/errz"
 
 	"github.com/neilotoole/sq/cli/output"
@@ -40,13 +42,31 @@ func (w *errorWriter) Error(err error) {
 	}
 
 	t := struct {
-		Error string   `json:"error"`
-		Stack []string `json:"stack,omitempty"`
+		Error       string   `json:"error"`
+		Kind        string   `json:"kind,omitempty"`
+		Query       string   `json:"query,omitempty"`
+		Line        int      `json:"line,omitempty"`
+		Column      int      `json:"column,omitempty"`
+		Text        string   `json:"text,omitempty"`
+		Suggestions []string `json:"suggestions,omitempty"`
+		Stack       []string `json:"stack,omitempty"`
 	}{
 		Error: errMsg,
 		Stack: stack,
 	}
 
+	// For an error in a SLQ query, include the position of the
+	// error in the query, and any suggestions.
+	var qErr *ast.QueryError
+	if errors.As(err, &qErr) {
+		t.Kind = qErr.Kind
+		t.Query = qErr.Query
+		t.Line = qErr.Line
+		t.Column = qErr.Column
+		t.Text = qErr.Text
+		t.Suggestions = qErr.Suggestions
+	}
+
 	pr := w.pr.Clone()
 	pr.String = pr.Error
 
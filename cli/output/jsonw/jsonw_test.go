@@ -17,6 +17,7 @@ import (
 
 	"github.com/neilotoole/sq/cli/output"
 	"github.com/neilotoole/sq/cli/output/jsonw"
+	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/core/errz"
 	"github.com/neilotoole/sq/testh"
 	"github.com/neilotoole/sq/testh/fixt"
@@ -222,3 +223,19 @@ func TestErrorWriter(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorWriter_QueryError(t *testing.T) {
+	_, err := ast.Parse(slogt.New(t), ".actor | grup_by(.first_name)")
+	require.Error(t, err)
+
+	buf := &bytes.Buffer{}
+	pr := output.NewPrinting()
+	pr.Compact = true
+	pr.EnableColor(false)
+
+	jsonw.NewErrorWriter(slogt.New(t), buf, pr).Error(errz.Wrap(err, "query"))
+	want := `{"error":"query: syntax error: [1:10] unexpected input 'grup_by': did you mean \"group_by\"?",` +
+		`"kind":"syntax error","query":".actor | grup_by(.first_name)","line":1,"column":10,` +
+		`"text":"grup_by","suggestions":["group_by"]}` + "\n"
+	require.Equal(t, want, buf.String())
+}
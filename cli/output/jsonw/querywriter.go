@@ -0,0 +1,67 @@
+package jsonw
+
+import (
+	"io"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"github.com/neilotoole/sq/cli/config"
+	"github.com/neilotoole/sq/cli/output"
+)
+
+var _ output.QueryWriter = (*queryWriter)(nil)
+
+// queryWriter implements output.QueryWriter for JSON.
+type queryWriter struct {
+	out io.Writer
+	pr  *output.Printing
+}
+
+// NewQueryWriter returns a new output.QueryWriter instance
+// that outputs saved queries in JSON.
+func NewQueryWriter(out io.Writer, pr *output.Printing) output.QueryWriter {
+	return &queryWriter{out: out, pr: pr}
+}
+
+// namedQuery is a saved query, together with its name.
+type namedQuery struct {
+	Name string   `json:"name"`
+	SLQ  string   `json:"slq"`
+	Args []string `json:"args,omitempty"`
+}
+
+func newNamedQuery(name string, q *config.Query) namedQuery {
+	return namedQuery{Name: name, SLQ: q.SLQ, Args: q.Args}
+}
+
+// Queries implements output.QueryWriter.
+func (w *queryWriter) Queries(queries map[string]*config.Query) error {
+	names := lo.Keys(queries)
+	slices.Sort(names)
+
+	a := make([]namedQuery, len(names))
+	for i, name := range names {
+		a[i] = newNamedQuery(name, queries[name])
+	}
+
+	return writeJSON(w.out, w.pr, a)
+}
+
+// Query implements output.QueryWriter.
+func (w *queryWriter) Query(name string, q *config.Query) error {
+	if q == nil {
+		return nil
+	}
+
+	return writeJSON(w.out, w.pr, newNamedQuery(name, q))
+}
+
+// Removed implements output.QueryWriter.
+func (w *queryWriter) Removed(names ...string) error {
+	if !w.pr.Verbose || len(names) == 0 {
+		return nil
+	}
+
+	return writeJSON(w.out, w.pr, names)
+}
@@ -1,10 +1,12 @@
 package tablew
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strings"
 
+	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/core/errz"
 
 	"github.com/neilotoole/sq/cli/output"
@@ -25,6 +27,17 @@ func NewErrorWriter(w io.Writer, pr *output.Printing) output.ErrorWriter {
 // Error implements output.ErrorWriter.
 func (w *errorWriter) Error(err error) {
 	fmt.Fprintln(w.w, w.pr.Error.Sprintf("sq: %v", err))
+
+	// For an error in a SLQ query, show the offending query text.
+	var qErr *ast.QueryError
+	if errors.As(err, &qErr) {
+		if query, caret, ok := strings.Cut(qErr.Snippet(), "\n"); ok {
+			fmt.Fprintln(w.w)
+			fmt.Fprintln(w.w, "  "+query)
+			fmt.Fprintln(w.w, w.pr.Error.Sprint("  "+caret))
+		}
+	}
+
 	if !w.pr.Verbose {
 		return
 	}
@@ -0,0 +1,86 @@
+package tablew
+
+import (
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/neilotoole/sq/cli/config"
+	"github.com/neilotoole/sq/cli/output"
+)
+
+var _ output.QueryWriter = (*queryWriter)(nil)
+
+// queryWriter implements output.QueryWriter.
+type queryWriter struct {
+	tbl *table
+}
+
+// NewQueryWriter returns a new output.QueryWriter.
+func NewQueryWriter(out io.Writer, pr *output.Printing) output.QueryWriter {
+	tbl := &table{out: out, pr: pr, header: pr.ShowHeader}
+	tbl.reset()
+	return &queryWriter{tbl: tbl}
+}
+
+// Queries implements output.QueryWriter.
+func (w *queryWriter) Queries(queries map[string]*config.Query) error {
+	names := lo.Keys(queries)
+	slices.Sort(names)
+
+	pr := w.tbl.pr
+	var rows [][]string
+	for _, name := range names {
+		q := queries[name]
+		if !pr.Verbose {
+			rows = append(rows, []string{name, q.SLQ})
+			continue
+		}
+		rows = append(rows, []string{name, strings.Join(q.Args, ", "), q.SLQ})
+	}
+
+	w.tbl.tblImpl.SetColTrans(0, pr.Key.SprintFunc())
+	if !pr.Verbose {
+		w.tbl.tblImpl.SetHeaderDisable(true)
+	} else {
+		w.tbl.tblImpl.SetHeaderDisable(!pr.ShowHeader)
+		w.tbl.tblImpl.SetColTrans(1, pr.String.SprintFunc())
+		w.tbl.tblImpl.SetHeader([]string{"NAME", "ARGS", "QUERY"})
+	}
+
+	w.tbl.appendRowsAndRenderAll(rows)
+	return nil
+}
+
+// Query implements output.QueryWriter. Unless in verbose mode,
+// only the query text is printed.
+func (w *queryWriter) Query(name string, q *config.Query) error {
+	if q == nil {
+		return nil
+	}
+
+	if !w.tbl.pr.Verbose {
+		_, err := io.WriteString(w.tbl.out, q.SLQ+"\n")
+		return err
+	}
+
+	return w.Queries(map[string]*config.Query{name: q})
+}
+
+// Removed implements output.QueryWriter.
+func (w *queryWriter) Removed(names ...string) error {
+	if !w.tbl.pr.Verbose || len(names) == 0 {
+		return nil
+	}
+
+	w.tbl.pr.Faint.Fprint(w.tbl.out, "Removed ")
+	w.tbl.pr.Number.Fprint(w.tbl.out, len(names))
+	w.tbl.pr.Faint.Fprintln(w.tbl.out, " queries")
+
+	for _, name := range names {
+		w.tbl.pr.Key.Fprintln(w.tbl.out, name)
+	}
+	return nil
+}
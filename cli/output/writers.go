@@ -17,6 +17,7 @@ import (
 	"github.com/neilotoole/sq/libsq/core/options"
 
 	"github.com/neilotoole/sq/cli/buildinfo"
+	"github.com/neilotoole/sq/cli/config"
 	"github.com/neilotoole/sq/libsq/driver"
 	"github.com/neilotoole/sq/libsq/source"
 )
@@ -131,6 +132,18 @@ type ConfigWriter interface {
 	UnsetOption(opt options.Opt) error
 }
 
+// QueryWriter prints saved queries.
+type QueryWriter interface {
+	// Queries prints the saved queries, keyed by name.
+	Queries(queries map[string]*config.Query) error
+
+	// Query prints the saved query with name.
+	Query(name string, q *config.Query) error
+
+	// Removed is called when saved queries are removed.
+	Removed(names ...string) error
+}
+
 // Writers is a container for the various output Writers.
 type Writers struct {
 	Printing *Printing
@@ -142,6 +155,7 @@ type Writers struct {
 	Ping     PingWriter
 	Version  VersionWriter
 	Config   ConfigWriter
+	Query    QueryWriter
 }
 
 // NewRecordWriterFunc is a func type that returns an output.RecordWriter.
@@ -0,0 +1,69 @@
+package yamlw
+
+import (
+	"io"
+	"slices"
+
+	"github.com/goccy/go-yaml/printer"
+	"github.com/samber/lo"
+
+	"github.com/neilotoole/sq/cli/config"
+	"github.com/neilotoole/sq/cli/output"
+)
+
+var _ output.QueryWriter = (*queryWriter)(nil)
+
+// queryWriter implements output.QueryWriter for YAML.
+type queryWriter struct {
+	out io.Writer
+	p   printer.Printer
+	pr  *output.Printing
+}
+
+// NewQueryWriter returns a new output.QueryWriter instance
+// that outputs saved queries in YAML.
+func NewQueryWriter(out io.Writer, pr *output.Printing) output.QueryWriter {
+	return &queryWriter{out: out, pr: pr, p: newPrinter(pr)}
+}
+
+// namedQuery is a saved query, together with its name.
+type namedQuery struct {
+	Name string   `yaml:"name"`
+	SLQ  string   `yaml:"slq"`
+	Args []string `yaml:"args,omitempty"`
+}
+
+func newNamedQuery(name string, q *config.Query) namedQuery {
+	return namedQuery{Name: name, SLQ: q.SLQ, Args: q.Args}
+}
+
+// Queries implements output.QueryWriter.
+func (w *queryWriter) Queries(queries map[string]*config.Query) error {
+	names := lo.Keys(queries)
+	slices.Sort(names)
+
+	a := make([]namedQuery, len(names))
+	for i, name := range names {
+		a[i] = newNamedQuery(name, queries[name])
+	}
+
+	return writeYAML(w.out, w.p, a)
+}
+
+// Query implements output.QueryWriter.
+func (w *queryWriter) Query(name string, q *config.Query) error {
+	if q == nil {
+		return nil
+	}
+
+	return writeYAML(w.out, w.p, newNamedQuery(name, q))
+}
+
+// Removed implements output.QueryWriter.
+func (w *queryWriter) Removed(names ...string) error {
+	if !w.pr.Verbose || len(names) == 0 {
+		return nil
+	}
+
+	return writeYAML(w.out, w.p, names)
+}
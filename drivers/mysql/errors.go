@@ -18,6 +18,9 @@ func errw(err error) error {
 		return nil
 	case hasErrCode(err, errNumTableNotExist):
 		return errz.NotExist(err)
+	case hasErrCode(err, errNumDBAccessDenied), hasErrCode(err, errNumTableAccessDenied),
+		hasErrCode(err, errNumOptionPreventsStatement), hasErrCode(err, errNumReadOnlyTransaction):
+		return errz.NoPermission(err)
 	default:
 		return errz.Err(err)
 	}
@@ -40,8 +43,12 @@ func hasErrCode(err error, code uint16) bool {
 
 // https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html
 const (
-	errNumTableNotExist = uint16(1146)
-	errNumConCount      = uint16(1040)
+	errNumTableNotExist           = uint16(1146)
+	errNumConCount                = uint16(1040)
+	errNumDBAccessDenied          = uint16(1044)
+	errNumTableAccessDenied       = uint16(1142)
+	errNumOptionPreventsStatement = uint16(1290) // e.g. --read-only
+	errNumReadOnlyTransaction     = uint16(1792)
 )
 
 func isErrTooManyConnections(err error) bool {
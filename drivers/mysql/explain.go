@@ -0,0 +1,59 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/sqlz"
+)
+
+// Explain implements driver.SQLDriver. The plan is the output of
+// EXPLAIN, with a line for each of its rows, of the form:
+//
+//	id=1 select_type=SIMPLE table=actor type=ALL rows=200 filtered=100.00
+//
+// NULL values are omitted.
+//
+// See: https://dev.mysql.com/doc/refman/8.0/en/explain-output.html
+func (d *driveri) Explain(ctx context.Context, db sqlz.DB, query string, args ...any) (string, error) {
+	rows, err := db.QueryContext(ctx, "EXPLAIN "+query, args...)
+	if err != nil {
+		return "", errz.Wrapf(errw(err), "explain: %s", query)
+	}
+	defer lg.WarnIfCloseError(lg.FromContext(ctx), lgm.CloseDBRows, rows)
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return "", errw(err)
+	}
+
+	vals := make([]sql.NullString, len(cols))
+	dest := make([]any, len(cols))
+	for i := range vals {
+		dest[i] = &vals[i]
+	}
+
+	var lines []string
+	for rows.Next() {
+		if err = rows.Scan(dest...); err != nil {
+			return "", errw(err)
+		}
+
+		fields := make([]string, 0, len(cols))
+		for i, val := range vals {
+			if val.Valid {
+				fields = append(fields, cols[i]+"="+val.String)
+			}
+		}
+		lines = append(lines, strings.Join(fields, " "))
+	}
+	if err = rows.Err(); err != nil {
+		return "", errw(err)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
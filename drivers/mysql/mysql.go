@@ -134,7 +134,22 @@ func placeholders(numCols, numRows int) string {
 
 // Renderer implements driver.SQLDriver.
 func (d *driveri) Renderer() *render.Renderer {
-	return render.NewDefaultRenderer()
+	r := render.NewDefaultRenderer()
+	// MySQL's LENGTH returns the length in bytes.
+	r.FunctionNames["length"] = "char_length"
+	r.FunctionOverrides["concat"] = renderFuncConcat
+	r.FunctionOverrides["string_agg"] = renderFuncStringAgg
+	r.FunctionOverrides["median"] = renderFuncNotSupported
+	r.FunctionOverrides["percentile_cont"] = renderFuncNotSupported
+	r.FunctionOverrides["date_trunc"] = renderFuncDateTrunc
+	r.FunctionOverrides["date_add"] = renderFuncDateAdd
+	r.TypeName = castTypeName
+	r.Groupings = render.GroupingsWithRollup
+	r.OrderByTerm = render.OrderByTermNullsEmulated
+	r.Like = render.LikeDefaultEscape
+	r.Is = renderIs(r.Is)
+	r.RangeFilter = render.RangeRowNumber
+	return r
 }
 
 // RecordMeta implements driver.SQLDriver.
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/ast/render"
 	"github.com/neilotoole/sq/libsq/core/kind"
 
 	"github.com/neilotoole/sq/libsq/core/errz"
 	"github.com/neilotoole/sq/libsq/core/sqlmodel"
+	"github.com/neilotoole/sq/libsq/core/stringz"
 )
 
 func dbTypeNameFromKind(knd kind.Kind) string {
@@ -36,6 +39,25 @@ func dbTypeNameFromKind(knd kind.Kind) string {
 	}
 }
 
+// castTypeNames is a map of kind to the type used by CAST, for
+// those kinds whose CREATE TABLE type (per dbTypeNameFromKind) is
+// not permitted by MySQL's CAST function.
+var castTypeNames = map[kind.Kind]string{ //nolint:exhaustive
+	kind.Text:  "CHAR",
+	kind.Int:   "SIGNED",
+	kind.Bool:  "SIGNED",
+	kind.Bytes: "BINARY",
+}
+
+// castTypeName returns the CAST type for knd. It implements
+// render.Renderer.TypeName.
+func castTypeName(knd kind.Kind) string {
+	if typ, ok := castTypeNames[knd]; ok {
+		return typ
+	}
+	return dbTypeNameFromKind(knd)
+}
+
 // createTblKindDefaults is a map of Kind to the value
 // to use for a column's DEFAULT clause in a CREATE TABLE statement.
 //
@@ -214,3 +236,104 @@ func buildUpdateStmt(tbl string, cols []string, where string) (string, error) {
 
 	return buf.String(), nil
 }
+
+// renderIs returns a render.Renderer.Is func that wraps next. Comparison
+// against a non-null value uses MySQL's null-safe equal operator "<=>".
+func renderIs(next func(*render.Context, *ast.IsNode) (string, error),
+) func(*render.Context, *ast.IsNode) (string, error) {
+	return func(rc *render.Context, n *ast.IsNode) (string, error) {
+		if render.IsNullLiteral(n.Left()) || render.IsNullLiteral(n.Right()) {
+			return next(rc, n)
+		}
+
+		lhs, err := rc.Renderer.Expr(rc, n.Left())
+		if err != nil {
+			return "", err
+		}
+
+		rhs, err := rc.Renderer.Expr(rc, n.Right())
+		if err != nil {
+			return "", err
+		}
+
+		if n.Negated() {
+			return "NOT (" + lhs + " <=> " + rhs + ")", nil
+		}
+		return lhs + " <=> " + rhs, nil
+	}
+}
+
+// renderFuncConcat renders concat using CONCAT_WS, which, unlike MySQL's
+// CONCAT, ignores null arguments (as do the other dialects).
+func renderFuncConcat(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "CONCAT_WS('', " + strings.Join(args, ", ") + ")", nil
+}
+
+// renderFuncStringAgg renders string_agg using GROUP_CONCAT. The
+// separator must be a string literal, so it is inlined rather than
+// bound as a parameter.
+func renderFuncStringAgg(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	sep, err := render.FuncStringArg(fn, 1, ",")
+	if err != nil {
+		return "", err
+	}
+
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	// MySQL treats backslash as an escape character in string literals.
+	sep = strings.ReplaceAll(sep, `\`, `\\`)
+	return "GROUP_CONCAT(" + args[0] + " SEPARATOR " + stringz.SingleQuote(sep) + ")", nil
+}
+
+// renderFuncNotSupported returns an error for a function that MySQL
+// can't compute, such as median, which MySQL lacks.
+func renderFuncNotSupported(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	return "", errz.Errorf("function %s: not supported by SQL dialect %s", fn.FuncName(), rc.Dialect.Type)
+}
+
+// dateTruncFormats is a map of date unit to the DATE_FORMAT
+// format that truncates a datetime to that unit.
+var dateTruncFormats = map[string]string{
+	render.UnitYear:   "%Y-01-01 00:00:00",
+	render.UnitMonth:  "%Y-%m-01 00:00:00",
+	render.UnitDay:    "%Y-%m-%d 00:00:00",
+	render.UnitHour:   "%Y-%m-%d %H:00:00",
+	render.UnitMinute: "%Y-%m-%d %H:%i:00",
+	render.UnitSecond: "%Y-%m-%d %H:%i:%s",
+}
+
+func renderFuncDateTrunc(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	unit, err := render.FuncUnitArg(fn, 0)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "CAST(DATE_FORMAT(" + args[1] + ", '" + dateTruncFormats[unit] + "') AS DATETIME)", nil
+}
+
+func renderFuncDateAdd(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	unit, err := render.FuncUnitArg(fn, 2)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "DATE_ADD(" + args[0] + ", INTERVAL " + args[1] + " " + strings.ToUpper(unit) + ")", nil
+}
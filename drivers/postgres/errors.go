@@ -18,14 +18,18 @@ func errw(err error) error {
 		return nil
 	case hasErrCode(err, errCodeRelationNotExist):
 		return errz.NotExist(err)
+	case hasErrCode(err, errCodeInsufficientPrivilege), hasErrCode(err, errCodeReadOnlyTransaction):
+		return errz.NoPermission(err)
 	default:
 		return errz.Err(err)
 	}
 }
 
 const (
-	errCodeRelationNotExist   = "42P01"
-	errCodeTooManyConnections = "53300"
+	errCodeRelationNotExist      = "42P01"
+	errCodeTooManyConnections    = "53300"
+	errCodeInsufficientPrivilege = "42501"
+	errCodeReadOnlyTransaction   = "25006"
 )
 
 // isErrTooManyConnections returns true if err is a postgres error
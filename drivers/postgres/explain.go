@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/sqlz"
+)
+
+// Explain implements driver.SQLDriver. The plan is the JSON
+// output of EXPLAIN (FORMAT JSON).
+//
+// See: https://www.postgresql.org/docs/current/sql-explain.html
+func (d *driveri) Explain(ctx context.Context, db sqlz.DB, query string, args ...any) (string, error) {
+	var plan string
+	err := db.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) "+query, args...).Scan(&plan)
+	if err != nil {
+		return "", errz.Wrapf(errw(err), "explain: %s", query)
+	}
+
+	return plan, nil
+}
@@ -136,7 +136,14 @@ func placeholders(numCols, numRows int) string {
 
 // Renderer implements driver.SQLDriver.
 func (d *driveri) Renderer() *render.Renderer {
-	return render.NewDefaultRenderer()
+	r := render.NewDefaultRenderer()
+	r.FunctionOverrides["round"] = renderFuncRound
+	r.FunctionOverrides["concat"] = renderFuncConcat
+	r.FunctionOverrides["median"] = renderFuncPercentile(r.FunctionOverrides["median"])
+	r.FunctionOverrides["percentile_cont"] = renderFuncPercentile(r.FunctionOverrides["percentile_cont"])
+	r.TypeName = dbTypeNameFromKind
+	r.UniqueBy = render.UniqueByDistinctOn
+	return r
 }
 
 // Open implements driver.DatabaseOpener.
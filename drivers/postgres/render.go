@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/ast/render"
 	"github.com/neilotoole/sq/libsq/core/kind"
 
 	"github.com/neilotoole/sq/libsq/core/errz"
@@ -132,3 +134,46 @@ func replacePlaceholders(input string) string {
 
 	return sb.String()
 }
+
+// renderFuncRound renders round. Postgres's two-argument round
+// is defined only for NUMERIC, so the value is cast.
+func renderFuncRound(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	if len(args) == 1 {
+		return "round(" + args[0] + ")", nil
+	}
+	return "round(CAST(" + args[0] + " AS NUMERIC), " + args[1] + ")", nil
+}
+
+// renderFuncConcat renders concat with each argument cast to TEXT. The
+// arguments of Postgres's concat are of type "any", so Postgres can't
+// otherwise determine the type of a bound parameter argument.
+func renderFuncConcat(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range args {
+		args[i] = "CAST(" + args[i] + " AS TEXT)"
+	}
+	return "concat(" + strings.Join(args, ", ") + ")", nil
+}
+
+// renderFuncPercentile returns a render.Renderer.FunctionOverrides func
+// that wraps next, for median and percentile_cont. In Postgres, those are
+// ordered-set aggregates, which can't be used as window functions.
+func renderFuncPercentile(next func(*render.Context, *ast.FuncNode) (string, error),
+) func(*render.Context, *ast.FuncNode) (string, error) {
+	return func(rc *render.Context, fn *ast.FuncNode) (string, error) {
+		if fn.Window() != nil {
+			return "", errz.Errorf("function %s: SQL dialect %s doesn't support it as a window function",
+				fn.FuncName(), rc.Dialect.Type)
+		}
+		return next(rc, fn)
+	}
+}
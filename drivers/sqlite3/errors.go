@@ -1,8 +1,11 @@
 package sqlite3
 
 import (
+	"errors"
 	"strings"
 
+	"github.com/mattn/go-sqlite3"
+
 	"github.com/neilotoole/sq/libsq/core/errz"
 )
 
@@ -18,7 +21,24 @@ func errw(err error) error {
 		// The sqlite driver always returns sqlite3.ErrError(1), so
 		// we need to search by string. Needs further investigation.
 		return errz.NotExist(err)
+	case hasErrCode(err, sqlite3.ErrReadonly), hasErrCode(err, sqlite3.ErrPerm):
+		return errz.NoPermission(err)
 	default:
 		return errz.Err(err)
 	}
 }
+
+// hasErrCode returns true if err (or its cause error)
+// is of type sqlite3.Error and err.Code equals code.
+func hasErrCode(err error, code sqlite3.ErrNo) bool {
+	if err == nil {
+		return false
+	}
+
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == code
+	}
+
+	return false
+}
@@ -0,0 +1,71 @@
+package sqlite3
+
+import (
+	"context"
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/sqlz"
+)
+
+// Explain implements driver.SQLDriver. The plan is the output of
+// EXPLAIN QUERY PLAN, rendered as a tree, as the sqlite3 shell does.
+//
+//	QUERY PLAN
+//	|--SCAN actor
+//	`--USE TEMP B-TREE FOR ORDER BY
+//
+// See: https://www.sqlite.org/eqp.html
+func (d *driveri) Explain(ctx context.Context, db sqlz.DB, query string, args ...any) (string, error) {
+	rows, err := db.QueryContext(ctx, "EXPLAIN QUERY PLAN "+query, args...)
+	if err != nil {
+		return "", errz.Wrapf(errw(err), "explain: %s", query)
+	}
+	defer lg.WarnIfCloseError(lg.FromContext(ctx), lgm.CloseDBRows, rows)
+
+	// The rows are in tree order: each row's parent precedes it.
+	type step struct {
+		id, parent int64
+		detail     string
+	}
+
+	var (
+		steps    []step
+		children = map[int64][]int{}
+		notUsed  int64
+	)
+
+	for rows.Next() {
+		var s step
+		if err = rows.Scan(&s.id, &s.parent, &notUsed, &s.detail); err != nil {
+			return "", errw(err)
+		}
+		children[s.parent] = append(children[s.parent], len(steps))
+		steps = append(steps, s)
+	}
+	if err = rows.Err(); err != nil {
+		return "", errw(err)
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString("QUERY PLAN")
+
+	var writeChildren func(parent int64, indent string)
+	writeChildren = func(parent int64, indent string) {
+		kids := children[parent]
+		for i, k := range kids {
+			branch, nextIndent := "|--", indent+"|  "
+			if i == len(kids)-1 {
+				branch, nextIndent = "`--", indent+"   "
+			}
+
+			sb.WriteString("\n" + indent + branch + steps[k].detail)
+			writeChildren(steps[k].id, nextIndent)
+		}
+	}
+	writeChildren(0, "")
+
+	return sb.String(), nil
+}
@@ -0,0 +1,199 @@
+package sqlite3
+
+import (
+	"database/sql"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	gosqlite3 "github.com/mattn/go-sqlite3"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+// dbDrvrFuncs is the name that the backing sqlite3 SQL driver is
+// registered under, with the Go implementations of the aggregate
+// functions that SQLite lacks. See registerFuncs.
+const dbDrvrFuncs = "sqlite3_sq"
+
+func init() { //nolint:gochecknoinits
+	sql.Register(dbDrvrFuncs, &gosqlite3.SQLiteDriver{ConnectHook: registerFuncs})
+}
+
+// registerFuncs registers the Go-implemented aggregate functions
+// with conn. SQLite doesn't provide the statistical aggregates that
+// the other dialects do, but SLQ's median, percentile_cont, stddev and
+// variance functions render as these functions for SQLite. Note that
+// every document source (CSV, XLSX, JSON, etc.) is backed by SQLite.
+func registerFuncs(conn *gosqlite3.SQLiteConn) error {
+	aggs := []struct {
+		name string
+		impl any
+	}{
+		{"median", newMedianAgg},
+		{"percentile_cont", newPercentileContAgg},
+		{"stddev_samp", newStddevSampAgg},
+		{"var_samp", newVarSampAgg},
+	}
+
+	for _, agg := range aggs {
+		if err := conn.RegisterAggregator(agg.name, agg.impl, true); err != nil {
+			return errz.Wrapf(err, "sqlite3: register aggregate function %s", agg.name)
+		}
+	}
+
+	return nil
+}
+
+// aggFloat returns v, an argument of an aggregate function, as a float.
+// If v is null, ok is false. Numeric text is parsed; any other text
+// is an error. Note that the backing sqlite3 driver passes null as a
+// nil []byte.
+func aggFloat(fnName string, v any) (f float64, ok bool, err error) {
+	switch v := v.(type) {
+	case nil:
+		return 0, false, nil
+	case int64:
+		return float64(v), true, nil
+	case float64:
+		return v, true, nil
+	case bool:
+		if v {
+			return 1, true, nil
+		}
+		return 0, true, nil
+	case string:
+		if f, err = strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return f, true, nil
+		}
+	case []byte:
+		if v == nil {
+			return 0, false, nil
+		}
+		if f, err = strconv.ParseFloat(strings.TrimSpace(string(v)), 64); err == nil {
+			return f, true, nil
+		}
+	}
+
+	return 0, false, errz.Errorf("%s: value is not a number: %v", fnName, v)
+}
+
+// percentileAgg implements the percentile_cont aggregate function,
+// which computes the value at a fraction of the sorted values,
+// interpolating between adjacent values as necessary.
+type percentileAgg struct {
+	name     string
+	vals     []float64
+	fraction float64
+}
+
+func newMedianAgg() *medianAgg {
+	return &medianAgg{agg: percentileAgg{name: "median", fraction: 0.5}}
+}
+
+func newPercentileContAgg() *percentileAgg {
+	return &percentileAgg{name: "percentile_cont"}
+}
+
+// Step implements the aggregator interface. The fraction is
+// an argument of each step, but only the first is used.
+func (a *percentileAgg) Step(v, fraction any) error {
+	if len(a.vals) == 0 {
+		f, ok, err := aggFloat(a.name, fraction)
+		if err != nil {
+			return err
+		}
+		if !ok || f < 0 || f > 1 {
+			return errz.Errorf("%s: fraction must be between 0 and 1, but got: %v", a.name, fraction)
+		}
+		a.fraction = f
+	}
+
+	return a.add(v)
+}
+
+func (a *percentileAgg) add(v any) error {
+	f, ok, err := aggFloat(a.name, v)
+	if err != nil || !ok {
+		return err
+	}
+
+	a.vals = append(a.vals, f)
+	return nil
+}
+
+// Done implements the aggregator interface. If there
+// are no (non-null) values, the result is null.
+func (a *percentileAgg) Done() any {
+	if len(a.vals) == 0 {
+		return nil
+	}
+
+	slices.Sort(a.vals)
+	pos := a.fraction * float64(len(a.vals)-1)
+	lo, hi := int(math.Floor(pos)), int(math.Ceil(pos))
+	return a.vals[lo] + (a.vals[hi]-a.vals[lo])*(pos-float64(lo))
+}
+
+// medianAgg implements the median aggregate function, which is
+// percentile_cont with a fraction of 0.5.
+type medianAgg struct {
+	agg percentileAgg
+}
+
+// Step implements the aggregator interface.
+func (a *medianAgg) Step(v any) error {
+	return a.agg.add(v)
+}
+
+// Done implements the aggregator interface.
+func (a *medianAgg) Done() any {
+	return a.agg.Done()
+}
+
+// varianceAgg computes the sample variance of its values,
+// using Welford's online algorithm.
+type varianceAgg struct {
+	name string
+	n    int64
+	mean float64
+	m2   float64
+	sqrt bool
+}
+
+func newStddevSampAgg() *varianceAgg {
+	return &varianceAgg{name: "stddev_samp", sqrt: true}
+}
+
+func newVarSampAgg() *varianceAgg {
+	return &varianceAgg{name: "var_samp"}
+}
+
+// Step implements the aggregator interface.
+func (a *varianceAgg) Step(v any) error {
+	f, ok, err := aggFloat(a.name, v)
+	if err != nil || !ok {
+		return err
+	}
+
+	a.n++
+	delta := f - a.mean
+	a.mean += delta / float64(a.n)
+	a.m2 += delta * (f - a.mean)
+	return nil
+}
+
+// Done implements the aggregator interface. As with the other
+// dialects, the result is null if there are fewer than two values.
+func (a *varianceAgg) Done() any {
+	if a.n < 2 {
+		return nil
+	}
+
+	variance := a.m2 / float64(a.n-1)
+	if a.sqrt {
+		return math.Sqrt(variance)
+	}
+	return variance
+}
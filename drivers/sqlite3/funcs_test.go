@@ -0,0 +1,44 @@
+package sqlite3_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+)
+
+// TestStatisticalAggregateFuncs verifies the Go-implemented
+// aggregate functions that SQLite lacks.
+func TestStatisticalAggregateFuncs(t *testing.T) {
+	t.Parallel()
+
+	const tpl = `SELECT median(v), percentile_cont(v, 0.25), stddev_samp(v), var_samp(v)
+	FROM (SELECT 1 AS v UNION ALL SELECT 2 UNION ALL SELECT 3.0 UNION ALL SELECT 4 UNION ALL SELECT NULL)`
+
+	th := testh.New(t)
+	src := th.Source(sakila.SL3)
+
+	sink, err := th.QuerySQL(src, tpl)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(sink.Recs))
+
+	rec := sink.Recs[0]
+	require.Equal(t, 2.5, rec[0])
+	require.Equal(t, 1.75, rec[1])
+	require.InDelta(t, math.Sqrt(5.0/3.0), rec[2], 0.000001)
+	require.InDelta(t, 5.0/3.0, rec[3], 0.000001)
+
+	// With no values, the result is null.
+	sink, err = th.QuerySQL(src, tpl+" WHERE v > 10")
+	require.NoError(t, err)
+	require.Equal(t, []any{nil, nil, nil, nil}, []any(sink.Recs[0]))
+
+	_, err = th.QuerySQL(src, `SELECT percentile_cont(amount, 2) FROM payment`)
+	require.ErrorContains(t, err, "fraction must be between 0 and 1")
+
+	_, err = th.QuerySQL(src, `SELECT median(first_name) FROM actor`)
+	require.ErrorContains(t, err, "value is not a number")
+}
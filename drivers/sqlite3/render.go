@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"strings"
 
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/ast/render"
 	"github.com/neilotoole/sq/libsq/core/kind"
 
 	"github.com/neilotoole/sq/libsq/core/errz"
 	"github.com/neilotoole/sq/libsq/core/sqlmodel"
+	"github.com/neilotoole/sq/libsq/core/stringz"
 )
 
 // createTblKindDefaults is a mapping of Kind to the value
@@ -136,3 +139,205 @@ func buildUpdateStmt(tbl string, cols []string, where string) (string, error) {
 
 	return buf.String(), nil
 }
+
+// renderIs renders a null-safe comparison using SQLite's "IS" operator,
+// which, unlike standard SQL, accepts any right-hand side.
+func renderIs(rc *render.Context, n *ast.IsNode) (string, error) {
+	lhs, err := rc.Renderer.Expr(rc, n.Left())
+	if err != nil {
+		return "", err
+	}
+
+	rhs, err := rc.Renderer.Expr(rc, n.Right())
+	if err != nil {
+		return "", err
+	}
+
+	if n.Negated() {
+		return lhs + " IS NOT " + rhs, nil
+	}
+	return lhs + " IS " + rhs, nil
+}
+
+// renderFuncConcat renders concat using the "||" operator. Like the
+// other dialects, a null argument is treated as the empty string.
+func renderFuncConcat(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range args {
+		args[i] = "coalesce(" + args[i] + ", '')"
+	}
+	return "(" + strings.Join(args, " || ") + ")", nil
+}
+
+// renderFuncCeil renders ceil without relying on SQLite's
+// math functions, which are a compile-time option.
+func renderFuncCeil(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	x := args[0]
+	return "(CAST(" + x + " AS INTEGER) + (" + x + " > CAST(" + x + " AS INTEGER)))", nil
+}
+
+// renderFuncFloor renders floor without relying on SQLite's
+// math functions, which are a compile-time option.
+func renderFuncFloor(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	x := args[0]
+	return "(CAST(" + x + " AS INTEGER) - (" + x + " < CAST(" + x + " AS INTEGER)))", nil
+}
+
+func renderFuncNow(_ *render.Context, _ *ast.FuncNode) (string, error) {
+	return "datetime('now')", nil
+}
+
+// dateTruncFormats is a map of date unit to the strftime
+// format that truncates a datetime to that unit.
+var dateTruncFormats = map[string]string{
+	render.UnitYear:   "%Y-01-01 00:00:00",
+	render.UnitMonth:  "%Y-%m-01 00:00:00",
+	render.UnitDay:    "%Y-%m-%d 00:00:00",
+	render.UnitHour:   "%Y-%m-%d %H:00:00",
+	render.UnitMinute: "%Y-%m-%d %H:%M:00",
+	render.UnitSecond: "%Y-%m-%d %H:%M:%S",
+}
+
+func renderFuncDateTrunc(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	unit, err := render.FuncUnitArg(fn, 0)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "strftime('" + dateTruncFormats[unit] + "', " + args[1] + ")", nil
+}
+
+// extractFormats is a map of date unit to the strftime
+// format that extracts that unit from a datetime.
+var extractFormats = map[string]string{
+	render.UnitYear:   "%Y",
+	render.UnitMonth:  "%m",
+	render.UnitDay:    "%d",
+	render.UnitHour:   "%H",
+	render.UnitMinute: "%M",
+	render.UnitSecond: "%S",
+}
+
+func renderFuncExtract(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	unit, err := render.FuncUnitArg(fn, 0)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "CAST(strftime('" + extractFormats[unit] + "', " + args[1] + ") AS INTEGER)", nil
+}
+
+// renderFuncDateAdd renders date_add using a datetime modifier,
+// e.g. "datetime(rental_date, 7 || ' days')".
+func renderFuncDateAdd(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	unit, err := render.FuncUnitArg(fn, 2)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "datetime(" + args[0] + ", " + args[1] + " || ' " + unit + "s')", nil
+}
+
+// renderFuncCast returns a cast override func that wraps next. SQLite
+// has no date or time types: CAST(x AS DATETIME) has numeric affinity,
+// and yields only the year. Thus the date and time kinds are rendered
+// using the date(), datetime() and time() functions instead.
+func renderFuncCast(next func(*render.Context, *ast.FuncNode) (string, error),
+) func(*render.Context, *ast.FuncNode) (string, error) {
+	return func(rc *render.Context, fn *ast.FuncNode) (string, error) {
+		knd, err := render.FuncKindArg(fn, 1)
+		if err != nil {
+			return "", err
+		}
+
+		var fnName string
+		switch knd { //nolint:exhaustive
+		case kind.Datetime:
+			fnName = "datetime"
+		case kind.Date:
+			fnName = "date"
+		case kind.Time:
+			fnName = "time"
+		default:
+			return next(rc, fn)
+		}
+
+		args, err := render.FuncArgs(rc, fn)
+		if err != nil {
+			return "", err
+		}
+
+		return fnName + "(" + args[0] + ")", nil
+	}
+}
+
+// renderFuncMedian renders median as the Go-implemented
+// aggregate function of the same name: see registerFuncs.
+func renderFuncMedian(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "median(" + args[0] + ")", nil
+}
+
+// renderFuncPercentileCont renders percentile_cont as the Go-implemented
+// aggregate function of the same name, e.g. "percentile_cont(amount, 0.9)".
+func renderFuncPercentileCont(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	fraction, err := render.FuncFractionArg(fn, 1)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "percentile_cont(" + args[0] + ", " + fraction + ")", nil
+}
+
+// renderFuncStringAgg renders string_agg using group_concat.
+func renderFuncStringAgg(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	sep, err := render.FuncStringArg(fn, 1, ",")
+	if err != nil {
+		return "", err
+	}
+
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "group_concat(" + args[0] + ", " + stringz.SingleQuote(sep) + ")", nil
+}
@@ -146,7 +146,7 @@ func (d *driveri) doOpen(ctx context.Context, src *source.Source) (*sql.DB, erro
 	if err != nil {
 		return nil, err
 	}
-	db, err := sql.Open(dbDrvr, dsn)
+	db, err := sql.Open(dbDrvrFuncs, dsn)
 	if err != nil {
 		return nil, errz.Wrapf(errw(err), "failed to open sqlite3 source with DSN: %s", dsn)
 	}
@@ -237,7 +237,22 @@ func placeholders(numCols, numRows int) string {
 
 // Renderer implements driver.SQLDriver.
 func (d *driveri) Renderer() *render.Renderer {
-	return render.NewDefaultRenderer()
+	r := render.NewDefaultRenderer()
+	r.Is = renderIs
+	r.FunctionOverrides["concat"] = renderFuncConcat
+	r.FunctionOverrides["ceil"] = renderFuncCeil
+	r.FunctionOverrides["floor"] = renderFuncFloor
+	r.FunctionOverrides["now"] = renderFuncNow
+	r.FunctionOverrides["date_trunc"] = renderFuncDateTrunc
+	r.FunctionOverrides["extract"] = renderFuncExtract
+	r.FunctionOverrides["date_add"] = renderFuncDateAdd
+	r.FunctionOverrides["cast"] = renderFuncCast(r.FunctionOverrides["cast"])
+	r.FunctionOverrides["median"] = renderFuncMedian
+	r.FunctionOverrides["percentile_cont"] = renderFuncPercentileCont
+	r.FunctionOverrides["string_agg"] = renderFuncStringAgg
+	r.TypeName = DBTypeForKind
+	r.Groupings = render.GroupingsUnionAll
+	return r
 }
 
 // CopyTable implements driver.SQLDriver.
@@ -832,7 +847,9 @@ func (d *database) SourceMetadata(ctx context.Context, noSchema bool) (*source.M
 
 	md.DBProduct = "SQLite3 v" + md.DBVersion
 
-	fi, err := os.Stat(dsn)
+	// The DSN may have connection params, e.g. "sakila.db?mode=ro".
+	fpath, _, _ := strings.Cut(dsn, "?")
+	fi, err := os.Stat(fpath)
 	if err != nil {
 		return nil, errw(err)
 	}
@@ -16,6 +16,8 @@ const (
 	errCodeIdentityInsert int32 = 544
 	errCodeObjectNotExist int32 = 15009
 	errCodeBadObject      int32 = 208
+	errCodePermission     int32 = 262
+	errCodeReadOnlyDB     int32 = 3906
 )
 
 // hasErrCode returns true if err (or its cause err) is
@@ -43,6 +45,8 @@ func errw(err error) error {
 		return nil
 	case hasErrCode(err, errCodeBadObject):
 		return errz.NotExist(err)
+	case hasErrCode(err, errCodePermission), hasErrCode(err, errCodeReadOnlyDB):
+		return errz.NoPermission(err)
 	default:
 		return errz.Err(err)
 	}
@@ -0,0 +1,69 @@
+package sqlserver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/sqlz"
+)
+
+// Explain implements driver.SQLDriver. The plan is the text output of
+// SET SHOWPLAN_TEXT, which is in effect only for the connection: this
+// is why db must be a single connection. While SHOWPLAN_TEXT is on,
+// the query isn't executed: instead, SQL Server returns a result set
+// with the query's text, followed by a result set with the plan's
+// steps, which are returned as lines of the plan.
+//
+// See: https://learn.microsoft.com/en-us/sql/t-sql/statements/set-showplan-text-transact-sql
+func (d *driveri) Explain(ctx context.Context, db sqlz.DB, query string, args ...any) (plan string, err error) {
+	if _, err = db.ExecContext(ctx, "SET SHOWPLAN_TEXT ON"); err != nil {
+		return "", errz.Wrap(errw(err), "explain: enable SHOWPLAN_TEXT")
+	}
+
+	defer func() {
+		// The connection may be returned to the pool, so SHOWPLAN_TEXT
+		// must be turned off, even if ctx is done.
+		_, offErr := db.ExecContext(context.WithoutCancel(ctx), "SET SHOWPLAN_TEXT OFF")
+		if offErr != nil {
+			lg.FromContext(ctx).Warn("Failed to disable SHOWPLAN_TEXT", lga.Err, offErr)
+			if err == nil {
+				err = errz.Wrap(errw(offErr), "explain: disable SHOWPLAN_TEXT")
+			}
+		}
+	}()
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return "", errz.Wrapf(errw(err), "explain: %s", query)
+	}
+	defer lg.WarnIfCloseError(lg.FromContext(ctx), lgm.CloseDBRows, rows)
+
+	var lines []string
+	for i := 0; ; i++ {
+		for rows.Next() {
+			var stmtText string
+			if err = rows.Scan(&stmtText); err != nil {
+				return "", errw(err)
+			}
+
+			if i > 0 {
+				// The first result set is the query text.
+				lines = append(lines, strings.TrimRight(stmtText, " \n"))
+			}
+		}
+
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return "", errw(err)
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
@@ -13,11 +13,15 @@ import (
 	"github.com/neilotoole/sq/libsq/core/sqlmodel"
 )
 
-func renderRange(_ *render.Context, rr *ast.RowRangeNode) (string, error) {
+func renderRange(rc *render.Context, rr *ast.RowRangeNode) (string, error) {
 	if rr == nil {
 		return "", nil
 	}
 
+	if rr.Negative() {
+		return renderRangeNegative(rc, rr)
+	}
+
 	/*
 		SELECT * FROM actor
 			ORDER BY (SELECT 0)
@@ -45,6 +49,38 @@ func renderRange(_ *render.Context, rr *ast.RowRangeNode) (string, error) {
 	return sql, nil
 }
 
+// renderRangeNegative renders a row range with a negative index, e.g.
+// ".[-10:-2]", whose OFFSET and FETCH are scalar subqueries against the
+// row count of the query. SQL Server doesn't permit FETCH NEXT 0 ROWS, so
+// if the range is empty, the OFFSET is instead pushed past the last row.
+func renderRangeNegative(rc *render.Context, rr *ast.RowRangeNode) (string, error) {
+	start, end := render.RangeBounds(rr)
+	_, _, open := rr.Bounds()
+
+	offsetExpr := start
+	if !open {
+		offsetExpr = fmt.Sprintf("CASE WHEN %s > %s THEN %s ELSE COUNT(*) END", end, start, start)
+	}
+
+	offset, err := render.RangeSubquery(rc, offsetExpr)
+	if err != nil {
+		return "", err
+	}
+
+	sql := "OFFSET " + offset + " ROWS"
+	if open {
+		return sql, nil
+	}
+
+	fetchExpr := fmt.Sprintf("CASE WHEN %s > %s THEN %s - %s ELSE 1 END", end, start, end, start)
+	fetch, err := render.RangeSubquery(rc, fetchExpr)
+	if err != nil {
+		return "", err
+	}
+
+	return sql + " FETCH NEXT " + fetch + " ROWS ONLY", nil
+}
+
 func preRender(_ *render.Context, f *render.Fragments) error {
 	// SQL Server handles range (OFFSET, LIMIT) a little differently. If the query has a range,
 	// then the ORDER BY clause is required. If ORDER BY is not specified, we use a trick (SELECT 0)
@@ -63,6 +99,156 @@ func preRender(_ *render.Context, f *render.Fragments) error {
 	return nil
 }
 
+// windowFuncsRequiringOrder is the set of window functions for which SQL Server
+// requires the OVER clause to have an ORDER BY.
+var windowFuncsRequiringOrder = map[string]struct{}{
+	"row_number":  {},
+	"rank":        {},
+	"dense_rank":  {},
+	"ntile":       {},
+	"lag":         {},
+	"lead":        {},
+	"first_value": {},
+	"last_value":  {},
+}
+
+// renderWindow implements render.Renderer.Window. SQL Server requires
+// ranking and offset window functions to have an ORDER BY in the OVER
+// clause. If no ordering is specified, the (SELECT NULL) trick is used, e.g.
+//
+//	ROW_NUMBER() OVER (PARTITION BY "customer_id" ORDER BY (SELECT NULL))
+func renderWindow(rc *render.Context, fn *ast.FuncNode, w *ast.WindowNode) (string, error) {
+	var defaultOrderBy string
+	if _, ok := windowFuncsRequiringOrder[strings.ToLower(fn.FuncName())]; ok {
+		defaultOrderBy = "ORDER BY (SELECT NULL)"
+	}
+	return render.OverClause(rc, w, defaultOrderBy)
+}
+
+// renderFuncPercentile returns a render.Renderer.FunctionOverrides func
+// that wraps next, for median and percentile_cont. SQL Server's
+// PERCENTILE_CONT is only a window function, so fn must have a window,
+// e.g. "median(.amount) over(partition_by(.customer_id))".
+func renderFuncPercentile(next func(*render.Context, *ast.FuncNode) (string, error),
+) func(*render.Context, *ast.FuncNode) (string, error) {
+	return func(rc *render.Context, fn *ast.FuncNode) (string, error) {
+		if fn.Window() == nil {
+			return "", errz.Errorf("function %s: SQL dialect %s supports it only as a window function: use over()",
+				fn.FuncName(), rc.Dialect.Type)
+		}
+		return next(rc, fn)
+	}
+}
+
+// renderIs returns a render.Renderer.Is func that wraps next. SQL Server
+// lacks "IS DISTINCT FROM", so comparison against a non-null value makes
+// use of INTERSECT, which treats null values as equal, e.g.
+//
+//	EXISTS (SELECT "address2" INTERSECT SELECT "address")
+func renderIs(next func(*render.Context, *ast.IsNode) (string, error),
+) func(*render.Context, *ast.IsNode) (string, error) {
+	return func(rc *render.Context, n *ast.IsNode) (string, error) {
+		if render.IsNullLiteral(n.Left()) || render.IsNullLiteral(n.Right()) {
+			return next(rc, n)
+		}
+
+		lhs, err := rc.Renderer.Expr(rc, n.Left())
+		if err != nil {
+			return "", err
+		}
+
+		rhs, err := rc.Renderer.Expr(rc, n.Right())
+		if err != nil {
+			return "", err
+		}
+
+		sql := "EXISTS (SELECT " + lhs + " INTERSECT SELECT " + rhs + ")"
+		if n.Negated() {
+			sql = "NOT " + sql
+		}
+		return sql, nil
+	}
+}
+
+// renderFuncSubstr renders substr using SUBSTRING. Unlike substr
+// elsewhere, SQL Server's SUBSTRING requires the length argument.
+func renderFuncSubstr(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	if len(args) == 2 {
+		args = append(args, "LEN("+args[0]+")")
+	}
+	return "SUBSTRING(" + strings.Join(args, ", ") + ")", nil
+}
+
+// renderFuncRound renders round. SQL Server's ROUND
+// requires the length argument.
+func renderFuncRound(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	if len(args) == 1 {
+		args = append(args, "0")
+	}
+	return "round(" + strings.Join(args, ", ") + ")", nil
+}
+
+// renderFuncDateTrunc renders date_trunc using the DATEADD/DATEDIFF
+// idiom, e.g. "DATEADD(month, DATEDIFF(month, 0, x), 0)". The difference
+// in seconds from the zero date (1900) would overflow, so a later
+// base date is used for seconds.
+func renderFuncDateTrunc(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	unit, err := render.FuncUnitArg(fn, 0)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	base := "0"
+	if unit == render.UnitSecond {
+		base = "'2000-01-01'"
+	}
+
+	return "DATEADD(" + unit + ", DATEDIFF(" + unit + ", " + base + ", " + args[1] + "), " + base + ")", nil
+}
+
+func renderFuncExtract(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	unit, err := render.FuncUnitArg(fn, 0)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "DATEPART(" + unit + ", " + args[1] + ")", nil
+}
+
+func renderFuncDateAdd(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	unit, err := render.FuncUnitArg(fn, 2)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := render.FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "DATEADD(" + unit + ", " + args[1] + ", " + args[0] + ")", nil
+}
+
 func dbTypeNameFromKind(knd kind.Kind) string {
 	switch knd { //nolint:exhaustive // ignore kind.Null
 	default:
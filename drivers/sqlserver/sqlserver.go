@@ -147,6 +147,22 @@ func (d *driveri) Renderer() *render.Renderer {
 
 	// Custom functions for SQLServer-specific stuff.
 	r.Range = renderRange
+	r.FunctionNames["length"] = "len"
+	r.FunctionNames["ceil"] = "ceiling"
+	r.FunctionNames["now"] = "getdate"
+	r.FunctionNames["stddev"] = "stdev"
+	r.FunctionNames["variance"] = "var"
+	r.FunctionOverrides["substr"] = renderFuncSubstr
+	r.FunctionOverrides["round"] = renderFuncRound
+	r.FunctionOverrides["date_trunc"] = renderFuncDateTrunc
+	r.FunctionOverrides["extract"] = renderFuncExtract
+	r.FunctionOverrides["date_add"] = renderFuncDateAdd
+	r.FunctionOverrides["median"] = renderFuncPercentile(r.FunctionOverrides["median"])
+	r.FunctionOverrides["percentile_cont"] = renderFuncPercentile(r.FunctionOverrides["percentile_cont"])
+	r.TypeName = dbTypeNameFromKind
+	r.Window = renderWindow
+	r.OrderByTerm = render.OrderByTermNullsEmulated
+	r.Is = renderIs(r.Is)
 	r.PreRender = preRender
 
 	return r
@@ -17,8 +17,11 @@ element
 	| orderBy
 	| rowRange
 	| uniqueFunc
+	| topFunc
 	| countFunc
 	| where
+	| setOp
+	| cte
 	| funcElement
 	| exprElement;
 
@@ -27,16 +30,105 @@ element
 
 
 
+/*
+func
+----
+
+Functions are portable: each function is rendered appropriately for the
+target database. In addition to the aggregate and window functions, SLQ
+provides a library of string, math and date functions:
+
+    upper, lower, trim, substr, length, replace, concat
+    round, abs, ceil, floor
+    now, date_trunc, extract, date_add
+    coalesce, nullif, cast
+
+The unit argument of the date functions is a string literal, one of
+"year", "month", "day", "hour", "minute" or "second".
+
+    .payment | date_trunc("month", .payment_date):month, sum(.amount) | group_by(date_trunc("month", .payment_date))
+    .payment | extract("year", .payment_date):year
+    .rental | date_add(.rental_date, 7, "day"):due_date
+
+The cast function converts a value to the DB type of a kind, one of
+"text", "int", "float", "decimal", "bool", "datetime", "date", "time"
+or "bytes". The DB type is the same as sq uses when creating a table.
+
+    .payment | cast(.amount, "int"):amount_int
+
+In addition to sum, avg, max and min, the statistical aggregate functions
+are median, percentile_cont, stddev and variance (stddev and variance are
+of the sample). The fraction argument of percentile_cont is between 0 and 1.
+The string_agg function concatenates values, with an optional string
+literal separator that defaults to ",".
+
+    .payment | .customer_id, median(.amount), percentile_cont(.amount, 0.9):p90 | group_by(.customer_id)
+    .actor | .last_name, string_agg(.first_name, ", "):first_names | group_by(.last_name)
+
+A DB-native function can be invoked using PROPRIETARY_FUNC_NAME.
+*/
 funcElement: func (alias)?;
-func: funcName '(' ( expr ( ',' expr)* | '*')? ')';
+func: funcName '(' ( expr ( ',' expr)* | '*')? ')' (window)?;
 funcName
   : 'sum'
 	| 'avg'
 	| 'max'
 	| 'min'
+	| 'median'
+	| 'percentile_cont'
+	| 'stddev'
+	| 'variance'
+	| 'string_agg'
+	| 'upper'
+	| 'lower'
+	| 'trim'
+	| 'substr'
+	| 'length'
+	| 'replace'
+	| 'concat'
+	| 'round'
+	| 'abs'
+	| 'ceil'
+	| 'floor'
+	| 'now'
+	| 'date_trunc'
+	| 'extract'
+	| 'date_add'
+	| 'coalesce'
+	| 'nullif'
+	| 'cast'
+	| 'row_number'
+	| 'rank'
+	| 'dense_rank'
+	| 'ntile'
+	| 'lag'
+	| 'lead'
+	| 'first_value'
+	| 'last_value'
 	| PROPRIETARY_FUNC_NAME
   ;
 
+/*
+window
+------
+
+The 'over' construct implements the SQL window function "OVER" clause.
+It can follow any function, although it's typically used with the ranking
+and offset functions (row_number, rank, lag, lead, etc), or to
+compute running aggregates.
+
+    .payment | .customer_id, row_number() over(partition_by(.customer_id), order_by(.payment_date-))
+    .payment | .payment_id, sum(.amount) over(order_by(.payment_date))
+    .payment | lag(.amount) over(partition_by(.customer_id, .staff_id))
+
+Both the partition_by and order_by parts are optional, but if both are
+present, partition_by must come first.
+*/
+window: 'over' '(' (partitionBy (',' orderBy)? | orderBy)? ')';
+
+PARTITION_BY: 'partition_by';
+partitionBy: PARTITION_BY '(' selector (',' selector)* ')';
+
 // PROPRIETARY_FUNC_NAME is a DB-native func, which is invoked by prefixing
 // an underscore to the func name, e.g. _date(xyz).
 PROPRIETARY_FUNC_NAME: '_' ID;
@@ -83,6 +175,103 @@ JOIN_TYPE
  ;
 
 
+/*
+setOp
+-----
+
+setOp implements SQL's compound SELECT operators: UNION, UNION ALL,
+INTERSECT and EXCEPT. The argument is a complete query, whose result
+is combined with the result of the query preceding the setOp.
+
+    @prod.actor | .first_name | union(@staging.actor | .first_name)
+    .actor | .first_name | union_all(.customer | .first_name)
+    .actor | .first_name | intersect(.customer | .first_name) | order_by(.first_name)
+
+Any order_by or row range in the outer query is applied to the
+combined result. If the argument query doesn't specify a source, the
+source of the outer query is used.
+*/
+SET_OP: 'union' | 'union_all' | 'intersect' | 'except';
+setOp: SET_OP '(' query ')';
+
+/*
+predicates
+----------
+
+The 'in', 'between', 'like' and 'is' predicates implement the SQL
+predicates of the same names. Each can be negated using 'not'.
+
+    .actor | where(.actor_id in [1, 2, 3])
+    .actor | where(.first_name not in ["PENELOPE", "NICK"])
+    .payment | where(.amount between 1 and 5)
+    .actor | where(.first_name like "PEN%")
+    .actor | where(.first_name not ilike "pen%")
+    .address | where(.address2 is null)
+    .address | where(.address2 is not .address)
+
+In a 'like' pattern, '%' matches any sequence of characters, and '_'
+matches any single character. A backslash escapes the following
+character, e.g. "100\\%". The case-sensitivity of 'like' is that of
+the database, whereas 'ilike' is always case-insensitive.
+
+The 'is' operator is a null-safe comparison: unlike '==', two null
+values are considered equal ("IS NOT DISTINCT FROM").
+*/
+IN: 'in';
+NOT: 'not';
+BETWEEN: 'between';
+AND: 'and';
+LIKE: 'like' | 'ilike';
+IS: 'is';
+
+/*
+subquery
+--------
+
+subquery is a complete query, enclosed in parentheses, used as part of an
+expression. It can be the right-hand side of the 'in' operator, or, if it
+returns a single value, it can be used in place of any other value.
+
+    .customer | where(.customer_id in (.payment | where(.amount > 10) | .customer_id))
+    .payment | where(.amount > (.payment | avg(.amount)))
+
+If the subquery doesn't specify a source, the source of the enclosing
+query is used.
+*/
+subquery: '(' query ')';
+
+/*
+conditional
+-----------
+
+conditional implements SQL's CASE expression, using jq's if-then-else
+syntax. Any number of 'elif' branches may be present, and the 'else'
+branch is optional: if it's absent, the expression evaluates to null
+when no condition matches.
+
+    .payment | .payment_id, (if .amount > 5 then "big" else "small" end):size
+    .film | .title, if .length < 60 then "short" elif .length < 120 then "medium" else "long" end
+    .film | where((if .rating == "R" then .length else 0 end) > 120)
+
+See: https://jqlang.github.io/jq/manual/v1.6/#if-then-else
+*/
+conditional: 'if' expr 'then' expr ('elif' expr 'then' expr)* ('else' expr)? 'end';
+
+/*
+cte
+---
+
+cte implements SQL's common table expression (CTE) mechanism, i.e. the
+"WITH" clause. It defines a named intermediate result, which can then be
+selected from as if it were a table.
+
+    @sakila | with(.big_spenders, .payment | where(.amount > 10) | .customer_id) | .big_spenders
+    with(.rentals, .rental | .customer_id, count:n | group_by(.customer_id)) | .rentals | where(.n > 40)
+
+The name may be referenced by subsequent cte definitions, and by subqueries.
+*/
+cte: 'with' '(' NAME ',' query ')';
+
 /*
 uniqueFunc
 ----------
@@ -92,9 +281,39 @@ uniqueFunc implements SQL's DISTINCT mechanism.
     .actor | .first_name | unique
     .actor | unique
 
-The func takes zero args.
+With zero args, the result rows are distinct across all of the selected
+columns. With column args, the result has one row for each distinct value
+of those columns, while still selecting the other columns. The row that is
+kept is the first row of each value, per the query's order_by; the result is
+ordered by the unique columns, then by the order_by terms.
+
+    .payment | unique(.customer_id) | order_by(.payment_date-)
 */
-uniqueFunc: 'unique';
+uniqueFunc: 'unique' ('(' selector (',' selector)* ')')?;
+
+/*
+topFunc
+-------
+
+topFunc selects the top N rows for each distinct value of its column args,
+per the query's order_by. For example, the three most recent payments of
+each customer:
+
+    .payment | top(3, .customer_id) | order_by(.payment_date-)
+
+If the query has a group_by or a join, the top N result rows are selected. In
+that case, the column args and order_by terms refer to the selected columns, by
+name or alias. For example, the three most rented films of each category:
+
+    .film_category:fc | join(.inventory:i, .fc.film_id == .i.film_id)
+    | join(.rental:r, .i.inventory_id == .r.inventory_id)
+    | .fc.category_id, .fc.film_id, count:rentals
+    | group_by(.fc.category_id, .fc.film_id)
+    | top(3, .category_id) | order_by(.rentals-)
+
+The result is ordered by the column args, then by the order_by terms.
+*/
+topFunc: 'top' '(' NN ',' selector (',' selector)* ')';
 
 /*
 
@@ -146,6 +365,7 @@ group_by
 The 'group_by' construct implments the SQL "GROUP BY" clause.
 
     .payment | .customer_id, sum(.amount) | group_by(.customer_id)
+    .film | (if .length < 90 then "short" else "long" end):len, count | group_by(if .length < 90 then "short" else "long" end)
 
 Syonyms:
 - 'group_by' for jq interoperability.
@@ -154,9 +374,31 @@ Syonyms:
 */
 
 GROUP_BY: 'group_by';
-groupByTerm: selector | func;
+groupByTerm: selector | func | conditional | grouping;
 groupBy: GROUP_BY '(' groupByTerm (',' groupByTerm)* ')';
 
+/*
+grouping
+--------
+
+grouping implements SQL's ROLLUP, CUBE and GROUPING SETS, which add
+subtotal rows to a group_by. In a subtotal row, the columns that the row
+isn't grouped by are null.
+
+    .payment | .customer_id, .staff_id, sum(.amount) | group_by(rollup(.customer_id, .staff_id))
+    .payment | .customer_id, .staff_id, sum(.amount) | group_by(cube(.customer_id, .staff_id))
+    .payment | .customer_id, .staff_id, sum(.amount) | group_by(grouping_sets((.customer_id, .staff_id), .staff_id, ()))
+
+A grouping can be combined with regular group_by terms, e.g.
+"group_by(.customer_id, rollup(.staff_id))".
+*/
+grouping
+  : ('rollup' | 'cube') '(' groupingTerm (',' groupingTerm)* ')'
+  | 'grouping_sets' '(' groupingSet (',' groupingSet)* ')'
+  ;
+groupingTerm: selector | func | conditional;
+groupingSet: groupingTerm | '(' (groupingTerm (',' groupingTerm)*)? ')';
+
 /*
 order_by
 ------
@@ -167,7 +409,14 @@ The 'order_by' construct implements the SQL "ORDER BY" clause.
     .actor | order_by(.first_name+)
     .actor | order_by(.actor.first_name-)
 
-The optional plus/minus tokens specify ASC or DESC order.
+The optional plus/minus tokens specify ASC or DESC order. A term can be
+an expression or function, and the optional nulls_first or nulls_last
+modifier specifies where nulls are ordered.
+
+    .payment | order_by((.amount * 2)-)
+    .film | order_by(length(.title)-, .title)
+    .address | order_by(.address2 nulls_last)
+    .address | order_by(.address2- nulls_first)
 
 Synonyms:
 
@@ -182,7 +431,9 @@ as a no-op.
 ORDER_ASC: '+';
 ORDER_DESC: '-';
 ORDER_BY: 'order_by' | 'sort_by';
-orderByTerm: selector (ORDER_ASC | ORDER_DESC)?;
+NULLS_FIRST: 'nulls_first';
+NULLS_LAST: 'nulls_last';
+orderByTerm: expr (ORDER_ASC | ORDER_DESC)? (NULLS_FIRST | NULLS_LAST)?;
 orderBy: ORDER_BY '(' orderByTerm (',' orderByTerm)* ')';
 
 // selector specfies a table name, a column name, or table.column.
@@ -203,7 +454,11 @@ selector: NAME (NAME)?;
 // - ."actor".first_name
 selectorElement: (selector) (alias)?;
 
-alias: ALIAS_RESERVED | ':' (ARG | ID | STRING);
+alias: ALIAS_RESERVED | ':' (ARG | ID | STRING | funcName | aliasKeyword);
+// aliasKeyword is the set of keywords that can be used as an alias,
+// e.g. ".first_name:is". Unlike ALIAS_RESERVED, these are matched after
+// the colon, so that an alias such as ":isbn" is still lexed as an ID.
+aliasKeyword: IN | NOT | BETWEEN | AND | LIKE | IS | 'if' | 'then' | 'elif' | 'else' | 'end' | NULLS_FIRST | NULLS_LAST | SET_OP | 'with' | 'over' | PARTITION_BY;
 // The grammar has problems dealing with "reserved" lexer tokens.
 // Basically, there's a problem with using "column:KEYWORD".
 // ALIAS_RESERVED is a hack to deal with those cases.
@@ -218,6 +473,18 @@ ALIAS_RESERVED
     | ':min'
     | ':order_by'
     | ':unique'
+    | ':top'
+    | ':rollup'
+    | ':cube'
+    | ':grouping_sets'
+    | ':row_number'
+    | ':rank'
+    | ':dense_rank'
+    | ':ntile'
+    | ':lag'
+    | ':lead'
+    | ':first_value'
+    | ':last_value'
     ;
 
 ARG: '$' ID;
@@ -241,22 +508,38 @@ handle: HANDLE;
 // - [10:15] select rows 10 thru 15
 // - [0:15] select rows 0 thru 15
 // - [:15] same as above (0 thru 15) [10:] select all rows from 10 onwards
+// - [-10:] select the last 10 rows
+// - [:-2] select all rows except the last 2
 rowRange:
 	'.[' (
-		NN COLON NN // [10:15]
-		| NN COLON // [10:]
-		| COLON NN // [:15]
-		| NN // [10]
+		rowRangeIndex COLON rowRangeIndex // [10:15]
+		| rowRangeIndex COLON // [10:]
+		| COLON rowRangeIndex // [:15]
+		| rowRangeIndex // [10]
 	)? ']';
 
+// rowRangeIndex is an index of rowRange. A negative
+// index counts back from the last row, e.g. -1 is the
+// last row. A "-10" is lexed as NUMBER rather than NN.
+rowRangeIndex: NN | NUMBER;
+
 
 exprElement: expr (alias)?;
 
+// expr is an expression. The "//" operator is the null-coalescing
+// (alternative) operator: ".a // .b" evaluates to .b if .a is null.
+// It can be chained, e.g. ".a // .b // 0", and is rendered as COALESCE.
+// As in jq, it binds more loosely than the other binary operators, thus
+// ".a // .b + 1" is ".a // (.b + 1)".
+// - .customer | .phone // .email // "n/a":contact
+// - .payment | where((.amount // 0) > 5)
 expr:
 	'(' expr ')'
 	| selector
 	| literal
 	| arg
+	| subquery
+	| conditional
 	| unaryOperator expr
 	| expr '||' expr
 	| expr ( '*' | '/' | '%') expr
@@ -264,12 +547,23 @@ expr:
 	| expr ( '<<' | '>>' | '&') expr
 	| expr ( '<' | '<=' | '>' | '>=') expr
 	| expr ( '==' | '!=' |) expr
+	| expr NOT? IN (subquery | list)
+	| expr NOT? BETWEEN expr AND expr
+	| expr NOT? LIKE expr
+	| expr IS NOT? expr
 	| expr '&&' expr
+	| expr '//' expr
 	| func
+	| countFunc
 	;
 
 literal: NN | NUMBER | STRING | NULL;
 
+// list is a list of values, used with the 'in' operator.
+// - [1, 2, 3]
+// - ["PENELOPE", "NICK"]
+list: LBRA expr (',' expr)* RBRA;
+
 unaryOperator: '-' | '+' | '~' | '!';
 
 NULL: 'null';
@@ -8,18 +8,24 @@ import (
 	"github.com/neilotoole/sq/libsq/ast/internal/slq"
 )
 
+// aliasText returns the alias specified by ctx, e.g. "name" for ":name",
+// or for ":\"name\"". The alias may also be a reserved word, such as a
+// function name, e.g. ":count".
+func aliasText(ctx slq.IAliasContext) string {
+	if ctx.STRING() != nil {
+		return stringz.StripDoubleQuote(ctx.STRING().GetText())
+	}
+
+	return strings.TrimPrefix(ctx.GetText(), ":")
+}
+
 // VisitAlias implements slq.SLQVisitor.
 func (v *parseTreeVisitor) VisitAlias(ctx *slq.AliasContext) any {
 	if ctx == nil || ctx.ID() == nil && ctx.GetText() == "" {
 		return nil
 	}
 
-	var alias string
-	if ctx.ID() != nil {
-		alias = ctx.ID().GetText()
-	} else if ctx.STRING() != nil {
-		alias = stringz.StripDoubleQuote(ctx.STRING().GetText())
-	}
+	alias := aliasText(ctx)
 
 	switch node := v.cur.(type) {
 	case *SelectorNode:
@@ -29,11 +35,6 @@ func (v *parseTreeVisitor) VisitAlias(ctx *slq.AliasContext) any {
 	case *ExprElementNode:
 		node.alias = alias
 	case *FuncNode:
-		if alias != "" {
-			node.alias = alias
-			return nil
-		}
-
 		// NOTE: The grammar has a dodgy hack to deal with no-arg funcs
 		// with an alias that is a reserved word.
 		//
@@ -50,14 +51,18 @@ func (v *parseTreeVisitor) VisitAlias(ctx *slq.AliasContext) any {
 		// Well, the grammar doesn't know how to handle this. Most likely the
 		// grammar could be refactored to deal with this more gracefully. The
 		// hack is to look at the full text of the context (e.g. ":count"),
-		// instead of just ID, and look for the alias after the colon.
-
-		text := ctx.GetText()
-		node.alias = strings.TrimPrefix(text, ":")
-
+		// instead of just ID, and look for the alias after the colon,
+		// which is what aliasText does.
+		node.alias = alias
 	default:
 		return errorf("alias not allowed for type %T: %v", node, ctx.GetText())
 	}
 
 	return nil
 }
+
+// VisitAliasKeyword implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitAliasKeyword(_ *slq.AliasKeywordContext) any {
+	// no-op: the keyword is handled by VisitAlias.
+	return nil
+}
@@ -65,6 +65,7 @@ func buildAST(log *slog.Logger, query slq.IQueryContext) (*AST, error) {
 		{typeSelectorNode, narrowTblColSel},
 		{typeSelectorNode, narrowColSel},
 		{typeRowRangeNode, verifyRowRange},
+		{typeWhereNode, narrowHaving},
 	}
 
 	for _, visitor := range visitors {
@@ -77,6 +78,22 @@ func buildAST(log *slog.Logger, query slq.IQueryContext) (*AST, error) {
 	return tree.ast, nil
 }
 
+// buildNestedAST builds an independent AST for a query that is nested
+// within another query, such as a subquery, or the argument of a set
+// operation. The nested AST's nodes are not part of the enclosing AST.
+func buildNestedAST(log *slog.Logger, query slq.IQueryContext) (*AST, error) {
+	a, err := buildAST(log, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = verify(a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
 // verify performs additional checks on the state of the built AST.
 func verify(ast *AST) error {
 	selCount := NewInspector(ast).CountNodes(typeSelectorNode)
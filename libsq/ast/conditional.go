@@ -0,0 +1,92 @@
+package ast
+
+import (
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+var _ Node = (*ConditionalNode)(nil)
+
+// ConditionalNode models a conditional (if-then-else) expression, which
+// is rendered as a SQL CASE expression. For example:
+//
+//	if .amount > 5 then "big" elif .amount > 2 then "medium" else "small" end
+//
+// The node's children are the expressions, in input order. That is to
+// say, the condition and result of each branch, followed by the
+// (optional) else expression.
+type ConditionalNode struct {
+	baseNode
+	hasElse bool
+}
+
+// Conditions returns the condition expression of each branch.
+func (n *ConditionalNode) Conditions() []Node {
+	conds := make([]Node, 0, len(n.children)/2)
+	for i := 0; i+1 < len(n.children); i += 2 {
+		conds = append(conds, n.children[i])
+	}
+	return conds
+}
+
+// Results returns the result expression of each branch. The returned
+// slice is the same length as that returned by Conditions.
+func (n *ConditionalNode) Results() []Node {
+	results := make([]Node, 0, len(n.children)/2)
+	for i := 1; i < len(n.children); i += 2 {
+		results = append(results, n.children[i])
+	}
+	return results
+}
+
+// Else returns the else expression, or nil if there is none.
+func (n *ConditionalNode) Else() Node {
+	if !n.hasElse || len(n.children) == 0 {
+		return nil
+	}
+	return n.children[len(n.children)-1]
+}
+
+// AddChild implements Node.AddChild.
+func (n *ConditionalNode) AddChild(child Node) error {
+	n.addChild(child)
+	return child.SetParent(n)
+}
+
+// SetChildren implements ast.Node.
+func (n *ConditionalNode) SetChildren(children []Node) error {
+	n.doSetChildren(children)
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *ConditionalNode) String() string {
+	return nodeString(n)
+}
+
+// VisitConditional implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitConditional(ctx *slq.ConditionalContext) any {
+	exprs := ctx.AllExpr()
+	if len(exprs) < 2 {
+		return errorf("invalid conditional expression: %s", ctx.GetText())
+	}
+
+	node := &ConditionalNode{hasElse: len(exprs)%2 == 1}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+	if err := node.SetParent(v.cur); err != nil {
+		return err
+	}
+
+	if e := v.using(node, func() any {
+		for _, exprCtx := range exprs {
+			if err := v.VisitExpr(exprCtx.(*slq.ExprContext)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); e != nil {
+		return e
+	}
+
+	return v.cur.AddChild(node)
+}
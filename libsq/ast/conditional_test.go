@@ -0,0 +1,43 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditional(t *testing.T) {
+	testCases := []struct {
+		in       string
+		wantWhen int
+		wantElse bool
+	}{
+		{in: `.film | if .length < 60 then "short" end`, wantWhen: 1},
+		{in: `.film | if .length < 60 then "short" else "long" end`, wantWhen: 1, wantElse: true},
+		{in: `.film | if .length < 60 then "short" elif .length < 120 then "medium" elif .length < 150 then "long" else .length end`, wantWhen: 3, wantElse: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.in, func(t *testing.T) {
+			a := mustParse(t, tc.in)
+			nodes := NewInspector(a).FindNodes(typeConditionalNode)
+			require.Len(t, nodes, 1)
+			cond, ok := nodes[0].(*ConditionalNode)
+			require.True(t, ok)
+
+			require.Len(t, cond.Conditions(), tc.wantWhen)
+			require.Len(t, cond.Results(), tc.wantWhen)
+			for _, node := range cond.Conditions() {
+				require.IsType(t, (*ExprNode)(nil), node)
+			}
+
+			if !tc.wantElse {
+				require.Nil(t, cond.Else())
+				return
+			}
+
+			require.NotNil(t, cond.Else())
+		})
+	}
+}
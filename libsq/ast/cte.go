@@ -0,0 +1,66 @@
+package ast
+
+import (
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+var _ Node = (*CTENode)(nil)
+
+// CTENode models a common table expression (CTE), that is, a named
+// intermediate result that is rendered as part of a SQL "WITH" clause.
+//
+//	@sakila | with(.big_spenders, .payment | where(.amount > 10) | .customer_id) | .big_spenders
+//
+// The CTE's query is a distinct AST, accessed via CTENode.Query.
+type CTENode struct {
+	baseNode
+	name  string
+	query *AST
+}
+
+// Name returns the CTE name, e.g. "big_spenders".
+func (n *CTENode) Name() string {
+	return n.name
+}
+
+// Query returns the AST of the CTE's query.
+func (n *CTENode) Query() *AST {
+	return n.query
+}
+
+// SetParent implements ast.Node.
+func (n *CTENode) SetParent(parent Node) error {
+	seg, ok := parent.(*SegmentNode)
+	if !ok {
+		return errorf("%T requires parent of type %s", n, typeSegmentNode)
+	}
+	n.parent = seg
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *CTENode) String() string {
+	return nodeString(n)
+}
+
+// VisitCte implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitCte(ctx *slq.CteContext) any {
+	node := &CTENode{}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+
+	var err error
+	if node.name, err = extractSelVal(ctx.NAME()); err != nil {
+		return err
+	}
+
+	if node.query, err = buildNestedAST(v.log, ctx.Query()); err != nil {
+		return err
+	}
+
+	if err = node.SetParent(v.cur); err != nil {
+		return err
+	}
+
+	return v.cur.AddChild(node)
+}
@@ -0,0 +1,210 @@
+package ast
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+	"github.com/neilotoole/sq/libsq/core/jointype"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+)
+
+var _ error = (*QueryError)(nil)
+
+// QueryError is an error in a SLQ query, such as a syntax error, or a
+// reference to a source, table or column that doesn't exist. In addition
+// to the error message, it holds the position of the offending text in
+// the query, and any suggested replacements for that text.
+type QueryError struct {
+	// Query is the text of the SLQ query.
+	Query string
+
+	// Kind describes the error, e.g. "syntax error" or "unknown table".
+	Kind string
+
+	// Msg is the error message, e.g. "unexpected input 'grup_by'".
+	Msg string
+
+	// Text is the offending text, e.g. "grup_by". It is empty if the
+	// error occurs at the end of the query.
+	Text string
+
+	// Line is the 1-based line number of Text in Query.
+	Line int
+
+	// Column is the 1-based column of Text in Line, in runes.
+	Column int
+
+	// Suggestions holds near matches for Text, e.g. "group_by" for
+	// "grup_by". It may be empty.
+	Suggestions []string
+}
+
+// Error implements error. The returned message includes the position
+// of the error, and any suggestions, e.g.
+//
+//	syntax error: [1:10] unexpected input 'grup_by': did you mean "group_by"?
+func (e *QueryError) Error() string {
+	s := fmt.Sprintf("%s: [%d:%d] %s", e.Kind, e.Line, e.Column, e.Msg)
+	if hint := e.Hint(); hint != "" {
+		s += ": " + hint
+	}
+	return s
+}
+
+// Hint returns text describing e.Suggestions, e.g.
+//
+//	did you mean "group_by"?
+//
+// Hint returns empty string if there are no suggestions.
+func (e *QueryError) Hint() string {
+	if len(e.Suggestions) == 0 {
+		return ""
+	}
+	return "did you mean " + strings.Join(stringz.SurroundSlice(e.Suggestions, `"`), " or ") + "?"
+}
+
+// Snippet returns the line of the query that holds the error,
+// followed by a line with carets marking the offending text, e.g.
+//
+//	.actor | grup_by(.first_name)
+//	         ^^^^^^^
+//
+// Snippet returns empty string if the position of the error is unknown.
+func (e *QueryError) Snippet() string {
+	lines := strings.Split(e.Query, "\n")
+	if e.Line < 1 || e.Line > len(lines) || e.Column < 1 {
+		return ""
+	}
+
+	line := []rune(strings.TrimSuffix(lines[e.Line-1], "\r"))
+	col := min(e.Column-1, len(line))
+
+	// Tabs are retained in the padding, so that the carets
+	// line up with the text when the snippet is printed.
+	pad := make([]rune, col)
+	for i := range pad {
+		pad[i] = ' '
+		if line[i] == '\t' {
+			pad[i] = '\t'
+		}
+	}
+
+	n := max(1, min(len([]rune(e.Text)), len(line)-col))
+	return string(line) + "\n" + string(pad) + strings.Repeat("^", n)
+}
+
+// NewNodeError returns a *QueryError of the given kind for node, which
+// is a node of the AST parsed from query. The error is positioned at the
+// text of node.
+func NewNodeError(query string, node Node, kind string, suggestions []string,
+	format string, args ...any,
+) *QueryError {
+	e := &QueryError{
+		Query:       query,
+		Kind:        kind,
+		Msg:         fmt.Sprintf(format, args...),
+		Text:        node.Text(),
+		Suggestions: suggestions,
+	}
+
+	var start, stop antlr.Token
+	switch ctx := node.context().(type) {
+	case antlr.ParserRuleContext:
+		start, stop = ctx.GetStart(), ctx.GetStop()
+	case antlr.TerminalNode:
+		start, stop = ctx.GetSymbol(), ctx.GetSymbol()
+	}
+
+	if start != nil {
+		e.Line, e.Column = start.GetLine(), start.GetColumn()+1
+		if stop != nil {
+			e.Text = inputText(query, start.GetStart(), stop.GetStop())
+		}
+	}
+
+	return e
+}
+
+// newSyntaxError returns a *QueryError for a syntax error reported by
+// the ANTLR lexer or parser. Arg tok is the offending token, which may
+// be nil, and msg is the ANTLR error message.
+func newSyntaxError(query string, tok antlr.Token, line, column int, msg string) *QueryError {
+	e := &QueryError{
+		Query:  query,
+		Kind:   "syntax error",
+		Msg:    trimExpecting(msg),
+		Line:   line,
+		Column: column + 1,
+	}
+
+	switch {
+	case tok == nil:
+		// A lexer error: the offending text is the rune at the position.
+	case tok.GetTokenType() == antlr.TokenEOF:
+		e.Msg = "unexpected end of query"
+	default:
+		e.Text = inputText(query, tok.GetStart(), tok.GetStop())
+		if identRx.MatchString(e.Text) {
+			e.Suggestions = stringz.SuggestionsFor(e.Text, keywords())
+		}
+	}
+
+	return e
+}
+
+// expectingSetRx matches the set of expected tokens that ANTLR appends
+// to some error messages, e.g. " expecting {'sum', 'avg', ...}". The
+// set is typically too large to be useful, and so is removed.
+var expectingSetRx = regexp.MustCompile(` expecting \{.*}$`)
+
+// trimExpecting returns the ANTLR error message msg, without any
+// set of expected tokens. The message "extraneous input" is
+// replaced with the less technical "unexpected input".
+func trimExpecting(msg string) string {
+	msg = expectingSetRx.ReplaceAllString(msg, "")
+	msg = strings.Replace(msg, "extraneous input", "unexpected input", 1)
+	msg = strings.Replace(msg, "mismatched input", "unexpected input", 1)
+	return msg
+}
+
+// inputText returns the text of input from rune index start to stop,
+// inclusive. It returns empty string if the indices are invalid.
+func inputText(input string, start, stop int) string {
+	runes := []rune(input)
+	if start < 0 || stop < start || stop >= len(runes) {
+		return ""
+	}
+	return string(runes[start : stop+1])
+}
+
+var identRx = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Keywords returns the SLQ keywords and function names, e.g. "where"
+// and "group_by".
+func Keywords() []string {
+	return slices.Clone(keywords())
+}
+
+// keywords returns the SLQ keywords and function names, which are used
+// to suggest replacements for a misspelled keyword.
+var keywords = sync.OnceValue(func() []string {
+	kws := []string{
+		"where", "order_by", "union", "union_all", "intersect", "except", "like", "ilike", jointype.JoinAlias,
+	}
+	for _, jt := range jointype.All() {
+		kws = append(kws, jt.String())
+	}
+
+	for _, name := range slq.NewSLQLexer(nil).LiteralNames {
+		if name = strings.Trim(name, "'"); identRx.MatchString(name) {
+			kws = append(kws, name)
+		}
+	}
+	return kws
+})
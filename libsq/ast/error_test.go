@@ -0,0 +1,117 @@
+package ast
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/neilotoole/slogt"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/testh/tutil"
+)
+
+func TestParse_QueryError(t *testing.T) {
+	testCases := []struct {
+		in              string
+		wantMsg         string
+		wantText        string
+		wantLine        int
+		wantCol         int
+		wantSuggestions []string
+	}{
+		{
+			in:              `.actor | grup_by(.first_name)`,
+			wantMsg:         "unexpected input 'grup_by'",
+			wantText:        "grup_by",
+			wantLine:        1,
+			wantCol:         10,
+			wantSuggestions: []string{"group_by"},
+		},
+		{
+			in:              "@sakila\n| .actor\n| wher(.actor_id > 1)",
+			wantMsg:         "unexpected input 'wher'",
+			wantText:        "wher",
+			wantLine:        3,
+			wantCol:         3,
+			wantSuggestions: []string{"where"},
+		},
+		{
+			in:              `.actor | lft_join(.film_actor, .actor_id)`,
+			wantMsg:         "unexpected input 'lft_join'",
+			wantText:        "lft_join",
+			wantLine:        1,
+			wantCol:         10,
+			wantSuggestions: []string{"left_join"},
+		},
+		{
+			in:       `.actor | where(.actor_id >)`,
+			wantMsg:  "unexpected input ')'",
+			wantText: ")",
+			wantLine: 1,
+			wantCol:  27,
+		},
+		{
+			in:       `.actor | .first_name,`,
+			wantMsg:  "unexpected end of query",
+			wantLine: 1,
+			wantCol:  22,
+		},
+		{
+			in:       `.actor | .first_name | "`,
+			wantMsg:  `token recognition error at: '"'`,
+			wantLine: 1,
+			wantCol:  24,
+		},
+		{
+			in:       `@sakila.actor:a | .first_name`,
+			wantMsg:  "unexpected input ':'",
+			wantText: ":",
+			wantLine: 1,
+			wantCol:  14,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(tutil.Name(i, tc.in), func(t *testing.T) {
+			_, err := Parse(slogt.New(t), tc.in)
+			require.Error(t, err)
+			t.Log(err)
+
+			var qErr *QueryError
+			require.True(t, errors.As(err, &qErr))
+			require.Equal(t, "syntax error", qErr.Kind)
+			require.Equal(t, tc.in, qErr.Query)
+			require.Equal(t, tc.wantMsg, qErr.Msg)
+			require.Equal(t, tc.wantText, qErr.Text)
+			require.Equal(t, tc.wantLine, qErr.Line)
+			require.Equal(t, tc.wantCol, qErr.Column)
+			require.Equal(t, tc.wantSuggestions, qErr.Suggestions)
+		})
+	}
+}
+
+func TestQueryError(t *testing.T) {
+	qErr := &QueryError{
+		Query:       "@sakila\n\t| .actor | grup_by(.first_name)",
+		Kind:        "syntax error",
+		Msg:         "unexpected input 'grup_by'",
+		Text:        "grup_by",
+		Line:        2,
+		Column:      13,
+		Suggestions: []string{"group_by", "order_by"},
+	}
+
+	require.Equal(t,
+		`syntax error: [2:13] unexpected input 'grup_by': did you mean "group_by" or "order_by"?`,
+		qErr.Error())
+	require.Equal(t, "\t| .actor | grup_by(.first_name)\n\t           ^^^^^^^", qErr.Snippet())
+
+	// At the end of the query, there's no offending text, but
+	// the snippet still has a caret.
+	qErr = &QueryError{Query: ".actor | .first_name,", Line: 1, Column: 22}
+	require.Equal(t, ".actor | .first_name,\n                     ^", qErr.Snippet())
+
+	qErr = &QueryError{Query: ".actor", Line: 2, Column: 1}
+	require.Empty(t, qErr.Snippet())
+}
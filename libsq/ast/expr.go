@@ -158,8 +158,9 @@ func (v *parseTreeVisitor) VisitExpr(ctx *slq.ExprContext) any {
 	// we want to elide the expression and directly add the selector.
 	// However, this may have been a bad choice? For ast.JoinNode, we
 	// want to always have its child be an ast.ExprNode.
-	// This mechanism should be revisited.
-	if _, ok := v.cur.(*JoinNode); !ok {
+	// This mechanism should be revisited. Likewise, the operands
+	// of a predicate node (e.g. ast.InNode) are always ast.ExprNode.
+	if !isExprParent(v.cur) {
 		if selCtx := ctx.Selector(); selCtx != nil {
 			selNode, err := newSelectorNode(v.cur, selCtx)
 			if err != nil {
@@ -181,6 +182,9 @@ func (v *parseTreeVisitor) VisitExpr(ctx *slq.ExprContext) any {
 	}
 
 	if e := v.using(node, func() any {
+		if pred := newPredicateNode(ctx); pred != nil {
+			return v.visitPredicate(ctx, pred)
+		}
 		return v.VisitChildren(ctx)
 	}); e != nil {
 		return e
@@ -189,6 +193,34 @@ func (v *parseTreeVisitor) VisitExpr(ctx *slq.ExprContext) any {
 	return v.cur.AddChild(node)
 }
 
+// visitPredicate visits the children of predicate expression ctx
+// (e.g. ".actor_id in [1, 2, 3]"), adding them to pred, which is
+// then added to the current node.
+func (v *parseTreeVisitor) visitPredicate(ctx *slq.ExprContext, pred Node) any {
+	if err := pred.SetParent(v.cur); err != nil {
+		return err
+	}
+
+	if e := v.using(pred, func() any {
+		return v.VisitChildren(ctx)
+	}); e != nil {
+		return e
+	}
+
+	return v.cur.AddChild(pred)
+}
+
+// isExprParent returns true if node's children
+// are always of type *ExprNode.
+func isExprParent(node Node) bool {
+	switch node.(type) {
+	case *JoinNode, *InNode, *BetweenNode, *LikeNode, *IsNode:
+		return true
+	default:
+		return false
+	}
+}
+
 func exprHasParens(ctx *slq.ExprContext) (bool, error) {
 	if ctx == nil {
 		return false, errorf("expression context is nil")
@@ -0,0 +1,411 @@
+package ast
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+	"github.com/neilotoole/sq/libsq/core/jointype"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+)
+
+// FormatLineWidth is the maximum width of a query formatted by Format,
+// beyond which the query is broken into multiple lines.
+const FormatLineWidth = 80
+
+// Format returns the canonical SLQ text of a. The canonical form has
+// consistent spacing and quoting, and uses the canonical name for
+// constructs that have synonyms, e.g. "order_by" instead of "sort_by",
+// or "left_join" instead of "ljoin". If the query is wider than
+// FormatLineWidth, each segment is placed on its own line, starting
+// with the pipe. For example:
+//
+//	@sakila | .actor
+//	| where(.actor_id > 10 && .first_name == "TOM")
+//	| .first_name, .last_name
+//	| order_by(.last_name-)
+//
+// Nested queries (subqueries, CTEs and set operations) are always
+// formatted on a single line. Note that comments are not part of
+// the AST, and thus are not present in the returned text.
+func Format(a *AST) string {
+	segs := formatSegments(a)
+	line := strings.Join(segs, " | ")
+	if len(segs) < 3 || utf8.RuneCountInString(line) <= FormatLineWidth {
+		return line
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString(segs[0])
+	for i := 1; i < len(segs); i++ {
+		if i == 1 && isHandleSegment(a.segs[0]) {
+			// Keep the handle on the same line as the table,
+			// e.g. "@sakila | .actor".
+			sb.WriteString(" | ")
+		} else {
+			sb.WriteString("\n| ")
+		}
+		sb.WriteString(segs[i])
+	}
+	return sb.String()
+}
+
+// formatInline returns the canonical SLQ text of a, on a single line.
+func formatInline(a *AST) string {
+	return strings.Join(formatSegments(a), " | ")
+}
+
+// formatSegments returns the canonical SLQ text of each segment of a.
+func formatSegments(a *AST) []string {
+	segs := make([]string, len(a.segs))
+	for i, seg := range a.segs {
+		children := seg.Children()
+		if len(children) == 0 {
+			// Some elements don't result in a node, e.g. the
+			// "select all rows" range ".[]".
+			segs[i] = seg.Text()
+			continue
+		}
+
+		elems := make([]string, len(children))
+		for j := range children {
+			elems[j] = formatNode(children[j])
+		}
+		segs[i] = strings.Join(elems, ", ")
+	}
+	return segs
+}
+
+// isHandleSegment returns true if seg consists of a single handle,
+// e.g. "@sakila".
+func isHandleSegment(seg *SegmentNode) bool {
+	children := seg.Children()
+	if len(children) != 1 {
+		return false
+	}
+	_, ok := children[0].(*HandleNode)
+	return ok
+}
+
+// formatNode returns the canonical SLQ text of node. If node is of
+// a type unknown to the formatter, the node's text is returned.
+func formatNode(node Node) string { //nolint:gocyclo
+	switch node := node.(type) {
+	case *HandleNode:
+		return node.Handle()
+	case *TblSelectorNode:
+		s := "." + formatName(node.TblName())
+		if node.handle != "" && !isHandleElsewhere(node) {
+			s = node.handle + s
+		}
+		return s + formatAlias(node.ctx)
+	case *TblColSelectorNode:
+		return "." + formatName(node.TblName()) + "." + formatName(node.ColName()) + formatAlias(node.ctx)
+	case *ColSelectorNode:
+		return "." + formatName(node.ColName()) + formatAlias(node.ctx)
+	case *SelectorNode:
+		s := "." + formatName(node.name0)
+		if node.name1 != "" {
+			s += "." + formatName(node.name1)
+		}
+		return s + formatAlias(node.ctx)
+	case *LiteralNode, *ArgNode, *OperatorNode, *RowRangeNode:
+		return node.Text()
+	case *UniqueNode:
+		if len(node.Children()) == 0 {
+			return "unique"
+		}
+		return "unique(" + formatNodes(node.Children()) + ")"
+	case *TopNode:
+		return "top(" + strconv.Itoa(node.n) + ", " + formatNodes(node.Children()) + ")"
+	case *ExprElementNode:
+		return formatNode(node.exprNode) + formatAlias(node.ctx)
+	case *ExprNode:
+		return formatExpr(node)
+	case *FuncNode:
+		return formatFunc(node)
+	case *WindowNode:
+		var parts []string
+		if node.partitionBy != nil {
+			parts = append(parts, "partition_by("+formatNodes(node.partitionBy.Children())+")")
+		}
+		if node.orderBy != nil {
+			parts = append(parts, formatNode(node.orderBy))
+		}
+		return "over(" + strings.Join(parts, ", ") + ")"
+	case *OrderByNode:
+		return "order_by(" + formatNodes(node.Children()) + ")"
+	case *OrderByTermNode:
+		s := formatNode(node.Expr())
+		switch node.direction {
+		case OrderByDirectionAsc:
+			s += "+"
+		case OrderByDirectionDesc:
+			s += "-"
+		default:
+		}
+		switch node.nulls {
+		case OrderByNullsFirst:
+			s += " nulls_first"
+		case OrderByNullsLast:
+			s += " nulls_last"
+		default:
+		}
+		return s
+	case *GroupByNode:
+		return "group_by(" + formatNodes(node.Children()) + ")"
+	case *GroupingNode:
+		return string(node.typ) + "(" + formatNodes(node.Children()) + ")"
+	case *GroupingSetNode:
+		if len(node.Children()) == 1 {
+			return formatNode(node.Children()[0])
+		}
+		return "(" + formatNodes(node.Children()) + ")"
+	case *WhereNode:
+		return "where(" + formatNodes(node.Children()) + ")"
+	case *HavingNode:
+		return "where(" + formatNodes(node.Children()) + ")"
+	case *JoinNode:
+		return formatJoin(node)
+	case *SetOpNode:
+		return string(node.op) + "(" + formatInline(node.query) + ")"
+	case *SubqueryNode:
+		return "(" + formatInline(node.query) + ")"
+	case *CTENode:
+		return "with(." + formatName(node.name) + ", " + formatInline(node.query) + ")"
+	case *ConditionalNode:
+		return formatConditional(node)
+	case *InNode:
+		s := formatNode(node.Expr()) + formatNegated(node.negated) + " in "
+		if sq := node.Subquery(); sq != nil {
+			return s + formatNode(sq)
+		}
+		list := node.List()
+		items := make([]string, len(list))
+		for i := range list {
+			items[i] = formatNode(list[i])
+		}
+		return s + "[" + strings.Join(items, ", ") + "]"
+	case *BetweenNode:
+		return formatNode(node.Expr()) + formatNegated(node.negated) + " between " +
+			formatNode(node.Low()) + " and " + formatNode(node.High())
+	case *LikeNode:
+		op := " like "
+		if node.caseInsensitive {
+			op = " ilike "
+		}
+		return formatNode(node.Expr()) + formatNegated(node.negated) + op + formatNode(node.Pattern())
+	case *IsNode:
+		s := formatNode(node.Left()) + " is "
+		if node.negated {
+			s += "not "
+		}
+		return s + formatNode(node.Right())
+	default:
+		return node.Text()
+	}
+}
+
+// formatNodes returns the canonical SLQ text of nodes, separated by commas.
+func formatNodes(nodes []Node) string {
+	strs := make([]string, len(nodes))
+	for i := range nodes {
+		strs[i] = formatNode(nodes[i])
+	}
+	return strings.Join(strs, ", ")
+}
+
+// formatNegated returns " not" if negated is true, or empty string.
+func formatNegated(negated bool) string {
+	if negated {
+		return " not"
+	}
+	return ""
+}
+
+// formatExpr returns the canonical SLQ text of expr. Operators
+// are surrounded by a single space.
+func formatExpr(expr *ExprNode) string {
+	sb := strings.Builder{}
+	if expr.parens {
+		sb.WriteRune('(')
+	}
+
+	children := expr.Children()
+	for i, child := range children {
+		if i > 0 {
+			if _, ok := child.(*OperatorNode); ok {
+				sb.WriteString(" " + child.Text() + " ")
+				continue
+			}
+
+			if _, ok := children[i-1].(*OperatorNode); !ok {
+				// Juxtaposed expressions, e.g. ".a .b".
+				sb.WriteRune(' ')
+			}
+		}
+
+		sb.WriteString(formatNode(child))
+	}
+
+	if expr.parens {
+		sb.WriteRune(')')
+	}
+	return sb.String()
+}
+
+// formatFunc returns the canonical SLQ text of fn. The no-arg form
+// of count, e.g. "count()", is formatted as "count".
+func formatFunc(fn *FuncNode) string {
+	name := fn.fnName
+	if fn.proprietary {
+		name = "_" + name
+	}
+
+	args := fn.Args()
+	if _, ok := fn.ctx.(*slq.CountFuncContext); ok && len(args) == 0 {
+		return name + formatAlias(fn.ctx)
+	}
+
+	s := name + "(" + formatNodes(args) + ")"
+	if fn.window != nil {
+		s += " " + formatNode(fn.window)
+	}
+	return s + formatAlias(fn.ctx)
+}
+
+// formatJoin returns the canonical SLQ text of join. The abbreviated
+// join types (e.g. "ljoin") are formatted using the full type name.
+func formatJoin(join *JoinNode) string {
+	typ := join.jt.String()
+	if join.jtVal == jointype.JoinAlias {
+		typ = jointype.JoinAlias
+	}
+
+	tbl := join.targetTbl
+	s := typ + "(" + tbl.handle + "." + formatName(tbl.tblName)
+	if ctx, ok := join.ctx.(*slq.JoinContext); ok && ctx.JoinTable() != nil {
+		// The table's parse tree is a terminal node, whose parent
+		// isn't accessible, so the alias is taken from the join.
+		s += formatAliasContext(ctx.JoinTable().Alias())
+	}
+
+	if join.predicateExpr != nil {
+		s += ", " + formatNode(join.predicateExpr)
+	}
+	return s + ")"
+}
+
+// formatConditional returns the canonical SLQ text of cond.
+func formatConditional(cond *ConditionalNode) string {
+	sb := strings.Builder{}
+	results := cond.Results()
+	for i, c := range cond.Conditions() {
+		if i == 0 {
+			sb.WriteString("if ")
+		} else {
+			sb.WriteString(" elif ")
+		}
+		sb.WriteString(formatNode(c))
+		sb.WriteString(" then ")
+		sb.WriteString(formatNode(results[i]))
+	}
+
+	if els := cond.Else(); els != nil {
+		sb.WriteString(" else ")
+		sb.WriteString(formatNode(els))
+	}
+
+	sb.WriteString(" end")
+	return sb.String()
+}
+
+// isHandleElsewhere returns true if the handle of tblSel was not part
+// of the selector's text, i.e. the handle was inherited from the
+// preceding segment, as in "@sakila | .actor".
+func isHandleElsewhere(tblSel *TblSelectorNode) bool {
+	switch tblSel.ctx.(type) {
+	case *slq.HandleTableContext:
+		return false
+	default:
+		return true
+	}
+}
+
+// formatAlias returns the explicit alias (if any) of the node whose
+// parse tree is ctx, formatted as ":alias". The parse tree is consulted
+// because FuncNode and ExprElementNode default their alias to the
+// node's text. If there's no explicit alias, empty string is returned.
+func formatAlias(ctx antlr.ParseTree) string {
+	var aliasCtx slq.IAliasContext
+	switch ctx := ctx.(type) {
+	case *slq.SelectorContext:
+		if parent, ok := ctx.GetParent().(*slq.SelectorElementContext); ok {
+			aliasCtx = parent.Alias()
+		}
+	case *slq.FuncContext:
+		if parent, ok := ctx.GetParent().(*slq.FuncElementContext); ok {
+			aliasCtx = parent.Alias()
+		}
+	case *slq.CountFuncContext:
+		aliasCtx = ctx.Alias()
+	case *slq.ExprElementContext:
+		aliasCtx = ctx.Alias()
+	}
+
+	return formatAliasContext(aliasCtx)
+}
+
+// formatAliasContext returns the alias of aliasCtx, formatted as
+// ":alias". If aliasCtx is nil, empty string is returned.
+func formatAliasContext(aliasCtx slq.IAliasContext) string {
+	if aliasCtx == nil {
+		return ""
+	}
+
+	var alias string
+	switch {
+	case aliasCtx.ID() != nil:
+		alias = aliasCtx.ID().GetText()
+	case aliasCtx.STRING() != nil:
+		alias = stringz.StripDoubleQuote(aliasCtx.STRING().GetText())
+	case aliasCtx.FuncName() != nil:
+		alias = aliasCtx.FuncName().GetText()
+	default:
+		// ALIAS_RESERVED, e.g. ":count".
+		alias = strings.TrimPrefix(aliasCtx.GetText(), ":")
+	}
+
+	if isPlainAlias(alias) {
+		return ":" + alias
+	}
+	return `:"` + alias + `"`
+}
+
+// plainNameRx matches a selector or alias name that need not be quoted.
+var plainNameRx = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// formatName returns name, as used in a selector such as ".first_name",
+// enclosed in double quotes if required, e.g. "first name".
+func formatName(name string) string {
+	if plainNameRx.MatchString(name) {
+		return name
+	}
+	return `"` + name + `"`
+}
+
+// isPlainAlias returns true if alias can be used without quotes. An alias
+// that is a SLQ keyword, such as "where", must be quoted.
+func isPlainAlias(alias string) bool {
+	if !plainNameRx.MatchString(alias) {
+		return false
+	}
+
+	_, err := parseSLQ(lg.Discard(), ".x:"+alias)
+	return err == nil
+}
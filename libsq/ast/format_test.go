@@ -0,0 +1,144 @@
+package ast
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/neilotoole/slogt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{in: `.actor`, want: `.actor`},
+		{in: `@sakila|.actor|.first_name,.last_name`, want: `@sakila | .actor | .first_name, .last_name`},
+		{in: `@sakila|.actor:a|.a.first_name:given`, want: `@sakila | .actor:a | .a.first_name:given`},
+		{in: `.actor | ."first_name" : "given name"`, want: `.actor | .first_name:"given name"`},
+		{in: `.actor | .first_name:"given_name"`, want: `.actor | .first_name:given_name`},
+		{in: `.actor | .first_name:"where"`, want: `.actor | .first_name:"where"`},
+		{in: `.actor | ."first name"`, want: `.actor | ."first name"`},
+		{in: `.actor | .first_name:count, .last_name:sum`, want: `.actor | .first_name:count, .last_name:sum`},
+		{in: `.actor|where(.actor_id>10&&.first_name=="TOM")`, want: `.actor | where(.actor_id > 10 && .first_name == "TOM")`},
+		{in: `.actor|select((.actor_id+1)*2>=$max)`, want: `.actor | where((.actor_id + 1) * 2 >= $max)`},
+		{in: `.address|.address2//.district//"n/a":a2`, want: `.address | .address2 // .district // "n/a":a2`},
+		{in: `.actor|sort_by(.first_name+,.last_name-)`, want: `.actor | order_by(.first_name+, .last_name-)`},
+		{in: `.payment|order_by(.amount*.customer_id -,length( .x )+ nulls_first,.y nulls_last)`, want: `.payment | order_by(.amount * .customer_id-, length(.x)+ nulls_first, .y nulls_last)`},
+		{in: `.actor | .[ 1 : 3 ]`, want: `.actor | .[1:3]`},
+		{in: `.actor | .[]`, want: `.actor | .[]`},
+		{in: `.actor | .[ -10 : ]`, want: `.actor | .[-10:]`},
+		{in: `.actor | .first_name | unique`, want: `.actor | .first_name | unique`},
+		{in: `.payment|unique( .customer_id,.staff_id )|order_by(.payment_date-)`, want: `.payment | unique(.customer_id, .staff_id) | order_by(.payment_date-)`},
+		{in: `.payment|top( 3,.customer_id )|order_by(.payment_date-)`, want: `.payment | top(3, .customer_id) | order_by(.payment_date-)`},
+		{in: `.actor | count()`, want: `.actor | count`},
+		{in: `.actor | count():n`, want: `.actor | count:n`},
+		{in: `.actor | count( .first_name ):n`, want: `.actor | count(.first_name):n`},
+		{in: `.payment|.customer_id,sum(.amount):total|group_by(.customer_id)|where(sum(.amount)>100)`, want: `.payment
+| .customer_id, sum(.amount):total
+| group_by(.customer_id)
+| where(sum(.amount) > 100)`},
+		{in: `.payment|group_by(.customer_id,rollup( .staff_id ))`, want: `.payment | group_by(.customer_id, rollup(.staff_id))`},
+		{in: `.payment|group_by(grouping_sets((.customer_id,.staff_id),(.staff_id),()))`, want: `.payment | group_by(grouping_sets((.customer_id, .staff_id), .staff_id, ()))`},
+		{in: `.payment | _strftime("%Y",.payment_date):year`, want: `.payment | _strftime("%Y", .payment_date):year`},
+		{in: `.payment | row_number() over(partition_by(.customer_id),sort_by(.payment_date-)):rn`, want: `.payment | row_number() over(partition_by(.customer_id), order_by(.payment_date-)):rn`},
+		{in: `.actor:a|ljoin(@sakila.film_actor:fa,.a.actor_id==.fa.actor_id)`, want: `.actor:a | left_join(@sakila.film_actor:fa, .a.actor_id == .fa.actor_id)`},
+		{in: `.actor|join(.film_actor,.actor_id)|xjoin(.film)`, want: `.actor | join(.film_actor, .actor_id) | cross_join(.film)`},
+		{in: `.actor|where(.actor_id not in [1,2,3])`, want: `.actor | where(.actor_id not in [1, 2, 3])`},
+		{in: `.payment|where(.amount not between 1 and 5)`, want: `.payment | where(.amount not between 1 and 5)`},
+		{in: `.actor|where(.first_name not ilike "pen%")`, want: `.actor | where(.first_name not ilike "pen%")`},
+		{in: `.address|where(.address2 is not null)`, want: `.address | where(.address2 is not null)`},
+		{in: `.customer|where(.customer_id in (.payment|where(.amount>10)|.customer_id))`, want: `.customer | where(.customer_id in (.payment | where(.amount > 10) | .customer_id))`},
+		{in: `.actor|.first_name|union_all(@other.actor|.first_name)`, want: `.actor | .first_name | union_all(@other.actor | .first_name)`},
+		{in: `with(.big,.payment|where(.amount>10))|.big`, want: `with(.big, .payment | where(.amount > 10)) | .big`},
+		{in: `.film|(if .length<60 then "short" elif .length<120 then "medium" else "long" end):len`, want: `.film | (if .length < 60 then "short" elif .length < 120 then "medium" else "long" end):len`},
+		{in: `@sakila|.actor|where(.actor_id>10&&.first_name=="TOM")|.first_name,.last_name|order_by(.last_name-)`, want: `@sakila | .actor
+| where(.actor_id > 10 && .first_name == "TOM")
+| .first_name, .last_name
+| order_by(.last_name-)`},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.in, func(t *testing.T) {
+			got := Format(mustParse(t, tc.in))
+			require.Equal(t, tc.want, got)
+
+			// The formatted query must itself be canonical.
+			require.Equal(t, got, Format(mustParse(t, got)))
+		})
+	}
+}
+
+// roundTripSkip holds the queries of the grammar testdata corpus that
+// aren't valid input to Parse, mapped to the reason why. These queries
+// predate the current grammar, e.g. the legacy join syntax.
+var roundTripSkip = map[string]string{
+	`@mydb1 | .user, .address | join( .user.uid == .address.uid) | .email, .username, .country`: "legacy join syntax",
+	`@mydb1 | .user, .address | join( .uid ) | .user.uid, .username, .country`:                  "legacy join syntax",
+	`@mydb1 | .user | .[01:002] // test with leading zeroes`:                                    "leading zeroes in row range",
+}
+
+// TestFormat_RoundTrip verifies that each query in the grammar testdata
+// corpus, when formatted, parses to an equivalent AST, and that
+// formatting is idempotent. The queries in roundTripSkip are skipped.
+func TestFormat_RoundTrip(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "grammar", "testdata", "*.slq"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			for _, query := range readQueries(t, file) {
+				if reason, ok := roundTripSkip[query]; ok {
+					t.Logf("skipping (%s): %s", reason, query)
+					continue
+				}
+
+				a := mustParse(t, query)
+				got := Format(a)
+				t.Logf("%s  -->  %s", query, got)
+
+				a2, err := Parse(slogt.New(t), got)
+				require.NoError(t, err)
+				require.Equal(t, nodeTypes(a), nodeTypes(a2))
+				require.Equal(t, got, Format(a2))
+			}
+		})
+	}
+}
+
+// readQueries returns the non-empty lines of the SLQ file at
+// path, with any trailing semicolon removed.
+func readQueries(t *testing.T, path string) []string {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+
+	var queries []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSuffix(strings.TrimSpace(sc.Text()), ";")
+		if line != "" {
+			queries = append(queries, line)
+		}
+	}
+	require.NoError(t, sc.Err())
+	return queries
+}
+
+// nodeTypes returns the types of the nodes of a, in walk order.
+func nodeTypes(a *AST) []string {
+	var types []string
+	_ = NewWalker(a).AddVisitor(reflect.TypeOf((*Node)(nil)).Elem(), func(_ *Walker, node Node) error {
+		types = append(types, reflect.TypeOf(node).String())
+		return nil
+	}).Walk()
+	return types
+}
@@ -17,6 +17,7 @@ type FuncNode struct {
 	fnName      string
 	alias       string
 	proprietary bool
+	window      *WindowNode
 }
 
 // resultColumn implements ast.ResultColumn.
@@ -39,6 +40,28 @@ func (fn *FuncNode) IsProprietary() bool {
 	return fn.proprietary
 }
 
+// Window returns the function's window ("OVER" clause), or nil
+// if the function is not a window function invocation.
+func (fn *FuncNode) Window() *WindowNode {
+	return fn.window
+}
+
+// Args returns the function's argument nodes. That is to say, the node's
+// children, excluding any WindowNode.
+func (fn *FuncNode) Args() []Node {
+	if fn.window == nil {
+		return fn.children
+	}
+
+	args := make([]Node, 0, len(fn.children))
+	for _, child := range fn.children {
+		if child != fn.window {
+			args = append(args, child)
+		}
+	}
+	return args
+}
+
 // String returns a log/debug-friendly representation.
 func (fn *FuncNode) String() string {
 	str := nodeString(fn)
@@ -67,6 +90,13 @@ func (fn *FuncNode) SetChildren(children []Node) error {
 // AddChild implements Node.
 func (fn *FuncNode) AddChild(child Node) error {
 	// TODO: add check for valid FuncNode child types
+	if w, ok := child.(*WindowNode); ok {
+		if fn.window != nil {
+			return errorf("%T can have only one %T", fn, w)
+		}
+		fn.window = w
+	}
+
 	fn.addChild(child)
 	return child.SetParent(fn)
 }
@@ -124,6 +154,7 @@ func (v *parseTreeVisitor) VisitFunc(ctx *slq.FuncContext) any {
 	node := &FuncNode{fnName: ctx.FuncName().GetText()}
 	if node.fnName[0] == '_' {
 		node.fnName = node.fnName[1:]
+		node.proprietary = true
 	}
 
 	node.ctx = ctx
@@ -6,19 +6,56 @@ import (
 	"github.com/neilotoole/sq/libsq/ast/internal/slq"
 )
 
-var groupByAllowedChildren = []reflect.Type{
+// groupingTermTypes are the types of the terms that the rows
+// can be grouped by, both in GROUP BY and in a GroupingNode.
+var groupingTermTypes = []reflect.Type{
 	typeSelectorNode,
 	typeColSelectorNode,
 	typeTblColSelectorNode,
 	typeFuncNode,
+	typeConditionalNode,
 }
 
+var groupByAllowedChildren = append([]reflect.Type{typeGroupingNode}, groupingTermTypes...)
+
 // GroupByNode models GROUP BY. The children of GroupBy node can be
-// of type selector or FuncNode.
+// of type selector, FuncNode, ConditionalNode or GroupingNode.
 type GroupByNode struct {
 	baseNode
 }
 
+// HasGroupings returns true if any of n's children is a GroupingNode,
+// i.e. if the GROUP BY produces subtotal rows.
+func (n *GroupByNode) HasGroupings() bool {
+	return len(nodesWithType(n.children, typeGroupingNode)) > 0
+}
+
+// GroupingSets returns the grouping sets of n, i.e. the lists of terms
+// that the rows are grouped by, with any GroupingNode expanded as per SQL.
+// For example, "group_by(.a, rollup(.b, .c))" has the sets (.a, .b, .c),
+// (.a, .b) and (.a). If n has no groupings, it has a single set, which
+// is its children.
+func (n *GroupByNode) GroupingSets() [][]Node {
+	sets := [][]Node{nil}
+	for _, child := range n.children {
+		childSets := [][]Node{{child}}
+		if g, ok := child.(*GroupingNode); ok {
+			childSets = g.Sets()
+		}
+
+		// Each set is combined with each of the child's sets.
+		product := make([][]Node, 0, len(sets)*len(childSets))
+		for _, set := range sets {
+			for _, childSet := range childSets {
+				product = append(product, append(append([]Node{}, set...), childSet...))
+			}
+		}
+		sets = product
+	}
+
+	return sets
+}
+
 // AddChild implements Node.
 func (n *GroupByNode) AddChild(child Node) error {
 	if err := nodesAreOnlyOfType([]Node{child}, groupByAllowedChildren...); err != nil {
@@ -64,3 +101,169 @@ func (v *parseTreeVisitor) VisitGroupBy(ctx *slq.GroupByContext) any {
 func (v *parseTreeVisitor) VisitGroupByTerm(ctx *slq.GroupByTermContext) interface{} {
 	return v.VisitChildren(ctx)
 }
+
+// GroupingType is an enum of the subtotal groupings of GROUP BY.
+type GroupingType string
+
+const (
+	// GroupingRollup is SQL's "ROLLUP".
+	GroupingRollup GroupingType = "rollup"
+
+	// GroupingCube is SQL's "CUBE".
+	GroupingCube GroupingType = "cube"
+
+	// GroupingSets is SQL's "GROUPING SETS".
+	GroupingSets GroupingType = "grouping_sets"
+)
+
+var _ Node = (*GroupingNode)(nil)
+
+// GroupingNode models a subtotal grouping term of GROUP BY, i.e.
+// "rollup(.a, .b)", "cube(.a, .b)" or "grouping_sets((.a, .b), .a, ())".
+// For rollup and cube, the children are the grouping terms (selector,
+// FuncNode or ConditionalNode). For grouping_sets, the children are
+// of type GroupingSetNode.
+type GroupingNode struct {
+	baseNode
+	typ GroupingType
+}
+
+// Type returns the grouping's type.
+func (n *GroupingNode) Type() GroupingType {
+	return n.typ
+}
+
+// Sets returns the grouping sets of n, i.e. the lists of terms that the
+// rows are grouped by. For example, "rollup(.a, .b)" has the sets
+// (.a, .b), (.a) and (); "cube(.a, .b)" has the sets (.a, .b), (.a), (.b)
+// and (). The sets are in the order that SQL defines them.
+func (n *GroupingNode) Sets() [][]Node {
+	terms := n.children
+	var sets [][]Node
+
+	switch n.typ {
+	case GroupingRollup:
+		for i := len(terms); i >= 0; i-- {
+			sets = append(sets, terms[:i:i])
+		}
+	case GroupingCube:
+		// Each bit of mask is a term, the first term being the most
+		// significant bit, so that the sets are in SQL order.
+		for mask := 1<<len(terms) - 1; mask >= 0; mask-- {
+			set := []Node{}
+			for i := range terms {
+				if mask&(1<<(len(terms)-1-i)) != 0 {
+					set = append(set, terms[i])
+				}
+			}
+			sets = append(sets, set)
+		}
+	default:
+		for _, child := range n.children {
+			sets = append(sets, child.Children())
+		}
+	}
+
+	return sets
+}
+
+// AddChild implements Node.AddChild.
+func (n *GroupingNode) AddChild(child Node) error {
+	if err := nodesAreOnlyOfType([]Node{child}, n.allowedChildren()...); err != nil {
+		return err
+	}
+
+	n.addChild(child)
+	return child.SetParent(n)
+}
+
+// SetChildren implements ast.Node.
+func (n *GroupingNode) SetChildren(children []Node) error {
+	if err := nodesAreOnlyOfType(children, n.allowedChildren()...); err != nil {
+		return err
+	}
+
+	n.doSetChildren(children)
+	return nil
+}
+
+func (n *GroupingNode) allowedChildren() []reflect.Type {
+	if n.typ == GroupingSets {
+		return []reflect.Type{typeGroupingSetNode}
+	}
+	return groupingTermTypes
+}
+
+// String returns a log/debug-friendly representation.
+func (n *GroupingNode) String() string {
+	return nodeString(n)
+}
+
+// VisitGrouping implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitGrouping(ctx *slq.GroupingContext) any {
+	node := &GroupingNode{typ: GroupingType(ctx.GetStart().GetText())}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+
+	if e := v.using(node, func() any {
+		return v.VisitChildren(ctx)
+	}); e != nil {
+		return e
+	}
+
+	return v.cur.AddChild(node)
+}
+
+// VisitGroupingTerm implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitGroupingTerm(ctx *slq.GroupingTermContext) any {
+	return v.VisitChildren(ctx)
+}
+
+var _ Node = (*GroupingSetNode)(nil)
+
+// GroupingSetNode models one of the sets of "grouping_sets", e.g.
+// "(.a, .b)", ".a", or "()". Its children are the grouping terms
+// (selector, FuncNode or ConditionalNode), and may be empty.
+type GroupingSetNode struct {
+	baseNode
+}
+
+// AddChild implements Node.AddChild.
+func (n *GroupingSetNode) AddChild(child Node) error {
+	if err := nodesAreOnlyOfType([]Node{child}, groupingTermTypes...); err != nil {
+		return err
+	}
+
+	n.addChild(child)
+	return child.SetParent(n)
+}
+
+// SetChildren implements ast.Node.
+func (n *GroupingSetNode) SetChildren(children []Node) error {
+	if err := nodesAreOnlyOfType(children, groupingTermTypes...); err != nil {
+		return err
+	}
+
+	n.doSetChildren(children)
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *GroupingSetNode) String() string {
+	return nodeString(n)
+}
+
+// VisitGroupingSet implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitGroupingSet(ctx *slq.GroupingSetContext) any {
+	node := &GroupingSetNode{}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+
+	if e := v.using(node, func() any {
+		return v.VisitChildren(ctx)
+	}); e != nil {
+		return e
+	}
+
+	return v.cur.AddChild(node)
+}
@@ -0,0 +1,61 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupByNode_GroupingSets(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want [][]string
+	}{
+		{
+			in:   `.payment | group_by(.customer_id, .staff_id)`,
+			want: [][]string{{".customer_id", ".staff_id"}},
+		},
+		{
+			in:   `.payment | group_by(rollup(.customer_id, .staff_id))`,
+			want: [][]string{{".customer_id", ".staff_id"}, {".customer_id"}, {}},
+		},
+		{
+			in:   `.payment | group_by(cube(.customer_id, .staff_id))`,
+			want: [][]string{{".customer_id", ".staff_id"}, {".customer_id"}, {".staff_id"}, {}},
+		},
+		{
+			in:   `.payment | group_by(grouping_sets((.customer_id, .staff_id), .staff_id, ()))`,
+			want: [][]string{{".customer_id", ".staff_id"}, {".staff_id"}, {}},
+		},
+		{
+			in:   `.payment | group_by(.store_id, rollup(.customer_id, .staff_id))`,
+			want: [][]string{{".store_id", ".customer_id", ".staff_id"}, {".store_id", ".customer_id"}, {".store_id"}},
+		},
+		{
+			in: `.payment | group_by(rollup(.customer_id), cube(.staff_id))`,
+			want: [][]string{
+				{".customer_id", ".staff_id"}, {".customer_id"}, {".staff_id"}, {},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.in, func(t *testing.T) {
+			gb, err := NewInspector(mustParse(t, tc.in)).FindGroupByNode()
+			require.NoError(t, err)
+			require.NotNil(t, gb)
+			require.Equal(t, len(tc.want) > 1, gb.HasGroupings())
+
+			sets := gb.GroupingSets()
+			got := make([][]string, len(sets))
+			for i, set := range sets {
+				got[i] = []string{}
+				for _, term := range set {
+					got[i] = append(got[i], term.Text())
+				}
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package ast
+
+var _ Node = (*HavingNode)(nil)
+
+// HavingNode represents a SQL HAVING clause, i.e. a filter on the
+// groups produced by GROUP BY. There is no distinct "having" construct
+// in SLQ: instead, a where() element that follows a group_by() element
+// is converted to a HavingNode.
+//
+//	.payment | .customer_id, sum(.amount) | group_by(.customer_id) | where(sum(.amount) > 100)
+type HavingNode struct {
+	baseNode
+}
+
+// String returns a log/debug-friendly representation.
+func (n *HavingNode) String() string {
+	return nodeString(n)
+}
+
+// Expr returns the expression that constitutes the HAVING clause, or nil
+// if no expression.
+func (n *HavingNode) Expr() *ExprNode {
+	if len(n.children) == 0 {
+		return nil
+	}
+
+	return n.children[0].(*ExprNode)
+}
+
+// AddChild implements Node.
+func (n *HavingNode) AddChild(node Node) error {
+	expr, ok := node.(*ExprNode)
+	if !ok {
+		return errorf("HAVING child must be %T, but got: %T", expr, node)
+	}
+
+	if len(n.children) > 0 {
+		return errorf("HAVING has max 1 child: failed to add: %T", node)
+	}
+
+	n.addChild(expr)
+	return expr.SetParent(n)
+}
+
+// SetChildren implements Node.
+func (n *HavingNode) SetChildren(children []Node) error {
+	n.children = nil
+	for _, child := range children {
+		if err := n.AddChild(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// narrowHaving converts a WhereNode into a HavingNode, if the
+// WhereNode's segment follows the segment containing the GroupByNode.
+func narrowHaving(w *Walker, node Node) error {
+	// node is guaranteed to be type WhereNode
+	where, ok := node.(*WhereNode)
+	if !ok {
+		return errorf("expected %T but got %T", where, node)
+	}
+
+	seg, ok := where.Parent().(*SegmentNode)
+	if !ok {
+		return nil
+	}
+
+	gb, err := NewInspector(w.root.(*AST)).FindGroupByNode()
+	if err != nil {
+		return err
+	}
+
+	if gb == nil {
+		return nil
+	}
+
+	gbSeg, ok := gb.Parent().(*SegmentNode)
+	if !ok || seg.SegIndex() <= gbSeg.SegIndex() {
+		// The where() precedes the group_by(), so it's a regular WHERE.
+		return nil
+	}
+
+	having := &HavingNode{}
+	having.text = where.text
+	if err = having.SetChildren(where.Children()); err != nil {
+		return err
+	}
+	if err = having.SetParent(seg); err != nil {
+		return err
+	}
+
+	return nodeReplace(where, having)
+}
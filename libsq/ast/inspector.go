@@ -2,6 +2,7 @@ package ast
 
 import (
 	"reflect"
+	"slices"
 
 	"github.com/neilotoole/sq/libsq/core/errz"
 
@@ -167,6 +168,90 @@ func (in *Inspector) FindGroupByNode() (*GroupByNode, error) {
 	return nil, nil //nolint:nilnil
 }
 
+// FindHavingNode returns the HavingNode, or nil if not found.
+func (in *Inspector) FindHavingNode() (*HavingNode, error) {
+	nodes := in.FindNodes(typeHavingNode)
+	switch len(nodes) {
+	case 0:
+		return nil, nil //nolint:nilnil
+	case 1:
+		return nodes[0].(*HavingNode), nil
+	default:
+		return nil, errorf("illegal query: only one HAVING clause allowed, but found %d", len(nodes))
+	}
+}
+
+// FindSetOpNodes returns the SetOpNode instances, in query order.
+// The returned slice may be empty.
+func (in *Inspector) FindSetOpNodes() []*SetOpNode {
+	var nodes []*SetOpNode
+	for _, seg := range in.ast.Segments() {
+		for _, node := range nodesWithType(seg.Children(), typeSetOpNode) {
+			nodes = append(nodes, node.(*SetOpNode))
+		}
+	}
+	return nodes
+}
+
+// FindCTENodes returns the CTENode instances, in query order.
+// The returned slice may be empty.
+func (in *Inspector) FindCTENodes() []*CTENode {
+	var nodes []*CTENode
+	for _, seg := range in.ast.Segments() {
+		for _, node := range nodesWithType(seg.Children(), typeCTENode) {
+			nodes = append(nodes, node.(*CTENode))
+		}
+	}
+	return nodes
+}
+
+// FindSubqueryNodes returns the SubqueryNode instances of the AST. Note
+// that subqueries nested within those subqueries are not returned.
+// The returned slice may be empty.
+func (in *Inspector) FindSubqueryNodes() []*SubqueryNode {
+	nodes := in.FindNodes(typeSubqueryNode)
+	subqueries := make([]*SubqueryNode, len(nodes))
+	for i := range nodes {
+		subqueries[i] = nodes[i].(*SubqueryNode)
+	}
+	return subqueries
+}
+
+// FindArgs returns the keys of the args referenced by the query, e.g.
+// "name" for "$name", including the args of any nested subqueries, set
+// operations and CTEs. Each key is returned once: the keys of the query
+// itself come first, followed by those of the nested queries. The returned
+// slice may be empty.
+func (in *Inspector) FindArgs() []string {
+	var keys []string
+	for _, node := range in.FindNodes(typeArgNode) {
+		if key := node.(*ArgNode).Key(); !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	var nested []*AST
+	for _, node := range in.FindSubqueryNodes() {
+		nested = append(nested, node.Query())
+	}
+	for _, node := range in.FindSetOpNodes() {
+		nested = append(nested, node.Query())
+	}
+	for _, node := range in.FindCTENodes() {
+		nested = append(nested, node.Query())
+	}
+
+	for _, a := range nested {
+		for _, key := range NewInspector(a).FindArgs() {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys
+}
+
 // FindTableSegments returns the segments that have at least one child
 // that is a ast.TblSelectorNode.
 func (in *Inspector) FindTableSegments() []*SegmentNode {
@@ -262,6 +347,15 @@ func (in *Inspector) FindUniqueNode() (*UniqueNode, error) {
 	return nodes[0].(*UniqueNode), nil
 }
 
+// FindTopNode returns any TopNode, or nil.
+func (in *Inspector) FindTopNode() (*TopNode, error) {
+	nodes := in.FindNodes(typeTopNode)
+	if len(nodes) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	return nodes[0].(*TopNode), nil
+}
+
 // FindRowRangeNode returns the single RowRangeNode, or nil.
 // An error can be returned if the AST is in an illegal state.
 func (in *Inspector) FindRowRangeNode() (*RowRangeNode, error) {
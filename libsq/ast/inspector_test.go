@@ -3,6 +3,7 @@ package ast
 import (
 	"testing"
 
+	"github.com/neilotoole/slogt"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,3 +26,29 @@ func TestInspector_findTableSegments(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, selSegs[0], finalSelSeg)
 }
+
+func TestInspector_FindArgs(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want []string
+	}{
+		{in: `.actor`, want: nil},
+		{in: `.actor | where(.first_name == $name)`, want: []string{"name"}},
+		{
+			in:   `.payment | where(.amount > $min && .amount < $max && .customer_id != $min)`,
+			want: []string{"min", "max"},
+		},
+		{
+			in:   `.customer | where(.customer_id in (.payment | where(.amount > $min) | .customer_id) && .store_id == $store)`,
+			want: []string{"store", "min"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.in, func(t *testing.T) {
+			a, err := Parse(slogt.New(t), tc.in)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, NewInspector(a).FindArgs())
+		})
+	}
+}
@@ -62,6 +62,18 @@ func (s *BaseSLQListener) EnterFuncName(ctx *FuncNameContext) {}
 // ExitFuncName is called when production funcName is exited.
 func (s *BaseSLQListener) ExitFuncName(ctx *FuncNameContext) {}
 
+// EnterWindow is called when production window is entered.
+func (s *BaseSLQListener) EnterWindow(ctx *WindowContext) {}
+
+// ExitWindow is called when production window is exited.
+func (s *BaseSLQListener) ExitWindow(ctx *WindowContext) {}
+
+// EnterPartitionBy is called when production partitionBy is entered.
+func (s *BaseSLQListener) EnterPartitionBy(ctx *PartitionByContext) {}
+
+// ExitPartitionBy is called when production partitionBy is exited.
+func (s *BaseSLQListener) ExitPartitionBy(ctx *PartitionByContext) {}
+
 // EnterJoin is called when production join is entered.
 func (s *BaseSLQListener) EnterJoin(ctx *JoinContext) {}
 
@@ -74,12 +86,42 @@ func (s *BaseSLQListener) EnterJoinTable(ctx *JoinTableContext) {}
 // ExitJoinTable is called when production joinTable is exited.
 func (s *BaseSLQListener) ExitJoinTable(ctx *JoinTableContext) {}
 
+// EnterSetOp is called when production setOp is entered.
+func (s *BaseSLQListener) EnterSetOp(ctx *SetOpContext) {}
+
+// ExitSetOp is called when production setOp is exited.
+func (s *BaseSLQListener) ExitSetOp(ctx *SetOpContext) {}
+
+// EnterSubquery is called when production subquery is entered.
+func (s *BaseSLQListener) EnterSubquery(ctx *SubqueryContext) {}
+
+// ExitSubquery is called when production subquery is exited.
+func (s *BaseSLQListener) ExitSubquery(ctx *SubqueryContext) {}
+
+// EnterConditional is called when production conditional is entered.
+func (s *BaseSLQListener) EnterConditional(ctx *ConditionalContext) {}
+
+// ExitConditional is called when production conditional is exited.
+func (s *BaseSLQListener) ExitConditional(ctx *ConditionalContext) {}
+
+// EnterCte is called when production cte is entered.
+func (s *BaseSLQListener) EnterCte(ctx *CteContext) {}
+
+// ExitCte is called when production cte is exited.
+func (s *BaseSLQListener) ExitCte(ctx *CteContext) {}
+
 // EnterUniqueFunc is called when production uniqueFunc is entered.
 func (s *BaseSLQListener) EnterUniqueFunc(ctx *UniqueFuncContext) {}
 
 // ExitUniqueFunc is called when production uniqueFunc is exited.
 func (s *BaseSLQListener) ExitUniqueFunc(ctx *UniqueFuncContext) {}
 
+// EnterTopFunc is called when production topFunc is entered.
+func (s *BaseSLQListener) EnterTopFunc(ctx *TopFuncContext) {}
+
+// ExitTopFunc is called when production topFunc is exited.
+func (s *BaseSLQListener) ExitTopFunc(ctx *TopFuncContext) {}
+
 // EnterCountFunc is called when production countFunc is entered.
 func (s *BaseSLQListener) EnterCountFunc(ctx *CountFuncContext) {}
 
@@ -104,6 +146,24 @@ func (s *BaseSLQListener) EnterGroupBy(ctx *GroupByContext) {}
 // ExitGroupBy is called when production groupBy is exited.
 func (s *BaseSLQListener) ExitGroupBy(ctx *GroupByContext) {}
 
+// EnterGrouping is called when production grouping is entered.
+func (s *BaseSLQListener) EnterGrouping(ctx *GroupingContext) {}
+
+// ExitGrouping is called when production grouping is exited.
+func (s *BaseSLQListener) ExitGrouping(ctx *GroupingContext) {}
+
+// EnterGroupingTerm is called when production groupingTerm is entered.
+func (s *BaseSLQListener) EnterGroupingTerm(ctx *GroupingTermContext) {}
+
+// ExitGroupingTerm is called when production groupingTerm is exited.
+func (s *BaseSLQListener) ExitGroupingTerm(ctx *GroupingTermContext) {}
+
+// EnterGroupingSet is called when production groupingSet is entered.
+func (s *BaseSLQListener) EnterGroupingSet(ctx *GroupingSetContext) {}
+
+// ExitGroupingSet is called when production groupingSet is exited.
+func (s *BaseSLQListener) ExitGroupingSet(ctx *GroupingSetContext) {}
+
 // EnterOrderByTerm is called when production orderByTerm is entered.
 func (s *BaseSLQListener) EnterOrderByTerm(ctx *OrderByTermContext) {}
 
@@ -134,6 +194,12 @@ func (s *BaseSLQListener) EnterAlias(ctx *AliasContext) {}
 // ExitAlias is called when production alias is exited.
 func (s *BaseSLQListener) ExitAlias(ctx *AliasContext) {}
 
+// EnterAliasKeyword is called when production aliasKeyword is entered.
+func (s *BaseSLQListener) EnterAliasKeyword(ctx *AliasKeywordContext) {}
+
+// ExitAliasKeyword is called when production aliasKeyword is exited.
+func (s *BaseSLQListener) ExitAliasKeyword(ctx *AliasKeywordContext) {}
+
 // EnterArg is called when production arg is entered.
 func (s *BaseSLQListener) EnterArg(ctx *ArgContext) {}
 
@@ -158,6 +224,12 @@ func (s *BaseSLQListener) EnterRowRange(ctx *RowRangeContext) {}
 // ExitRowRange is called when production rowRange is exited.
 func (s *BaseSLQListener) ExitRowRange(ctx *RowRangeContext) {}
 
+// EnterRowRangeIndex is called when production rowRangeIndex is entered.
+func (s *BaseSLQListener) EnterRowRangeIndex(ctx *RowRangeIndexContext) {}
+
+// ExitRowRangeIndex is called when production rowRangeIndex is exited.
+func (s *BaseSLQListener) ExitRowRangeIndex(ctx *RowRangeIndexContext) {}
+
 // EnterExprElement is called when production exprElement is entered.
 func (s *BaseSLQListener) EnterExprElement(ctx *ExprElementContext) {}
 
@@ -176,6 +248,12 @@ func (s *BaseSLQListener) EnterLiteral(ctx *LiteralContext) {}
 // ExitLiteral is called when production literal is exited.
 func (s *BaseSLQListener) ExitLiteral(ctx *LiteralContext) {}
 
+// EnterList is called when production list is entered.
+func (s *BaseSLQListener) EnterList(ctx *ListContext) {}
+
+// ExitList is called when production list is exited.
+func (s *BaseSLQListener) ExitList(ctx *ListContext) {}
+
 // EnterUnaryOperator is called when production unaryOperator is entered.
 func (s *BaseSLQListener) EnterUnaryOperator(ctx *UnaryOperatorContext) {}
 
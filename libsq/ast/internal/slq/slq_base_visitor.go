@@ -35,6 +35,14 @@ func (v *BaseSLQVisitor) VisitFuncName(ctx *FuncNameContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitWindow(ctx *WindowContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSLQVisitor) VisitPartitionBy(ctx *PartitionByContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitJoin(ctx *JoinContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -43,10 +51,30 @@ func (v *BaseSLQVisitor) VisitJoinTable(ctx *JoinTableContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitSetOp(ctx *SetOpContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSLQVisitor) VisitSubquery(ctx *SubqueryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSLQVisitor) VisitConditional(ctx *ConditionalContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSLQVisitor) VisitCte(ctx *CteContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitUniqueFunc(ctx *UniqueFuncContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitTopFunc(ctx *TopFuncContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitCountFunc(ctx *CountFuncContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -63,6 +91,18 @@ func (v *BaseSLQVisitor) VisitGroupBy(ctx *GroupByContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitGrouping(ctx *GroupingContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSLQVisitor) VisitGroupingTerm(ctx *GroupingTermContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSLQVisitor) VisitGroupingSet(ctx *GroupingSetContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitOrderByTerm(ctx *OrderByTermContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -83,6 +123,10 @@ func (v *BaseSLQVisitor) VisitAlias(ctx *AliasContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitAliasKeyword(ctx *AliasKeywordContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitArg(ctx *ArgContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -99,6 +143,10 @@ func (v *BaseSLQVisitor) VisitRowRange(ctx *RowRangeContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitRowRangeIndex(ctx *RowRangeIndexContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitExprElement(ctx *ExprElementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -111,6 +159,10 @@ func (v *BaseSLQVisitor) VisitLiteral(ctx *LiteralContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitList(ctx *ListContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitUnaryOperator(ctx *UnaryOperatorContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -43,325 +43,615 @@ func slqlexerLexerInit() {
 		"DEFAULT_MODE",
 	}
 	staticData.LiteralNames = []string{
-		"", "';'", "'*'", "'sum'", "'avg'", "'max'", "'min'", "'unique'", "'count'",
-		"'.['", "'||'", "'/'", "'%'", "'<<'", "'>>'", "'&'", "'&&'", "'~'",
-		"'!'", "", "", "", "'group_by'", "'+'", "'-'", "", "", "", "'null'",
-		"", "", "'('", "')'", "'['", "']'", "','", "'|'", "':'", "", "", "'<='",
-		"'<'", "'>='", "'>'", "'!='", "'=='",
+		"", "';'", "'*'", "'sum'", "'avg'", "'max'", "'min'", "'median'", "'percentile_cont'",
+		"'stddev'", "'variance'", "'string_agg'", "'upper'", "'lower'", "'trim'",
+		"'substr'", "'length'", "'replace'", "'concat'", "'round'", "'abs'",
+		"'ceil'", "'floor'", "'now'", "'date_trunc'", "'extract'", "'date_add'",
+		"'coalesce'", "'nullif'", "'cast'", "'row_number'", "'rank'", "'dense_rank'",
+		"'ntile'", "'lag'", "'lead'", "'first_value'", "'last_value'", "'over'",
+		"'if'", "'then'", "'elif'", "'else'", "'end'", "'with'", "'unique'",
+		"'top'", "'count'", "'rollup'", "'cube'", "'grouping_sets'", "'.['",
+		"'||'", "'/'", "'%'", "'<<'", "'>>'", "'&'", "'&&'", "'//'", "'~'",
+		"'!'", "'partition_by'", "", "", "", "'in'", "'not'", "'between'", "'and'",
+		"", "'is'", "", "'group_by'", "'+'", "'-'", "", "'nulls_first'", "'nulls_last'",
+		"", "", "'null'", "", "", "'('", "')'", "'['", "']'", "','", "'|'",
+		"':'", "", "", "'<='", "'<'", "'>='", "'>'", "'!='", "'=='",
 	}
 	staticData.SymbolicNames = []string{
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "PROPRIETARY_FUNC_NAME", "JOIN_TYPE", "WHERE", "GROUP_BY", "ORDER_ASC",
-		"ORDER_DESC", "ORDER_BY", "ALIAS_RESERVED", "ARG", "NULL", "ID", "WS",
-		"LPAR", "RPAR", "LBRA", "RBRA", "COMMA", "PIPE", "COLON", "NN", "NUMBER",
-		"LT_EQ", "LT", "GT_EQ", "GT", "NEQ", "EQ", "NAME", "HANDLE", "STRING",
-		"LINECOMMENT",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "PARTITION_BY", "PROPRIETARY_FUNC_NAME",
+		"JOIN_TYPE", "SET_OP", "IN", "NOT", "BETWEEN", "AND", "LIKE", "IS",
+		"WHERE", "GROUP_BY", "ORDER_ASC", "ORDER_DESC", "ORDER_BY", "NULLS_FIRST",
+		"NULLS_LAST", "ALIAS_RESERVED", "ARG", "NULL", "ID", "WS", "LPAR", "RPAR",
+		"LBRA", "RBRA", "COMMA", "PIPE", "COLON", "NN", "NUMBER", "LT_EQ", "LT",
+		"GT_EQ", "GT", "NEQ", "EQ", "NAME", "HANDLE", "STRING", "LINECOMMENT",
 	}
 	staticData.RuleNames = []string{
 		"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8",
 		"T__9", "T__10", "T__11", "T__12", "T__13", "T__14", "T__15", "T__16",
-		"T__17", "PROPRIETARY_FUNC_NAME", "JOIN_TYPE", "WHERE", "GROUP_BY",
-		"ORDER_ASC", "ORDER_DESC", "ORDER_BY", "ALIAS_RESERVED", "ARG", "NULL",
-		"ID", "WS", "LPAR", "RPAR", "LBRA", "RBRA", "COMMA", "PIPE", "COLON",
-		"NN", "NUMBER", "INTF", "EXP", "LT_EQ", "LT", "GT_EQ", "GT", "NEQ",
-		"EQ", "NAME", "HANDLE", "STRING", "ESC", "UNICODE", "HEX", "DIGIT",
-		"A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N",
-		"O", "P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z", "LINECOMMENT",
+		"T__17", "T__18", "T__19", "T__20", "T__21", "T__22", "T__23", "T__24",
+		"T__25", "T__26", "T__27", "T__28", "T__29", "T__30", "T__31", "T__32",
+		"T__33", "T__34", "T__35", "T__36", "T__37", "T__38", "T__39", "T__40",
+		"T__41", "T__42", "T__43", "T__44", "T__45", "T__46", "T__47", "T__48",
+		"T__49", "T__50", "T__51", "T__52", "T__53", "T__54", "T__55", "T__56",
+		"T__57", "T__58", "T__59", "T__60", "PARTITION_BY", "PROPRIETARY_FUNC_NAME",
+		"JOIN_TYPE", "SET_OP", "IN", "NOT", "BETWEEN", "AND", "LIKE", "IS",
+		"WHERE", "GROUP_BY", "ORDER_ASC", "ORDER_DESC", "ORDER_BY", "NULLS_FIRST",
+		"NULLS_LAST", "ALIAS_RESERVED", "ARG", "NULL", "ID", "WS", "LPAR", "RPAR",
+		"LBRA", "RBRA", "COMMA", "PIPE", "COLON", "NN", "NUMBER", "INTF", "EXP",
+		"LT_EQ", "LT", "GT_EQ", "GT", "NEQ", "EQ", "NAME", "HANDLE", "STRING",
+		"ESC", "UNICODE", "HEX", "DIGIT", "A", "B", "C", "D", "E", "F", "G",
+		"H", "I", "J", "K", "L", "M", "N", "O", "P", "Q", "R", "S", "T", "U",
+		"V", "W", "X", "Y", "Z", "LINECOMMENT",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 49, 648, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
-		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
-		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
-		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
-		20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25,
-		2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2,
-		31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36,
-		7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7,
-		41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46,
-		2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2,
-		52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57,
-		7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7,
-		62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67,
-		2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2,
-		73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78,
-		7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 1, 0, 1, 0, 1, 1, 1, 1, 1, 2, 1, 2,
-		1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5,
-		1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7,
-		1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1,
-		11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 15,
-		1, 15, 1, 15, 1, 16, 1, 16, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 19, 1,
-		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1,
-		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1,
-		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1,
-		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1,
-		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1,
-		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 3, 19, 347, 8, 19, 1, 20, 1, 20, 1,
-		20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 3, 20, 360,
-		8, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1,
-		22, 1, 22, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24,
-		1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 3, 24, 390, 8,
-		24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25,
-		1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1,
-		25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25,
-		1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1,
-		25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25,
-		1, 25, 1, 25, 1, 25, 1, 25, 3, 25, 448, 8, 25, 1, 26, 1, 26, 1, 26, 1,
-		27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 5, 28, 460, 8, 28, 10, 28,
-		12, 28, 463, 9, 28, 1, 29, 4, 29, 466, 8, 29, 11, 29, 12, 29, 467, 1, 29,
-		1, 29, 1, 30, 1, 30, 1, 31, 1, 31, 1, 32, 1, 32, 1, 33, 1, 33, 1, 34, 1,
-		34, 1, 35, 1, 35, 1, 36, 1, 36, 1, 37, 1, 37, 1, 38, 1, 38, 3, 38, 490,
-		8, 38, 1, 38, 1, 38, 1, 38, 4, 38, 495, 8, 38, 11, 38, 12, 38, 496, 1,
-		38, 3, 38, 500, 8, 38, 1, 38, 3, 38, 503, 8, 38, 1, 38, 1, 38, 1, 38, 1,
-		38, 3, 38, 509, 8, 38, 1, 38, 3, 38, 512, 8, 38, 1, 39, 1, 39, 1, 39, 5,
-		39, 517, 8, 39, 10, 39, 12, 39, 520, 9, 39, 3, 39, 522, 8, 39, 1, 40, 1,
-		40, 3, 40, 526, 8, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42,
-		1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1,
-		46, 1, 47, 1, 47, 1, 47, 1, 47, 3, 47, 550, 8, 47, 1, 48, 1, 48, 1, 48,
-		1, 48, 5, 48, 556, 8, 48, 10, 48, 12, 48, 559, 9, 48, 1, 49, 1, 49, 1,
-		49, 5, 49, 564, 8, 49, 10, 49, 12, 49, 567, 9, 49, 1, 49, 1, 49, 1, 50,
-		1, 50, 1, 50, 3, 50, 574, 8, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1,
-		51, 1, 52, 1, 52, 1, 53, 1, 53, 1, 54, 1, 54, 1, 55, 1, 55, 1, 56, 1, 56,
-		1, 57, 1, 57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1, 61, 1, 61, 1,
-		62, 1, 62, 1, 63, 1, 63, 1, 64, 1, 64, 1, 65, 1, 65, 1, 66, 1, 66, 1, 67,
-		1, 67, 1, 68, 1, 68, 1, 69, 1, 69, 1, 70, 1, 70, 1, 71, 1, 71, 1, 72, 1,
-		72, 1, 73, 1, 73, 1, 74, 1, 74, 1, 75, 1, 75, 1, 76, 1, 76, 1, 77, 1, 77,
-		1, 78, 1, 78, 1, 79, 1, 79, 1, 80, 1, 80, 5, 80, 640, 8, 80, 10, 80, 12,
-		80, 643, 9, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 641, 0, 81, 1, 1, 3, 2,
-		5, 3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25,
+		4, 0, 102, 1249, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3,
+		2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9,
+		2, 10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2,
+		15, 7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20,
+		7, 20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7,
+		25, 2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30,
+		2, 31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2,
+		36, 7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41,
+		7, 41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7,
+		46, 2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51,
+		2, 52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2,
+		57, 7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62,
+		7, 62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7,
+		67, 2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72,
+		2, 73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2,
+		78, 7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83,
+		7, 83, 2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7,
+		88, 2, 89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93,
+		2, 94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2,
+		99, 7, 99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103,
+		2, 104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 2, 107, 7, 107, 2, 108,
+		7, 108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 2, 112, 7, 112,
+		2, 113, 7, 113, 2, 114, 7, 114, 2, 115, 7, 115, 2, 116, 7, 116, 2, 117,
+		7, 117, 2, 118, 7, 118, 2, 119, 7, 119, 2, 120, 7, 120, 2, 121, 7, 121,
+		2, 122, 7, 122, 2, 123, 7, 123, 2, 124, 7, 124, 2, 125, 7, 125, 2, 126,
+		7, 126, 2, 127, 7, 127, 2, 128, 7, 128, 2, 129, 7, 129, 2, 130, 7, 130,
+		2, 131, 7, 131, 2, 132, 7, 132, 2, 133, 7, 133, 1, 0, 1, 0, 1, 1, 1, 1,
+		1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4,
+		1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7,
+		1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7,
+		1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9,
+		1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1,
+		10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11,
+		1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1,
+		13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15,
+		1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1,
+		16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17,
+		1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1,
+		20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21,
+		1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1,
+		23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24,
+		1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1,
+		25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27,
+		1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1,
+		28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29,
+		1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1,
+		31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 32,
+		1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1,
+		34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35,
+		1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1,
+		36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38,
+		1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1,
+		41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43,
+		1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1,
+		45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47,
+		1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1,
+		48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49,
+		1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1,
+		52, 1, 52, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 56,
+		1, 56, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 60, 1,
+		60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61,
+		1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 3, 63, 766, 8, 63, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1,
+		64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64,
+		1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1,
+		64, 1, 64, 1, 64, 3, 64, 797, 8, 64, 1, 65, 1, 65, 1, 65, 1, 66, 1, 66,
+		1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1,
+		68, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69,
+		1, 69, 1, 69, 3, 69, 827, 8, 69, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71, 1,
+		71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 3, 71, 843,
+		8, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1,
+		73, 1, 73, 1, 74, 1, 74, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75,
+		1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 3, 75, 873, 8,
+		75, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76,
+		1, 76, 1, 76, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1,
+		77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1,
+		78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1,
+		78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1,
+		78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1,
+		78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1,
+		78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1,
+		78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1,
+		78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 3, 78, 1049, 8, 78, 1, 79,
+		1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 81, 1, 81, 5, 81, 1061,
+		8, 81, 10, 81, 12, 81, 1064, 9, 81, 1, 82, 4, 82, 1067, 8, 82, 11, 82,
+		12, 82, 1068, 1, 82, 1, 82, 1, 83, 1, 83, 1, 84, 1, 84, 1, 85, 1, 85, 1,
+		86, 1, 86, 1, 87, 1, 87, 1, 88, 1, 88, 1, 89, 1, 89, 1, 90, 1, 90, 1, 91,
+		1, 91, 3, 91, 1091, 8, 91, 1, 91, 1, 91, 1, 91, 4, 91, 1096, 8, 91, 11,
+		91, 12, 91, 1097, 1, 91, 3, 91, 1101, 8, 91, 1, 91, 3, 91, 1104, 8, 91,
+		1, 91, 1, 91, 1, 91, 1, 91, 3, 91, 1110, 8, 91, 1, 91, 3, 91, 1113, 8,
+		91, 1, 92, 1, 92, 1, 92, 5, 92, 1118, 8, 92, 10, 92, 12, 92, 1121, 9, 92,
+		3, 92, 1123, 8, 92, 1, 93, 1, 93, 3, 93, 1127, 8, 93, 1, 93, 1, 93, 1,
+		94, 1, 94, 1, 94, 1, 95, 1, 95, 1, 96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 98,
+		1, 98, 1, 98, 1, 99, 1, 99, 1, 99, 1, 100, 1, 100, 1, 100, 1, 100, 3, 100,
+		1151, 8, 100, 1, 101, 1, 101, 1, 101, 1, 101, 5, 101, 1157, 8, 101, 10,
+		101, 12, 101, 1160, 9, 101, 1, 102, 1, 102, 1, 102, 5, 102, 1165, 8, 102,
+		10, 102, 12, 102, 1168, 9, 102, 1, 102, 1, 102, 1, 103, 1, 103, 1, 103,
+		3, 103, 1175, 8, 103, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1, 104, 1,
+		105, 1, 105, 1, 106, 1, 106, 1, 107, 1, 107, 1, 108, 1, 108, 1, 109, 1,
+		109, 1, 110, 1, 110, 1, 111, 1, 111, 1, 112, 1, 112, 1, 113, 1, 113, 1,
+		114, 1, 114, 1, 115, 1, 115, 1, 116, 1, 116, 1, 117, 1, 117, 1, 118, 1,
+		118, 1, 119, 1, 119, 1, 120, 1, 120, 1, 121, 1, 121, 1, 122, 1, 122, 1,
+		123, 1, 123, 1, 124, 1, 124, 1, 125, 1, 125, 1, 126, 1, 126, 1, 127, 1,
+		127, 1, 128, 1, 128, 1, 129, 1, 129, 1, 130, 1, 130, 1, 131, 1, 131, 1,
+		132, 1, 132, 1, 133, 1, 133, 5, 133, 1241, 8, 133, 10, 133, 12, 133, 1244,
+		9, 133, 1, 133, 1, 133, 1, 133, 1, 133, 1, 1242, 0, 134, 1, 1, 3, 2, 5,
+		3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23, 12, 25,
 		13, 27, 14, 29, 15, 31, 16, 33, 17, 35, 18, 37, 19, 39, 20, 41, 21, 43,
 		22, 45, 23, 47, 24, 49, 25, 51, 26, 53, 27, 55, 28, 57, 29, 59, 30, 61,
 		31, 63, 32, 65, 33, 67, 34, 69, 35, 71, 36, 73, 37, 75, 38, 77, 39, 79,
-		0, 81, 0, 83, 40, 85, 41, 87, 42, 89, 43, 91, 44, 93, 45, 95, 46, 97, 47,
-		99, 48, 101, 0, 103, 0, 105, 0, 107, 0, 109, 0, 111, 0, 113, 0, 115, 0,
-		117, 0, 119, 0, 121, 0, 123, 0, 125, 0, 127, 0, 129, 0, 131, 0, 133, 0,
-		135, 0, 137, 0, 139, 0, 141, 0, 143, 0, 145, 0, 147, 0, 149, 0, 151, 0,
-		153, 0, 155, 0, 157, 0, 159, 0, 161, 49, 1, 0, 35, 3, 0, 65, 90, 95, 95,
-		97, 122, 4, 0, 48, 57, 65, 90, 95, 95, 97, 122, 3, 0, 9, 10, 13, 13, 32,
-		32, 1, 0, 48, 57, 1, 0, 49, 57, 2, 0, 69, 69, 101, 101, 2, 0, 43, 43, 45,
-		45, 2, 0, 34, 34, 92, 92, 8, 0, 34, 34, 47, 47, 92, 92, 98, 98, 102, 102,
-		110, 110, 114, 114, 116, 116, 3, 0, 48, 57, 65, 70, 97, 102, 2, 0, 65,
-		65, 97, 97, 2, 0, 66, 66, 98, 98, 2, 0, 67, 67, 99, 99, 2, 0, 68, 68, 100,
-		100, 2, 0, 70, 70, 102, 102, 2, 0, 71, 71, 103, 103, 2, 0, 72, 72, 104,
-		104, 2, 0, 73, 73, 105, 105, 2, 0, 74, 74, 106, 106, 2, 0, 75, 75, 107,
-		107, 2, 0, 76, 76, 108, 108, 2, 0, 77, 77, 109, 109, 2, 0, 78, 78, 110,
-		110, 2, 0, 79, 79, 111, 111, 2, 0, 80, 80, 112, 112, 2, 0, 81, 81, 113,
-		113, 2, 0, 82, 82, 114, 114, 2, 0, 83, 83, 115, 115, 2, 0, 84, 84, 116,
-		116, 2, 0, 85, 85, 117, 117, 2, 0, 86, 86, 118, 118, 2, 0, 87, 87, 119,
-		119, 2, 0, 88, 88, 120, 120, 2, 0, 89, 89, 121, 121, 2, 0, 90, 90, 122,
-		122, 657, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1,
-		0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15,
-		1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0,
-		23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0,
-		0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0,
-		0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0,
-		0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1,
-		0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61,
-		1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0,
-		69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0,
-		0, 77, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0,
-		0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0,
-		0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 161, 1, 0, 0, 0, 1, 163,
-		1, 0, 0, 0, 3, 165, 1, 0, 0, 0, 5, 167, 1, 0, 0, 0, 7, 171, 1, 0, 0, 0,
-		9, 175, 1, 0, 0, 0, 11, 179, 1, 0, 0, 0, 13, 183, 1, 0, 0, 0, 15, 190,
-		1, 0, 0, 0, 17, 196, 1, 0, 0, 0, 19, 199, 1, 0, 0, 0, 21, 202, 1, 0, 0,
-		0, 23, 204, 1, 0, 0, 0, 25, 206, 1, 0, 0, 0, 27, 209, 1, 0, 0, 0, 29, 212,
-		1, 0, 0, 0, 31, 214, 1, 0, 0, 0, 33, 217, 1, 0, 0, 0, 35, 219, 1, 0, 0,
-		0, 37, 221, 1, 0, 0, 0, 39, 346, 1, 0, 0, 0, 41, 359, 1, 0, 0, 0, 43, 361,
-		1, 0, 0, 0, 45, 370, 1, 0, 0, 0, 47, 372, 1, 0, 0, 0, 49, 389, 1, 0, 0,
-		0, 51, 447, 1, 0, 0, 0, 53, 449, 1, 0, 0, 0, 55, 452, 1, 0, 0, 0, 57, 457,
-		1, 0, 0, 0, 59, 465, 1, 0, 0, 0, 61, 471, 1, 0, 0, 0, 63, 473, 1, 0, 0,
-		0, 65, 475, 1, 0, 0, 0, 67, 477, 1, 0, 0, 0, 69, 479, 1, 0, 0, 0, 71, 481,
-		1, 0, 0, 0, 73, 483, 1, 0, 0, 0, 75, 485, 1, 0, 0, 0, 77, 511, 1, 0, 0,
-		0, 79, 521, 1, 0, 0, 0, 81, 523, 1, 0, 0, 0, 83, 529, 1, 0, 0, 0, 85, 532,
-		1, 0, 0, 0, 87, 534, 1, 0, 0, 0, 89, 537, 1, 0, 0, 0, 91, 539, 1, 0, 0,
-		0, 93, 542, 1, 0, 0, 0, 95, 545, 1, 0, 0, 0, 97, 551, 1, 0, 0, 0, 99, 560,
-		1, 0, 0, 0, 101, 570, 1, 0, 0, 0, 103, 575, 1, 0, 0, 0, 105, 581, 1, 0,
-		0, 0, 107, 583, 1, 0, 0, 0, 109, 585, 1, 0, 0, 0, 111, 587, 1, 0, 0, 0,
-		113, 589, 1, 0, 0, 0, 115, 591, 1, 0, 0, 0, 117, 593, 1, 0, 0, 0, 119,
-		595, 1, 0, 0, 0, 121, 597, 1, 0, 0, 0, 123, 599, 1, 0, 0, 0, 125, 601,
-		1, 0, 0, 0, 127, 603, 1, 0, 0, 0, 129, 605, 1, 0, 0, 0, 131, 607, 1, 0,
-		0, 0, 133, 609, 1, 0, 0, 0, 135, 611, 1, 0, 0, 0, 137, 613, 1, 0, 0, 0,
-		139, 615, 1, 0, 0, 0, 141, 617, 1, 0, 0, 0, 143, 619, 1, 0, 0, 0, 145,
-		621, 1, 0, 0, 0, 147, 623, 1, 0, 0, 0, 149, 625, 1, 0, 0, 0, 151, 627,
-		1, 0, 0, 0, 153, 629, 1, 0, 0, 0, 155, 631, 1, 0, 0, 0, 157, 633, 1, 0,
-		0, 0, 159, 635, 1, 0, 0, 0, 161, 637, 1, 0, 0, 0, 163, 164, 5, 59, 0, 0,
-		164, 2, 1, 0, 0, 0, 165, 166, 5, 42, 0, 0, 166, 4, 1, 0, 0, 0, 167, 168,
-		5, 115, 0, 0, 168, 169, 5, 117, 0, 0, 169, 170, 5, 109, 0, 0, 170, 6, 1,
-		0, 0, 0, 171, 172, 5, 97, 0, 0, 172, 173, 5, 118, 0, 0, 173, 174, 5, 103,
-		0, 0, 174, 8, 1, 0, 0, 0, 175, 176, 5, 109, 0, 0, 176, 177, 5, 97, 0, 0,
-		177, 178, 5, 120, 0, 0, 178, 10, 1, 0, 0, 0, 179, 180, 5, 109, 0, 0, 180,
-		181, 5, 105, 0, 0, 181, 182, 5, 110, 0, 0, 182, 12, 1, 0, 0, 0, 183, 184,
-		5, 117, 0, 0, 184, 185, 5, 110, 0, 0, 185, 186, 5, 105, 0, 0, 186, 187,
-		5, 113, 0, 0, 187, 188, 5, 117, 0, 0, 188, 189, 5, 101, 0, 0, 189, 14,
-		1, 0, 0, 0, 190, 191, 5, 99, 0, 0, 191, 192, 5, 111, 0, 0, 192, 193, 5,
-		117, 0, 0, 193, 194, 5, 110, 0, 0, 194, 195, 5, 116, 0, 0, 195, 16, 1,
-		0, 0, 0, 196, 197, 5, 46, 0, 0, 197, 198, 5, 91, 0, 0, 198, 18, 1, 0, 0,
-		0, 199, 200, 5, 124, 0, 0, 200, 201, 5, 124, 0, 0, 201, 20, 1, 0, 0, 0,
-		202, 203, 5, 47, 0, 0, 203, 22, 1, 0, 0, 0, 204, 205, 5, 37, 0, 0, 205,
-		24, 1, 0, 0, 0, 206, 207, 5, 60, 0, 0, 207, 208, 5, 60, 0, 0, 208, 26,
-		1, 0, 0, 0, 209, 210, 5, 62, 0, 0, 210, 211, 5, 62, 0, 0, 211, 28, 1, 0,
-		0, 0, 212, 213, 5, 38, 0, 0, 213, 30, 1, 0, 0, 0, 214, 215, 5, 38, 0, 0,
-		215, 216, 5, 38, 0, 0, 216, 32, 1, 0, 0, 0, 217, 218, 5, 126, 0, 0, 218,
-		34, 1, 0, 0, 0, 219, 220, 5, 33, 0, 0, 220, 36, 1, 0, 0, 0, 221, 222, 5,
-		95, 0, 0, 222, 223, 3, 57, 28, 0, 223, 38, 1, 0, 0, 0, 224, 225, 5, 106,
-		0, 0, 225, 226, 5, 111, 0, 0, 226, 227, 5, 105, 0, 0, 227, 347, 5, 110,
-		0, 0, 228, 229, 5, 105, 0, 0, 229, 230, 5, 110, 0, 0, 230, 231, 5, 110,
-		0, 0, 231, 232, 5, 101, 0, 0, 232, 233, 5, 114, 0, 0, 233, 234, 5, 95,
-		0, 0, 234, 235, 5, 106, 0, 0, 235, 236, 5, 111, 0, 0, 236, 237, 5, 105,
-		0, 0, 237, 347, 5, 110, 0, 0, 238, 239, 5, 108, 0, 0, 239, 240, 5, 101,
-		0, 0, 240, 241, 5, 102, 0, 0, 241, 242, 5, 116, 0, 0, 242, 243, 5, 95,
-		0, 0, 243, 244, 5, 106, 0, 0, 244, 245, 5, 111, 0, 0, 245, 246, 5, 105,
-		0, 0, 246, 347, 5, 110, 0, 0, 247, 248, 5, 108, 0, 0, 248, 249, 5, 106,
-		0, 0, 249, 250, 5, 111, 0, 0, 250, 251, 5, 105, 0, 0, 251, 347, 5, 110,
-		0, 0, 252, 253, 5, 108, 0, 0, 253, 254, 5, 101, 0, 0, 254, 255, 5, 102,
-		0, 0, 255, 256, 5, 116, 0, 0, 256, 257, 5, 95, 0, 0, 257, 258, 5, 111,
-		0, 0, 258, 259, 5, 117, 0, 0, 259, 260, 5, 116, 0, 0, 260, 261, 5, 101,
-		0, 0, 261, 262, 5, 114, 0, 0, 262, 263, 5, 95, 0, 0, 263, 264, 5, 106,
-		0, 0, 264, 265, 5, 111, 0, 0, 265, 266, 5, 105, 0, 0, 266, 347, 5, 110,
-		0, 0, 267, 268, 5, 108, 0, 0, 268, 269, 5, 111, 0, 0, 269, 270, 5, 106,
-		0, 0, 270, 271, 5, 111, 0, 0, 271, 272, 5, 105, 0, 0, 272, 347, 5, 110,
-		0, 0, 273, 274, 5, 114, 0, 0, 274, 275, 5, 105, 0, 0, 275, 276, 5, 103,
-		0, 0, 276, 277, 5, 104, 0, 0, 277, 278, 5, 116, 0, 0, 278, 279, 5, 95,
-		0, 0, 279, 280, 5, 106, 0, 0, 280, 281, 5, 111, 0, 0, 281, 282, 5, 105,
-		0, 0, 282, 347, 5, 110, 0, 0, 283, 284, 5, 114, 0, 0, 284, 285, 5, 106,
-		0, 0, 285, 286, 5, 111, 0, 0, 286, 287, 5, 105, 0, 0, 287, 347, 5, 110,
-		0, 0, 288, 289, 5, 114, 0, 0, 289, 290, 5, 105, 0, 0, 290, 291, 5, 103,
-		0, 0, 291, 292, 5, 104, 0, 0, 292, 293, 5, 116, 0, 0, 293, 294, 5, 95,
-		0, 0, 294, 295, 5, 111, 0, 0, 295, 296, 5, 117, 0, 0, 296, 297, 5, 116,
-		0, 0, 297, 298, 5, 101, 0, 0, 298, 299, 5, 114, 0, 0, 299, 300, 5, 95,
-		0, 0, 300, 301, 5, 106, 0, 0, 301, 302, 5, 111, 0, 0, 302, 303, 5, 105,
-		0, 0, 303, 347, 5, 110, 0, 0, 304, 305, 5, 114, 0, 0, 305, 306, 5, 111,
-		0, 0, 306, 307, 5, 106, 0, 0, 307, 308, 5, 111, 0, 0, 308, 309, 5, 105,
-		0, 0, 309, 347, 5, 110, 0, 0, 310, 311, 5, 102, 0, 0, 311, 312, 5, 117,
-		0, 0, 312, 313, 5, 108, 0, 0, 313, 314, 5, 108, 0, 0, 314, 315, 5, 95,
-		0, 0, 315, 316, 5, 111, 0, 0, 316, 317, 5, 117, 0, 0, 317, 318, 5, 116,
-		0, 0, 318, 319, 5, 101, 0, 0, 319, 320, 5, 114, 0, 0, 320, 321, 5, 95,
-		0, 0, 321, 322, 5, 106, 0, 0, 322, 323, 5, 111, 0, 0, 323, 324, 5, 105,
-		0, 0, 324, 347, 5, 110, 0, 0, 325, 326, 5, 102, 0, 0, 326, 327, 5, 111,
-		0, 0, 327, 328, 5, 106, 0, 0, 328, 329, 5, 111, 0, 0, 329, 330, 5, 105,
-		0, 0, 330, 347, 5, 110, 0, 0, 331, 332, 5, 99, 0, 0, 332, 333, 5, 114,
-		0, 0, 333, 334, 5, 111, 0, 0, 334, 335, 5, 115, 0, 0, 335, 336, 5, 115,
-		0, 0, 336, 337, 5, 95, 0, 0, 337, 338, 5, 106, 0, 0, 338, 339, 5, 111,
-		0, 0, 339, 340, 5, 105, 0, 0, 340, 347, 5, 110, 0, 0, 341, 342, 5, 120,
-		0, 0, 342, 343, 5, 106, 0, 0, 343, 344, 5, 111, 0, 0, 344, 345, 5, 105,
-		0, 0, 345, 347, 5, 110, 0, 0, 346, 224, 1, 0, 0, 0, 346, 228, 1, 0, 0,
-		0, 346, 238, 1, 0, 0, 0, 346, 247, 1, 0, 0, 0, 346, 252, 1, 0, 0, 0, 346,
-		267, 1, 0, 0, 0, 346, 273, 1, 0, 0, 0, 346, 283, 1, 0, 0, 0, 346, 288,
-		1, 0, 0, 0, 346, 304, 1, 0, 0, 0, 346, 310, 1, 0, 0, 0, 346, 325, 1, 0,
-		0, 0, 346, 331, 1, 0, 0, 0, 346, 341, 1, 0, 0, 0, 347, 40, 1, 0, 0, 0,
-		348, 349, 5, 119, 0, 0, 349, 350, 5, 104, 0, 0, 350, 351, 5, 101, 0, 0,
-		351, 352, 5, 114, 0, 0, 352, 360, 5, 101, 0, 0, 353, 354, 5, 115, 0, 0,
-		354, 355, 5, 101, 0, 0, 355, 356, 5, 108, 0, 0, 356, 357, 5, 101, 0, 0,
-		357, 358, 5, 99, 0, 0, 358, 360, 5, 116, 0, 0, 359, 348, 1, 0, 0, 0, 359,
-		353, 1, 0, 0, 0, 360, 42, 1, 0, 0, 0, 361, 362, 5, 103, 0, 0, 362, 363,
-		5, 114, 0, 0, 363, 364, 5, 111, 0, 0, 364, 365, 5, 117, 0, 0, 365, 366,
-		5, 112, 0, 0, 366, 367, 5, 95, 0, 0, 367, 368, 5, 98, 0, 0, 368, 369, 5,
-		121, 0, 0, 369, 44, 1, 0, 0, 0, 370, 371, 5, 43, 0, 0, 371, 46, 1, 0, 0,
-		0, 372, 373, 5, 45, 0, 0, 373, 48, 1, 0, 0, 0, 374, 375, 5, 111, 0, 0,
-		375, 376, 5, 114, 0, 0, 376, 377, 5, 100, 0, 0, 377, 378, 5, 101, 0, 0,
-		378, 379, 5, 114, 0, 0, 379, 380, 5, 95, 0, 0, 380, 381, 5, 98, 0, 0, 381,
-		390, 5, 121, 0, 0, 382, 383, 5, 115, 0, 0, 383, 384, 5, 111, 0, 0, 384,
-		385, 5, 114, 0, 0, 385, 386, 5, 116, 0, 0, 386, 387, 5, 95, 0, 0, 387,
-		388, 5, 98, 0, 0, 388, 390, 5, 121, 0, 0, 389, 374, 1, 0, 0, 0, 389, 382,
-		1, 0, 0, 0, 390, 50, 1, 0, 0, 0, 391, 392, 5, 58, 0, 0, 392, 393, 5, 99,
-		0, 0, 393, 394, 5, 111, 0, 0, 394, 395, 5, 117, 0, 0, 395, 396, 5, 110,
-		0, 0, 396, 448, 5, 116, 0, 0, 397, 398, 5, 58, 0, 0, 398, 399, 5, 99, 0,
-		0, 399, 400, 5, 111, 0, 0, 400, 401, 5, 117, 0, 0, 401, 402, 5, 110, 0,
-		0, 402, 403, 5, 116, 0, 0, 403, 404, 5, 95, 0, 0, 404, 405, 5, 117, 0,
-		0, 405, 406, 5, 110, 0, 0, 406, 407, 5, 105, 0, 0, 407, 408, 5, 113, 0,
-		0, 408, 409, 5, 117, 0, 0, 409, 448, 5, 101, 0, 0, 410, 411, 5, 58, 0,
-		0, 411, 412, 5, 97, 0, 0, 412, 413, 5, 118, 0, 0, 413, 448, 5, 103, 0,
-		0, 414, 415, 5, 58, 0, 0, 415, 416, 5, 103, 0, 0, 416, 417, 5, 114, 0,
-		0, 417, 418, 5, 111, 0, 0, 418, 419, 5, 117, 0, 0, 419, 420, 5, 112, 0,
-		0, 420, 421, 5, 95, 0, 0, 421, 422, 5, 98, 0, 0, 422, 448, 5, 121, 0, 0,
-		423, 424, 5, 58, 0, 0, 424, 425, 5, 109, 0, 0, 425, 426, 5, 97, 0, 0, 426,
-		448, 5, 120, 0, 0, 427, 428, 5, 58, 0, 0, 428, 429, 5, 109, 0, 0, 429,
-		430, 5, 105, 0, 0, 430, 448, 5, 110, 0, 0, 431, 432, 5, 58, 0, 0, 432,
-		433, 5, 111, 0, 0, 433, 434, 5, 114, 0, 0, 434, 435, 5, 100, 0, 0, 435,
-		436, 5, 101, 0, 0, 436, 437, 5, 114, 0, 0, 437, 438, 5, 95, 0, 0, 438,
-		439, 5, 98, 0, 0, 439, 448, 5, 121, 0, 0, 440, 441, 5, 58, 0, 0, 441, 442,
-		5, 117, 0, 0, 442, 443, 5, 110, 0, 0, 443, 444, 5, 105, 0, 0, 444, 445,
-		5, 113, 0, 0, 445, 446, 5, 117, 0, 0, 446, 448, 5, 101, 0, 0, 447, 391,
-		1, 0, 0, 0, 447, 397, 1, 0, 0, 0, 447, 410, 1, 0, 0, 0, 447, 414, 1, 0,
-		0, 0, 447, 423, 1, 0, 0, 0, 447, 427, 1, 0, 0, 0, 447, 431, 1, 0, 0, 0,
-		447, 440, 1, 0, 0, 0, 448, 52, 1, 0, 0, 0, 449, 450, 5, 36, 0, 0, 450,
-		451, 3, 57, 28, 0, 451, 54, 1, 0, 0, 0, 452, 453, 5, 110, 0, 0, 453, 454,
-		5, 117, 0, 0, 454, 455, 5, 108, 0, 0, 455, 456, 5, 108, 0, 0, 456, 56,
-		1, 0, 0, 0, 457, 461, 7, 0, 0, 0, 458, 460, 7, 1, 0, 0, 459, 458, 1, 0,
-		0, 0, 460, 463, 1, 0, 0, 0, 461, 459, 1, 0, 0, 0, 461, 462, 1, 0, 0, 0,
-		462, 58, 1, 0, 0, 0, 463, 461, 1, 0, 0, 0, 464, 466, 7, 2, 0, 0, 465, 464,
-		1, 0, 0, 0, 466, 467, 1, 0, 0, 0, 467, 465, 1, 0, 0, 0, 467, 468, 1, 0,
-		0, 0, 468, 469, 1, 0, 0, 0, 469, 470, 6, 29, 0, 0, 470, 60, 1, 0, 0, 0,
-		471, 472, 5, 40, 0, 0, 472, 62, 1, 0, 0, 0, 473, 474, 5, 41, 0, 0, 474,
-		64, 1, 0, 0, 0, 475, 476, 5, 91, 0, 0, 476, 66, 1, 0, 0, 0, 477, 478, 5,
-		93, 0, 0, 478, 68, 1, 0, 0, 0, 479, 480, 5, 44, 0, 0, 480, 70, 1, 0, 0,
-		0, 481, 482, 5, 124, 0, 0, 482, 72, 1, 0, 0, 0, 483, 484, 5, 58, 0, 0,
-		484, 74, 1, 0, 0, 0, 485, 486, 3, 79, 39, 0, 486, 76, 1, 0, 0, 0, 487,
-		512, 3, 75, 37, 0, 488, 490, 5, 45, 0, 0, 489, 488, 1, 0, 0, 0, 489, 490,
-		1, 0, 0, 0, 490, 491, 1, 0, 0, 0, 491, 492, 3, 79, 39, 0, 492, 494, 5,
-		46, 0, 0, 493, 495, 7, 3, 0, 0, 494, 493, 1, 0, 0, 0, 495, 496, 1, 0, 0,
-		0, 496, 494, 1, 0, 0, 0, 496, 497, 1, 0, 0, 0, 497, 499, 1, 0, 0, 0, 498,
-		500, 3, 81, 40, 0, 499, 498, 1, 0, 0, 0, 499, 500, 1, 0, 0, 0, 500, 512,
-		1, 0, 0, 0, 501, 503, 5, 45, 0, 0, 502, 501, 1, 0, 0, 0, 502, 503, 1, 0,
-		0, 0, 503, 504, 1, 0, 0, 0, 504, 505, 3, 79, 39, 0, 505, 506, 3, 81, 40,
-		0, 506, 512, 1, 0, 0, 0, 507, 509, 5, 45, 0, 0, 508, 507, 1, 0, 0, 0, 508,
-		509, 1, 0, 0, 0, 509, 510, 1, 0, 0, 0, 510, 512, 3, 79, 39, 0, 511, 487,
-		1, 0, 0, 0, 511, 489, 1, 0, 0, 0, 511, 502, 1, 0, 0, 0, 511, 508, 1, 0,
-		0, 0, 512, 78, 1, 0, 0, 0, 513, 522, 5, 48, 0, 0, 514, 518, 7, 4, 0, 0,
-		515, 517, 7, 3, 0, 0, 516, 515, 1, 0, 0, 0, 517, 520, 1, 0, 0, 0, 518,
-		516, 1, 0, 0, 0, 518, 519, 1, 0, 0, 0, 519, 522, 1, 0, 0, 0, 520, 518,
-		1, 0, 0, 0, 521, 513, 1, 0, 0, 0, 521, 514, 1, 0, 0, 0, 522, 80, 1, 0,
-		0, 0, 523, 525, 7, 5, 0, 0, 524, 526, 7, 6, 0, 0, 525, 524, 1, 0, 0, 0,
-		525, 526, 1, 0, 0, 0, 526, 527, 1, 0, 0, 0, 527, 528, 3, 79, 39, 0, 528,
-		82, 1, 0, 0, 0, 529, 530, 5, 60, 0, 0, 530, 531, 5, 61, 0, 0, 531, 84,
-		1, 0, 0, 0, 532, 533, 5, 60, 0, 0, 533, 86, 1, 0, 0, 0, 534, 535, 5, 62,
-		0, 0, 535, 536, 5, 61, 0, 0, 536, 88, 1, 0, 0, 0, 537, 538, 5, 62, 0, 0,
-		538, 90, 1, 0, 0, 0, 539, 540, 5, 33, 0, 0, 540, 541, 5, 61, 0, 0, 541,
-		92, 1, 0, 0, 0, 542, 543, 5, 61, 0, 0, 543, 544, 5, 61, 0, 0, 544, 94,
-		1, 0, 0, 0, 545, 549, 5, 46, 0, 0, 546, 550, 3, 53, 26, 0, 547, 550, 3,
-		57, 28, 0, 548, 550, 3, 99, 49, 0, 549, 546, 1, 0, 0, 0, 549, 547, 1, 0,
-		0, 0, 549, 548, 1, 0, 0, 0, 550, 96, 1, 0, 0, 0, 551, 552, 5, 64, 0, 0,
-		552, 557, 3, 57, 28, 0, 553, 554, 5, 47, 0, 0, 554, 556, 3, 57, 28, 0,
-		555, 553, 1, 0, 0, 0, 556, 559, 1, 0, 0, 0, 557, 555, 1, 0, 0, 0, 557,
-		558, 1, 0, 0, 0, 558, 98, 1, 0, 0, 0, 559, 557, 1, 0, 0, 0, 560, 565, 5,
-		34, 0, 0, 561, 564, 3, 101, 50, 0, 562, 564, 8, 7, 0, 0, 563, 561, 1, 0,
-		0, 0, 563, 562, 1, 0, 0, 0, 564, 567, 1, 0, 0, 0, 565, 563, 1, 0, 0, 0,
-		565, 566, 1, 0, 0, 0, 566, 568, 1, 0, 0, 0, 567, 565, 1, 0, 0, 0, 568,
-		569, 5, 34, 0, 0, 569, 100, 1, 0, 0, 0, 570, 573, 5, 92, 0, 0, 571, 574,
-		7, 8, 0, 0, 572, 574, 3, 103, 51, 0, 573, 571, 1, 0, 0, 0, 573, 572, 1,
-		0, 0, 0, 574, 102, 1, 0, 0, 0, 575, 576, 5, 117, 0, 0, 576, 577, 3, 105,
-		52, 0, 577, 578, 3, 105, 52, 0, 578, 579, 3, 105, 52, 0, 579, 580, 3, 105,
-		52, 0, 580, 104, 1, 0, 0, 0, 581, 582, 7, 9, 0, 0, 582, 106, 1, 0, 0, 0,
-		583, 584, 7, 3, 0, 0, 584, 108, 1, 0, 0, 0, 585, 586, 7, 10, 0, 0, 586,
-		110, 1, 0, 0, 0, 587, 588, 7, 11, 0, 0, 588, 112, 1, 0, 0, 0, 589, 590,
-		7, 12, 0, 0, 590, 114, 1, 0, 0, 0, 591, 592, 7, 13, 0, 0, 592, 116, 1,
-		0, 0, 0, 593, 594, 7, 5, 0, 0, 594, 118, 1, 0, 0, 0, 595, 596, 7, 14, 0,
-		0, 596, 120, 1, 0, 0, 0, 597, 598, 7, 15, 0, 0, 598, 122, 1, 0, 0, 0, 599,
-		600, 7, 16, 0, 0, 600, 124, 1, 0, 0, 0, 601, 602, 7, 17, 0, 0, 602, 126,
-		1, 0, 0, 0, 603, 604, 7, 18, 0, 0, 604, 128, 1, 0, 0, 0, 605, 606, 7, 19,
-		0, 0, 606, 130, 1, 0, 0, 0, 607, 608, 7, 20, 0, 0, 608, 132, 1, 0, 0, 0,
-		609, 610, 7, 21, 0, 0, 610, 134, 1, 0, 0, 0, 611, 612, 7, 22, 0, 0, 612,
-		136, 1, 0, 0, 0, 613, 614, 7, 23, 0, 0, 614, 138, 1, 0, 0, 0, 615, 616,
-		7, 24, 0, 0, 616, 140, 1, 0, 0, 0, 617, 618, 7, 25, 0, 0, 618, 142, 1,
-		0, 0, 0, 619, 620, 7, 26, 0, 0, 620, 144, 1, 0, 0, 0, 621, 622, 7, 27,
-		0, 0, 622, 146, 1, 0, 0, 0, 623, 624, 7, 28, 0, 0, 624, 148, 1, 0, 0, 0,
-		625, 626, 7, 29, 0, 0, 626, 150, 1, 0, 0, 0, 627, 628, 7, 30, 0, 0, 628,
-		152, 1, 0, 0, 0, 629, 630, 7, 31, 0, 0, 630, 154, 1, 0, 0, 0, 631, 632,
-		7, 32, 0, 0, 632, 156, 1, 0, 0, 0, 633, 634, 7, 33, 0, 0, 634, 158, 1,
-		0, 0, 0, 635, 636, 7, 34, 0, 0, 636, 160, 1, 0, 0, 0, 637, 641, 5, 35,
-		0, 0, 638, 640, 9, 0, 0, 0, 639, 638, 1, 0, 0, 0, 640, 643, 1, 0, 0, 0,
-		641, 642, 1, 0, 0, 0, 641, 639, 1, 0, 0, 0, 642, 644, 1, 0, 0, 0, 643,
-		641, 1, 0, 0, 0, 644, 645, 5, 10, 0, 0, 645, 646, 1, 0, 0, 0, 646, 647,
-		6, 80, 0, 0, 647, 162, 1, 0, 0, 0, 22, 0, 346, 359, 389, 447, 461, 467,
-		489, 496, 499, 502, 508, 511, 518, 521, 525, 549, 557, 563, 565, 573, 641,
-		1, 6, 0, 0,
+		40, 81, 41, 83, 42, 85, 43, 87, 44, 89, 45, 91, 46, 93, 47, 95, 48, 97,
+		49, 99, 50, 101, 51, 103, 52, 105, 53, 107, 54, 109, 55, 111, 56, 113,
+		57, 115, 58, 117, 59, 119, 60, 121, 61, 123, 62, 125, 63, 127, 64, 129,
+		65, 131, 66, 133, 67, 135, 68, 137, 69, 139, 70, 141, 71, 143, 72, 145,
+		73, 147, 74, 149, 75, 151, 76, 153, 77, 155, 78, 157, 79, 159, 80, 161,
+		81, 163, 82, 165, 83, 167, 84, 169, 85, 171, 86, 173, 87, 175, 88, 177,
+		89, 179, 90, 181, 91, 183, 92, 185, 0, 187, 0, 189, 93, 191, 94, 193, 95,
+		195, 96, 197, 97, 199, 98, 201, 99, 203, 100, 205, 101, 207, 0, 209, 0,
+		211, 0, 213, 0, 215, 0, 217, 0, 219, 0, 221, 0, 223, 0, 225, 0, 227, 0,
+		229, 0, 231, 0, 233, 0, 235, 0, 237, 0, 239, 0, 241, 0, 243, 0, 245, 0,
+		247, 0, 249, 0, 251, 0, 253, 0, 255, 0, 257, 0, 259, 0, 261, 0, 263, 0,
+		265, 0, 267, 102, 1, 0, 35, 3, 0, 65, 90, 95, 95, 97, 122, 4, 0, 48, 57,
+		65, 90, 95, 95, 97, 122, 3, 0, 9, 10, 13, 13, 32, 32, 1, 0, 48, 57, 1,
+		0, 49, 57, 2, 0, 69, 69, 101, 101, 2, 0, 43, 43, 45, 45, 2, 0, 34, 34,
+		92, 92, 8, 0, 34, 34, 47, 47, 92, 92, 98, 98, 102, 102, 110, 110, 114,
+		114, 116, 116, 3, 0, 48, 57, 65, 70, 97, 102, 2, 0, 65, 65, 97, 97, 2,
+		0, 66, 66, 98, 98, 2, 0, 67, 67, 99, 99, 2, 0, 68, 68, 100, 100, 2, 0,
+		70, 70, 102, 102, 2, 0, 71, 71, 103, 103, 2, 0, 72, 72, 104, 104, 2, 0,
+		73, 73, 105, 105, 2, 0, 74, 74, 106, 106, 2, 0, 75, 75, 107, 107, 2, 0,
+		76, 76, 108, 108, 2, 0, 77, 77, 109, 109, 2, 0, 78, 78, 110, 110, 2, 0,
+		79, 79, 111, 111, 2, 0, 80, 80, 112, 112, 2, 0, 81, 81, 113, 113, 2, 0,
+		82, 82, 114, 114, 2, 0, 83, 83, 115, 115, 2, 0, 84, 84, 116, 116, 2, 0,
+		85, 85, 117, 117, 2, 0, 86, 86, 118, 118, 2, 0, 87, 87, 119, 119, 2, 0,
+		88, 88, 120, 120, 2, 0, 89, 89, 121, 121, 2, 0, 90, 90, 122, 122, 1274,
+		0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0,
+		0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0,
+		0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0,
+		0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1,
+		0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39,
+		1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0,
+		47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0,
+		0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0,
+		0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0,
+		0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1,
+		0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85,
+		1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0,
+		93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0,
+		0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 105, 1, 0, 0, 0, 0, 107, 1,
+		0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0,
+		115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 121, 1, 0,
+		0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 129,
+		1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0, 133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0,
+		0, 137, 1, 0, 0, 0, 0, 139, 1, 0, 0, 0, 0, 141, 1, 0, 0, 0, 0, 143, 1,
+		0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147, 1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 0,
+		151, 1, 0, 0, 0, 0, 153, 1, 0, 0, 0, 0, 155, 1, 0, 0, 0, 0, 157, 1, 0,
+		0, 0, 0, 159, 1, 0, 0, 0, 0, 161, 1, 0, 0, 0, 0, 163, 1, 0, 0, 0, 0, 165,
+		1, 0, 0, 0, 0, 167, 1, 0, 0, 0, 0, 169, 1, 0, 0, 0, 0, 171, 1, 0, 0, 0,
+		0, 173, 1, 0, 0, 0, 0, 175, 1, 0, 0, 0, 0, 177, 1, 0, 0, 0, 0, 179, 1,
+		0, 0, 0, 0, 181, 1, 0, 0, 0, 0, 183, 1, 0, 0, 0, 0, 189, 1, 0, 0, 0, 0,
+		191, 1, 0, 0, 0, 0, 193, 1, 0, 0, 0, 0, 195, 1, 0, 0, 0, 0, 197, 1, 0,
+		0, 0, 0, 199, 1, 0, 0, 0, 0, 201, 1, 0, 0, 0, 0, 203, 1, 0, 0, 0, 0, 205,
+		1, 0, 0, 0, 0, 267, 1, 0, 0, 0, 1, 269, 1, 0, 0, 0, 3, 271, 1, 0, 0, 0,
+		5, 273, 1, 0, 0, 0, 7, 277, 1, 0, 0, 0, 9, 281, 1, 0, 0, 0, 11, 285, 1,
+		0, 0, 0, 13, 289, 1, 0, 0, 0, 15, 296, 1, 0, 0, 0, 17, 312, 1, 0, 0, 0,
+		19, 319, 1, 0, 0, 0, 21, 328, 1, 0, 0, 0, 23, 339, 1, 0, 0, 0, 25, 345,
+		1, 0, 0, 0, 27, 351, 1, 0, 0, 0, 29, 356, 1, 0, 0, 0, 31, 363, 1, 0, 0,
+		0, 33, 370, 1, 0, 0, 0, 35, 378, 1, 0, 0, 0, 37, 385, 1, 0, 0, 0, 39, 391,
+		1, 0, 0, 0, 41, 395, 1, 0, 0, 0, 43, 400, 1, 0, 0, 0, 45, 406, 1, 0, 0,
+		0, 47, 410, 1, 0, 0, 0, 49, 421, 1, 0, 0, 0, 51, 429, 1, 0, 0, 0, 53, 438,
+		1, 0, 0, 0, 55, 447, 1, 0, 0, 0, 57, 454, 1, 0, 0, 0, 59, 459, 1, 0, 0,
+		0, 61, 470, 1, 0, 0, 0, 63, 475, 1, 0, 0, 0, 65, 486, 1, 0, 0, 0, 67, 492,
+		1, 0, 0, 0, 69, 496, 1, 0, 0, 0, 71, 501, 1, 0, 0, 0, 73, 513, 1, 0, 0,
+		0, 75, 524, 1, 0, 0, 0, 77, 529, 1, 0, 0, 0, 79, 532, 1, 0, 0, 0, 81, 537,
+		1, 0, 0, 0, 83, 542, 1, 0, 0, 0, 85, 547, 1, 0, 0, 0, 87, 551, 1, 0, 0,
+		0, 89, 556, 1, 0, 0, 0, 91, 563, 1, 0, 0, 0, 93, 567, 1, 0, 0, 0, 95, 573,
+		1, 0, 0, 0, 97, 580, 1, 0, 0, 0, 99, 585, 1, 0, 0, 0, 101, 599, 1, 0, 0,
+		0, 103, 602, 1, 0, 0, 0, 105, 605, 1, 0, 0, 0, 107, 607, 1, 0, 0, 0, 109,
+		609, 1, 0, 0, 0, 111, 612, 1, 0, 0, 0, 113, 615, 1, 0, 0, 0, 115, 617,
+		1, 0, 0, 0, 117, 620, 1, 0, 0, 0, 119, 623, 1, 0, 0, 0, 121, 625, 1, 0,
+		0, 0, 123, 627, 1, 0, 0, 0, 125, 640, 1, 0, 0, 0, 127, 765, 1, 0, 0, 0,
+		129, 796, 1, 0, 0, 0, 131, 798, 1, 0, 0, 0, 133, 801, 1, 0, 0, 0, 135,
+		805, 1, 0, 0, 0, 137, 813, 1, 0, 0, 0, 139, 826, 1, 0, 0, 0, 141, 828,
+		1, 0, 0, 0, 143, 842, 1, 0, 0, 0, 145, 844, 1, 0, 0, 0, 147, 853, 1, 0,
+		0, 0, 149, 855, 1, 0, 0, 0, 151, 872, 1, 0, 0, 0, 153, 874, 1, 0, 0, 0,
+		155, 886, 1, 0, 0, 0, 157, 1048, 1, 0, 0, 0, 159, 1050, 1, 0, 0, 0, 161,
+		1053, 1, 0, 0, 0, 163, 1058, 1, 0, 0, 0, 165, 1066, 1, 0, 0, 0, 167, 1072,
+		1, 0, 0, 0, 169, 1074, 1, 0, 0, 0, 171, 1076, 1, 0, 0, 0, 173, 1078, 1,
+		0, 0, 0, 175, 1080, 1, 0, 0, 0, 177, 1082, 1, 0, 0, 0, 179, 1084, 1, 0,
+		0, 0, 181, 1086, 1, 0, 0, 0, 183, 1112, 1, 0, 0, 0, 185, 1122, 1, 0, 0,
+		0, 187, 1124, 1, 0, 0, 0, 189, 1130, 1, 0, 0, 0, 191, 1133, 1, 0, 0, 0,
+		193, 1135, 1, 0, 0, 0, 195, 1138, 1, 0, 0, 0, 197, 1140, 1, 0, 0, 0, 199,
+		1143, 1, 0, 0, 0, 201, 1146, 1, 0, 0, 0, 203, 1152, 1, 0, 0, 0, 205, 1161,
+		1, 0, 0, 0, 207, 1171, 1, 0, 0, 0, 209, 1176, 1, 0, 0, 0, 211, 1182, 1,
+		0, 0, 0, 213, 1184, 1, 0, 0, 0, 215, 1186, 1, 0, 0, 0, 217, 1188, 1, 0,
+		0, 0, 219, 1190, 1, 0, 0, 0, 221, 1192, 1, 0, 0, 0, 223, 1194, 1, 0, 0,
+		0, 225, 1196, 1, 0, 0, 0, 227, 1198, 1, 0, 0, 0, 229, 1200, 1, 0, 0, 0,
+		231, 1202, 1, 0, 0, 0, 233, 1204, 1, 0, 0, 0, 235, 1206, 1, 0, 0, 0, 237,
+		1208, 1, 0, 0, 0, 239, 1210, 1, 0, 0, 0, 241, 1212, 1, 0, 0, 0, 243, 1214,
+		1, 0, 0, 0, 245, 1216, 1, 0, 0, 0, 247, 1218, 1, 0, 0, 0, 249, 1220, 1,
+		0, 0, 0, 251, 1222, 1, 0, 0, 0, 253, 1224, 1, 0, 0, 0, 255, 1226, 1, 0,
+		0, 0, 257, 1228, 1, 0, 0, 0, 259, 1230, 1, 0, 0, 0, 261, 1232, 1, 0, 0,
+		0, 263, 1234, 1, 0, 0, 0, 265, 1236, 1, 0, 0, 0, 267, 1238, 1, 0, 0, 0,
+		269, 270, 5, 59, 0, 0, 270, 2, 1, 0, 0, 0, 271, 272, 5, 42, 0, 0, 272,
+		4, 1, 0, 0, 0, 273, 274, 5, 115, 0, 0, 274, 275, 5, 117, 0, 0, 275, 276,
+		5, 109, 0, 0, 276, 6, 1, 0, 0, 0, 277, 278, 5, 97, 0, 0, 278, 279, 5, 118,
+		0, 0, 279, 280, 5, 103, 0, 0, 280, 8, 1, 0, 0, 0, 281, 282, 5, 109, 0,
+		0, 282, 283, 5, 97, 0, 0, 283, 284, 5, 120, 0, 0, 284, 10, 1, 0, 0, 0,
+		285, 286, 5, 109, 0, 0, 286, 287, 5, 105, 0, 0, 287, 288, 5, 110, 0, 0,
+		288, 12, 1, 0, 0, 0, 289, 290, 5, 109, 0, 0, 290, 291, 5, 101, 0, 0, 291,
+		292, 5, 100, 0, 0, 292, 293, 5, 105, 0, 0, 293, 294, 5, 97, 0, 0, 294,
+		295, 5, 110, 0, 0, 295, 14, 1, 0, 0, 0, 296, 297, 5, 112, 0, 0, 297, 298,
+		5, 101, 0, 0, 298, 299, 5, 114, 0, 0, 299, 300, 5, 99, 0, 0, 300, 301,
+		5, 101, 0, 0, 301, 302, 5, 110, 0, 0, 302, 303, 5, 116, 0, 0, 303, 304,
+		5, 105, 0, 0, 304, 305, 5, 108, 0, 0, 305, 306, 5, 101, 0, 0, 306, 307,
+		5, 95, 0, 0, 307, 308, 5, 99, 0, 0, 308, 309, 5, 111, 0, 0, 309, 310, 5,
+		110, 0, 0, 310, 311, 5, 116, 0, 0, 311, 16, 1, 0, 0, 0, 312, 313, 5, 115,
+		0, 0, 313, 314, 5, 116, 0, 0, 314, 315, 5, 100, 0, 0, 315, 316, 5, 100,
+		0, 0, 316, 317, 5, 101, 0, 0, 317, 318, 5, 118, 0, 0, 318, 18, 1, 0, 0,
+		0, 319, 320, 5, 118, 0, 0, 320, 321, 5, 97, 0, 0, 321, 322, 5, 114, 0,
+		0, 322, 323, 5, 105, 0, 0, 323, 324, 5, 97, 0, 0, 324, 325, 5, 110, 0,
+		0, 325, 326, 5, 99, 0, 0, 326, 327, 5, 101, 0, 0, 327, 20, 1, 0, 0, 0,
+		328, 329, 5, 115, 0, 0, 329, 330, 5, 116, 0, 0, 330, 331, 5, 114, 0, 0,
+		331, 332, 5, 105, 0, 0, 332, 333, 5, 110, 0, 0, 333, 334, 5, 103, 0, 0,
+		334, 335, 5, 95, 0, 0, 335, 336, 5, 97, 0, 0, 336, 337, 5, 103, 0, 0, 337,
+		338, 5, 103, 0, 0, 338, 22, 1, 0, 0, 0, 339, 340, 5, 117, 0, 0, 340, 341,
+		5, 112, 0, 0, 341, 342, 5, 112, 0, 0, 342, 343, 5, 101, 0, 0, 343, 344,
+		5, 114, 0, 0, 344, 24, 1, 0, 0, 0, 345, 346, 5, 108, 0, 0, 346, 347, 5,
+		111, 0, 0, 347, 348, 5, 119, 0, 0, 348, 349, 5, 101, 0, 0, 349, 350, 5,
+		114, 0, 0, 350, 26, 1, 0, 0, 0, 351, 352, 5, 116, 0, 0, 352, 353, 5, 114,
+		0, 0, 353, 354, 5, 105, 0, 0, 354, 355, 5, 109, 0, 0, 355, 28, 1, 0, 0,
+		0, 356, 357, 5, 115, 0, 0, 357, 358, 5, 117, 0, 0, 358, 359, 5, 98, 0,
+		0, 359, 360, 5, 115, 0, 0, 360, 361, 5, 116, 0, 0, 361, 362, 5, 114, 0,
+		0, 362, 30, 1, 0, 0, 0, 363, 364, 5, 108, 0, 0, 364, 365, 5, 101, 0, 0,
+		365, 366, 5, 110, 0, 0, 366, 367, 5, 103, 0, 0, 367, 368, 5, 116, 0, 0,
+		368, 369, 5, 104, 0, 0, 369, 32, 1, 0, 0, 0, 370, 371, 5, 114, 0, 0, 371,
+		372, 5, 101, 0, 0, 372, 373, 5, 112, 0, 0, 373, 374, 5, 108, 0, 0, 374,
+		375, 5, 97, 0, 0, 375, 376, 5, 99, 0, 0, 376, 377, 5, 101, 0, 0, 377, 34,
+		1, 0, 0, 0, 378, 379, 5, 99, 0, 0, 379, 380, 5, 111, 0, 0, 380, 381, 5,
+		110, 0, 0, 381, 382, 5, 99, 0, 0, 382, 383, 5, 97, 0, 0, 383, 384, 5, 116,
+		0, 0, 384, 36, 1, 0, 0, 0, 385, 386, 5, 114, 0, 0, 386, 387, 5, 111, 0,
+		0, 387, 388, 5, 117, 0, 0, 388, 389, 5, 110, 0, 0, 389, 390, 5, 100, 0,
+		0, 390, 38, 1, 0, 0, 0, 391, 392, 5, 97, 0, 0, 392, 393, 5, 98, 0, 0, 393,
+		394, 5, 115, 0, 0, 394, 40, 1, 0, 0, 0, 395, 396, 5, 99, 0, 0, 396, 397,
+		5, 101, 0, 0, 397, 398, 5, 105, 0, 0, 398, 399, 5, 108, 0, 0, 399, 42,
+		1, 0, 0, 0, 400, 401, 5, 102, 0, 0, 401, 402, 5, 108, 0, 0, 402, 403, 5,
+		111, 0, 0, 403, 404, 5, 111, 0, 0, 404, 405, 5, 114, 0, 0, 405, 44, 1,
+		0, 0, 0, 406, 407, 5, 110, 0, 0, 407, 408, 5, 111, 0, 0, 408, 409, 5, 119,
+		0, 0, 409, 46, 1, 0, 0, 0, 410, 411, 5, 100, 0, 0, 411, 412, 5, 97, 0,
+		0, 412, 413, 5, 116, 0, 0, 413, 414, 5, 101, 0, 0, 414, 415, 5, 95, 0,
+		0, 415, 416, 5, 116, 0, 0, 416, 417, 5, 114, 0, 0, 417, 418, 5, 117, 0,
+		0, 418, 419, 5, 110, 0, 0, 419, 420, 5, 99, 0, 0, 420, 48, 1, 0, 0, 0,
+		421, 422, 5, 101, 0, 0, 422, 423, 5, 120, 0, 0, 423, 424, 5, 116, 0, 0,
+		424, 425, 5, 114, 0, 0, 425, 426, 5, 97, 0, 0, 426, 427, 5, 99, 0, 0, 427,
+		428, 5, 116, 0, 0, 428, 50, 1, 0, 0, 0, 429, 430, 5, 100, 0, 0, 430, 431,
+		5, 97, 0, 0, 431, 432, 5, 116, 0, 0, 432, 433, 5, 101, 0, 0, 433, 434,
+		5, 95, 0, 0, 434, 435, 5, 97, 0, 0, 435, 436, 5, 100, 0, 0, 436, 437, 5,
+		100, 0, 0, 437, 52, 1, 0, 0, 0, 438, 439, 5, 99, 0, 0, 439, 440, 5, 111,
+		0, 0, 440, 441, 5, 97, 0, 0, 441, 442, 5, 108, 0, 0, 442, 443, 5, 101,
+		0, 0, 443, 444, 5, 115, 0, 0, 444, 445, 5, 99, 0, 0, 445, 446, 5, 101,
+		0, 0, 446, 54, 1, 0, 0, 0, 447, 448, 5, 110, 0, 0, 448, 449, 5, 117, 0,
+		0, 449, 450, 5, 108, 0, 0, 450, 451, 5, 108, 0, 0, 451, 452, 5, 105, 0,
+		0, 452, 453, 5, 102, 0, 0, 453, 56, 1, 0, 0, 0, 454, 455, 5, 99, 0, 0,
+		455, 456, 5, 97, 0, 0, 456, 457, 5, 115, 0, 0, 457, 458, 5, 116, 0, 0,
+		458, 58, 1, 0, 0, 0, 459, 460, 5, 114, 0, 0, 460, 461, 5, 111, 0, 0, 461,
+		462, 5, 119, 0, 0, 462, 463, 5, 95, 0, 0, 463, 464, 5, 110, 0, 0, 464,
+		465, 5, 117, 0, 0, 465, 466, 5, 109, 0, 0, 466, 467, 5, 98, 0, 0, 467,
+		468, 5, 101, 0, 0, 468, 469, 5, 114, 0, 0, 469, 60, 1, 0, 0, 0, 470, 471,
+		5, 114, 0, 0, 471, 472, 5, 97, 0, 0, 472, 473, 5, 110, 0, 0, 473, 474,
+		5, 107, 0, 0, 474, 62, 1, 0, 0, 0, 475, 476, 5, 100, 0, 0, 476, 477, 5,
+		101, 0, 0, 477, 478, 5, 110, 0, 0, 478, 479, 5, 115, 0, 0, 479, 480, 5,
+		101, 0, 0, 480, 481, 5, 95, 0, 0, 481, 482, 5, 114, 0, 0, 482, 483, 5,
+		97, 0, 0, 483, 484, 5, 110, 0, 0, 484, 485, 5, 107, 0, 0, 485, 64, 1, 0,
+		0, 0, 486, 487, 5, 110, 0, 0, 487, 488, 5, 116, 0, 0, 488, 489, 5, 105,
+		0, 0, 489, 490, 5, 108, 0, 0, 490, 491, 5, 101, 0, 0, 491, 66, 1, 0, 0,
+		0, 492, 493, 5, 108, 0, 0, 493, 494, 5, 97, 0, 0, 494, 495, 5, 103, 0,
+		0, 495, 68, 1, 0, 0, 0, 496, 497, 5, 108, 0, 0, 497, 498, 5, 101, 0, 0,
+		498, 499, 5, 97, 0, 0, 499, 500, 5, 100, 0, 0, 500, 70, 1, 0, 0, 0, 501,
+		502, 5, 102, 0, 0, 502, 503, 5, 105, 0, 0, 503, 504, 5, 114, 0, 0, 504,
+		505, 5, 115, 0, 0, 505, 506, 5, 116, 0, 0, 506, 507, 5, 95, 0, 0, 507,
+		508, 5, 118, 0, 0, 508, 509, 5, 97, 0, 0, 509, 510, 5, 108, 0, 0, 510,
+		511, 5, 117, 0, 0, 511, 512, 5, 101, 0, 0, 512, 72, 1, 0, 0, 0, 513, 514,
+		5, 108, 0, 0, 514, 515, 5, 97, 0, 0, 515, 516, 5, 115, 0, 0, 516, 517,
+		5, 116, 0, 0, 517, 518, 5, 95, 0, 0, 518, 519, 5, 118, 0, 0, 519, 520,
+		5, 97, 0, 0, 520, 521, 5, 108, 0, 0, 521, 522, 5, 117, 0, 0, 522, 523,
+		5, 101, 0, 0, 523, 74, 1, 0, 0, 0, 524, 525, 5, 111, 0, 0, 525, 526, 5,
+		118, 0, 0, 526, 527, 5, 101, 0, 0, 527, 528, 5, 114, 0, 0, 528, 76, 1,
+		0, 0, 0, 529, 530, 5, 105, 0, 0, 530, 531, 5, 102, 0, 0, 531, 78, 1, 0,
+		0, 0, 532, 533, 5, 116, 0, 0, 533, 534, 5, 104, 0, 0, 534, 535, 5, 101,
+		0, 0, 535, 536, 5, 110, 0, 0, 536, 80, 1, 0, 0, 0, 537, 538, 5, 101, 0,
+		0, 538, 539, 5, 108, 0, 0, 539, 540, 5, 105, 0, 0, 540, 541, 5, 102, 0,
+		0, 541, 82, 1, 0, 0, 0, 542, 543, 5, 101, 0, 0, 543, 544, 5, 108, 0, 0,
+		544, 545, 5, 115, 0, 0, 545, 546, 5, 101, 0, 0, 546, 84, 1, 0, 0, 0, 547,
+		548, 5, 101, 0, 0, 548, 549, 5, 110, 0, 0, 549, 550, 5, 100, 0, 0, 550,
+		86, 1, 0, 0, 0, 551, 552, 5, 119, 0, 0, 552, 553, 5, 105, 0, 0, 553, 554,
+		5, 116, 0, 0, 554, 555, 5, 104, 0, 0, 555, 88, 1, 0, 0, 0, 556, 557, 5,
+		117, 0, 0, 557, 558, 5, 110, 0, 0, 558, 559, 5, 105, 0, 0, 559, 560, 5,
+		113, 0, 0, 560, 561, 5, 117, 0, 0, 561, 562, 5, 101, 0, 0, 562, 90, 1,
+		0, 0, 0, 563, 564, 5, 116, 0, 0, 564, 565, 5, 111, 0, 0, 565, 566, 5, 112,
+		0, 0, 566, 92, 1, 0, 0, 0, 567, 568, 5, 99, 0, 0, 568, 569, 5, 111, 0,
+		0, 569, 570, 5, 117, 0, 0, 570, 571, 5, 110, 0, 0, 571, 572, 5, 116, 0,
+		0, 572, 94, 1, 0, 0, 0, 573, 574, 5, 114, 0, 0, 574, 575, 5, 111, 0, 0,
+		575, 576, 5, 108, 0, 0, 576, 577, 5, 108, 0, 0, 577, 578, 5, 117, 0, 0,
+		578, 579, 5, 112, 0, 0, 579, 96, 1, 0, 0, 0, 580, 581, 5, 99, 0, 0, 581,
+		582, 5, 117, 0, 0, 582, 583, 5, 98, 0, 0, 583, 584, 5, 101, 0, 0, 584,
+		98, 1, 0, 0, 0, 585, 586, 5, 103, 0, 0, 586, 587, 5, 114, 0, 0, 587, 588,
+		5, 111, 0, 0, 588, 589, 5, 117, 0, 0, 589, 590, 5, 112, 0, 0, 590, 591,
+		5, 105, 0, 0, 591, 592, 5, 110, 0, 0, 592, 593, 5, 103, 0, 0, 593, 594,
+		5, 95, 0, 0, 594, 595, 5, 115, 0, 0, 595, 596, 5, 101, 0, 0, 596, 597,
+		5, 116, 0, 0, 597, 598, 5, 115, 0, 0, 598, 100, 1, 0, 0, 0, 599, 600, 5,
+		46, 0, 0, 600, 601, 5, 91, 0, 0, 601, 102, 1, 0, 0, 0, 602, 603, 5, 124,
+		0, 0, 603, 604, 5, 124, 0, 0, 604, 104, 1, 0, 0, 0, 605, 606, 5, 47, 0,
+		0, 606, 106, 1, 0, 0, 0, 607, 608, 5, 37, 0, 0, 608, 108, 1, 0, 0, 0, 609,
+		610, 5, 60, 0, 0, 610, 611, 5, 60, 0, 0, 611, 110, 1, 0, 0, 0, 612, 613,
+		5, 62, 0, 0, 613, 614, 5, 62, 0, 0, 614, 112, 1, 0, 0, 0, 615, 616, 5,
+		38, 0, 0, 616, 114, 1, 0, 0, 0, 617, 618, 5, 38, 0, 0, 618, 619, 5, 38,
+		0, 0, 619, 116, 1, 0, 0, 0, 620, 621, 5, 47, 0, 0, 621, 622, 5, 47, 0,
+		0, 622, 118, 1, 0, 0, 0, 623, 624, 5, 126, 0, 0, 624, 120, 1, 0, 0, 0,
+		625, 626, 5, 33, 0, 0, 626, 122, 1, 0, 0, 0, 627, 628, 5, 112, 0, 0, 628,
+		629, 5, 97, 0, 0, 629, 630, 5, 114, 0, 0, 630, 631, 5, 116, 0, 0, 631,
+		632, 5, 105, 0, 0, 632, 633, 5, 116, 0, 0, 633, 634, 5, 105, 0, 0, 634,
+		635, 5, 111, 0, 0, 635, 636, 5, 110, 0, 0, 636, 637, 5, 95, 0, 0, 637,
+		638, 5, 98, 0, 0, 638, 639, 5, 121, 0, 0, 639, 124, 1, 0, 0, 0, 640, 641,
+		5, 95, 0, 0, 641, 642, 3, 163, 81, 0, 642, 126, 1, 0, 0, 0, 643, 644, 5,
+		106, 0, 0, 644, 645, 5, 111, 0, 0, 645, 646, 5, 105, 0, 0, 646, 766, 5,
+		110, 0, 0, 647, 648, 5, 105, 0, 0, 648, 649, 5, 110, 0, 0, 649, 650, 5,
+		110, 0, 0, 650, 651, 5, 101, 0, 0, 651, 652, 5, 114, 0, 0, 652, 653, 5,
+		95, 0, 0, 653, 654, 5, 106, 0, 0, 654, 655, 5, 111, 0, 0, 655, 656, 5,
+		105, 0, 0, 656, 766, 5, 110, 0, 0, 657, 658, 5, 108, 0, 0, 658, 659, 5,
+		101, 0, 0, 659, 660, 5, 102, 0, 0, 660, 661, 5, 116, 0, 0, 661, 662, 5,
+		95, 0, 0, 662, 663, 5, 106, 0, 0, 663, 664, 5, 111, 0, 0, 664, 665, 5,
+		105, 0, 0, 665, 766, 5, 110, 0, 0, 666, 667, 5, 108, 0, 0, 667, 668, 5,
+		106, 0, 0, 668, 669, 5, 111, 0, 0, 669, 670, 5, 105, 0, 0, 670, 766, 5,
+		110, 0, 0, 671, 672, 5, 108, 0, 0, 672, 673, 5, 101, 0, 0, 673, 674, 5,
+		102, 0, 0, 674, 675, 5, 116, 0, 0, 675, 676, 5, 95, 0, 0, 676, 677, 5,
+		111, 0, 0, 677, 678, 5, 117, 0, 0, 678, 679, 5, 116, 0, 0, 679, 680, 5,
+		101, 0, 0, 680, 681, 5, 114, 0, 0, 681, 682, 5, 95, 0, 0, 682, 683, 5,
+		106, 0, 0, 683, 684, 5, 111, 0, 0, 684, 685, 5, 105, 0, 0, 685, 766, 5,
+		110, 0, 0, 686, 687, 5, 108, 0, 0, 687, 688, 5, 111, 0, 0, 688, 689, 5,
+		106, 0, 0, 689, 690, 5, 111, 0, 0, 690, 691, 5, 105, 0, 0, 691, 766, 5,
+		110, 0, 0, 692, 693, 5, 114, 0, 0, 693, 694, 5, 105, 0, 0, 694, 695, 5,
+		103, 0, 0, 695, 696, 5, 104, 0, 0, 696, 697, 5, 116, 0, 0, 697, 698, 5,
+		95, 0, 0, 698, 699, 5, 106, 0, 0, 699, 700, 5, 111, 0, 0, 700, 701, 5,
+		105, 0, 0, 701, 766, 5, 110, 0, 0, 702, 703, 5, 114, 0, 0, 703, 704, 5,
+		106, 0, 0, 704, 705, 5, 111, 0, 0, 705, 706, 5, 105, 0, 0, 706, 766, 5,
+		110, 0, 0, 707, 708, 5, 114, 0, 0, 708, 709, 5, 105, 0, 0, 709, 710, 5,
+		103, 0, 0, 710, 711, 5, 104, 0, 0, 711, 712, 5, 116, 0, 0, 712, 713, 5,
+		95, 0, 0, 713, 714, 5, 111, 0, 0, 714, 715, 5, 117, 0, 0, 715, 716, 5,
+		116, 0, 0, 716, 717, 5, 101, 0, 0, 717, 718, 5, 114, 0, 0, 718, 719, 5,
+		95, 0, 0, 719, 720, 5, 106, 0, 0, 720, 721, 5, 111, 0, 0, 721, 722, 5,
+		105, 0, 0, 722, 766, 5, 110, 0, 0, 723, 724, 5, 114, 0, 0, 724, 725, 5,
+		111, 0, 0, 725, 726, 5, 106, 0, 0, 726, 727, 5, 111, 0, 0, 727, 728, 5,
+		105, 0, 0, 728, 766, 5, 110, 0, 0, 729, 730, 5, 102, 0, 0, 730, 731, 5,
+		117, 0, 0, 731, 732, 5, 108, 0, 0, 732, 733, 5, 108, 0, 0, 733, 734, 5,
+		95, 0, 0, 734, 735, 5, 111, 0, 0, 735, 736, 5, 117, 0, 0, 736, 737, 5,
+		116, 0, 0, 737, 738, 5, 101, 0, 0, 738, 739, 5, 114, 0, 0, 739, 740, 5,
+		95, 0, 0, 740, 741, 5, 106, 0, 0, 741, 742, 5, 111, 0, 0, 742, 743, 5,
+		105, 0, 0, 743, 766, 5, 110, 0, 0, 744, 745, 5, 102, 0, 0, 745, 746, 5,
+		111, 0, 0, 746, 747, 5, 106, 0, 0, 747, 748, 5, 111, 0, 0, 748, 749, 5,
+		105, 0, 0, 749, 766, 5, 110, 0, 0, 750, 751, 5, 99, 0, 0, 751, 752, 5,
+		114, 0, 0, 752, 753, 5, 111, 0, 0, 753, 754, 5, 115, 0, 0, 754, 755, 5,
+		115, 0, 0, 755, 756, 5, 95, 0, 0, 756, 757, 5, 106, 0, 0, 757, 758, 5,
+		111, 0, 0, 758, 759, 5, 105, 0, 0, 759, 766, 5, 110, 0, 0, 760, 761, 5,
+		120, 0, 0, 761, 762, 5, 106, 0, 0, 762, 763, 5, 111, 0, 0, 763, 764, 5,
+		105, 0, 0, 764, 766, 5, 110, 0, 0, 765, 643, 1, 0, 0, 0, 765, 647, 1, 0,
+		0, 0, 765, 657, 1, 0, 0, 0, 765, 666, 1, 0, 0, 0, 765, 671, 1, 0, 0, 0,
+		765, 686, 1, 0, 0, 0, 765, 692, 1, 0, 0, 0, 765, 702, 1, 0, 0, 0, 765,
+		707, 1, 0, 0, 0, 765, 723, 1, 0, 0, 0, 765, 729, 1, 0, 0, 0, 765, 744,
+		1, 0, 0, 0, 765, 750, 1, 0, 0, 0, 765, 760, 1, 0, 0, 0, 766, 128, 1, 0,
+		0, 0, 767, 768, 5, 117, 0, 0, 768, 769, 5, 110, 0, 0, 769, 770, 5, 105,
+		0, 0, 770, 771, 5, 111, 0, 0, 771, 797, 5, 110, 0, 0, 772, 773, 5, 117,
+		0, 0, 773, 774, 5, 110, 0, 0, 774, 775, 5, 105, 0, 0, 775, 776, 5, 111,
+		0, 0, 776, 777, 5, 110, 0, 0, 777, 778, 5, 95, 0, 0, 778, 779, 5, 97, 0,
+		0, 779, 780, 5, 108, 0, 0, 780, 797, 5, 108, 0, 0, 781, 782, 5, 105, 0,
+		0, 782, 783, 5, 110, 0, 0, 783, 784, 5, 116, 0, 0, 784, 785, 5, 101, 0,
+		0, 785, 786, 5, 114, 0, 0, 786, 787, 5, 115, 0, 0, 787, 788, 5, 101, 0,
+		0, 788, 789, 5, 99, 0, 0, 789, 797, 5, 116, 0, 0, 790, 791, 5, 101, 0,
+		0, 791, 792, 5, 120, 0, 0, 792, 793, 5, 99, 0, 0, 793, 794, 5, 101, 0,
+		0, 794, 795, 5, 112, 0, 0, 795, 797, 5, 116, 0, 0, 796, 767, 1, 0, 0, 0,
+		796, 772, 1, 0, 0, 0, 796, 781, 1, 0, 0, 0, 796, 790, 1, 0, 0, 0, 797,
+		130, 1, 0, 0, 0, 798, 799, 5, 105, 0, 0, 799, 800, 5, 110, 0, 0, 800, 132,
+		1, 0, 0, 0, 801, 802, 5, 110, 0, 0, 802, 803, 5, 111, 0, 0, 803, 804, 5,
+		116, 0, 0, 804, 134, 1, 0, 0, 0, 805, 806, 5, 98, 0, 0, 806, 807, 5, 101,
+		0, 0, 807, 808, 5, 116, 0, 0, 808, 809, 5, 119, 0, 0, 809, 810, 5, 101,
+		0, 0, 810, 811, 5, 101, 0, 0, 811, 812, 5, 110, 0, 0, 812, 136, 1, 0, 0,
+		0, 813, 814, 5, 97, 0, 0, 814, 815, 5, 110, 0, 0, 815, 816, 5, 100, 0,
+		0, 816, 138, 1, 0, 0, 0, 817, 818, 5, 108, 0, 0, 818, 819, 5, 105, 0, 0,
+		819, 820, 5, 107, 0, 0, 820, 827, 5, 101, 0, 0, 821, 822, 5, 105, 0, 0,
+		822, 823, 5, 108, 0, 0, 823, 824, 5, 105, 0, 0, 824, 825, 5, 107, 0, 0,
+		825, 827, 5, 101, 0, 0, 826, 817, 1, 0, 0, 0, 826, 821, 1, 0, 0, 0, 827,
+		140, 1, 0, 0, 0, 828, 829, 5, 105, 0, 0, 829, 830, 5, 115, 0, 0, 830, 142,
+		1, 0, 0, 0, 831, 832, 5, 119, 0, 0, 832, 833, 5, 104, 0, 0, 833, 834, 5,
+		101, 0, 0, 834, 835, 5, 114, 0, 0, 835, 843, 5, 101, 0, 0, 836, 837, 5,
+		115, 0, 0, 837, 838, 5, 101, 0, 0, 838, 839, 5, 108, 0, 0, 839, 840, 5,
+		101, 0, 0, 840, 841, 5, 99, 0, 0, 841, 843, 5, 116, 0, 0, 842, 831, 1,
+		0, 0, 0, 842, 836, 1, 0, 0, 0, 843, 144, 1, 0, 0, 0, 844, 845, 5, 103,
+		0, 0, 845, 846, 5, 114, 0, 0, 846, 847, 5, 111, 0, 0, 847, 848, 5, 117,
+		0, 0, 848, 849, 5, 112, 0, 0, 849, 850, 5, 95, 0, 0, 850, 851, 5, 98, 0,
+		0, 851, 852, 5, 121, 0, 0, 852, 146, 1, 0, 0, 0, 853, 854, 5, 43, 0, 0,
+		854, 148, 1, 0, 0, 0, 855, 856, 5, 45, 0, 0, 856, 150, 1, 0, 0, 0, 857,
+		858, 5, 111, 0, 0, 858, 859, 5, 114, 0, 0, 859, 860, 5, 100, 0, 0, 860,
+		861, 5, 101, 0, 0, 861, 862, 5, 114, 0, 0, 862, 863, 5, 95, 0, 0, 863,
+		864, 5, 98, 0, 0, 864, 873, 5, 121, 0, 0, 865, 866, 5, 115, 0, 0, 866,
+		867, 5, 111, 0, 0, 867, 868, 5, 114, 0, 0, 868, 869, 5, 116, 0, 0, 869,
+		870, 5, 95, 0, 0, 870, 871, 5, 98, 0, 0, 871, 873, 5, 121, 0, 0, 872, 857,
+		1, 0, 0, 0, 872, 865, 1, 0, 0, 0, 873, 152, 1, 0, 0, 0, 874, 875, 5, 110,
+		0, 0, 875, 876, 5, 117, 0, 0, 876, 877, 5, 108, 0, 0, 877, 878, 5, 108,
+		0, 0, 878, 879, 5, 115, 0, 0, 879, 880, 5, 95, 0, 0, 880, 881, 5, 102,
+		0, 0, 881, 882, 5, 105, 0, 0, 882, 883, 5, 114, 0, 0, 883, 884, 5, 115,
+		0, 0, 884, 885, 5, 116, 0, 0, 885, 154, 1, 0, 0, 0, 886, 887, 5, 110, 0,
+		0, 887, 888, 5, 117, 0, 0, 888, 889, 5, 108, 0, 0, 889, 890, 5, 108, 0,
+		0, 890, 891, 5, 115, 0, 0, 891, 892, 5, 95, 0, 0, 892, 893, 5, 108, 0,
+		0, 893, 894, 5, 97, 0, 0, 894, 895, 5, 115, 0, 0, 895, 896, 5, 116, 0,
+		0, 896, 156, 1, 0, 0, 0, 897, 898, 5, 58, 0, 0, 898, 899, 5, 99, 0, 0,
+		899, 900, 5, 111, 0, 0, 900, 901, 5, 117, 0, 0, 901, 902, 5, 110, 0, 0,
+		902, 1049, 5, 116, 0, 0, 903, 904, 5, 58, 0, 0, 904, 905, 5, 99, 0, 0,
+		905, 906, 5, 111, 0, 0, 906, 907, 5, 117, 0, 0, 907, 908, 5, 110, 0, 0,
+		908, 909, 5, 116, 0, 0, 909, 910, 5, 95, 0, 0, 910, 911, 5, 117, 0, 0,
+		911, 912, 5, 110, 0, 0, 912, 913, 5, 105, 0, 0, 913, 914, 5, 113, 0, 0,
+		914, 915, 5, 117, 0, 0, 915, 1049, 5, 101, 0, 0, 916, 917, 5, 58, 0, 0,
+		917, 918, 5, 97, 0, 0, 918, 919, 5, 118, 0, 0, 919, 1049, 5, 103, 0, 0,
+		920, 921, 5, 58, 0, 0, 921, 922, 5, 103, 0, 0, 922, 923, 5, 114, 0, 0,
+		923, 924, 5, 111, 0, 0, 924, 925, 5, 117, 0, 0, 925, 926, 5, 112, 0, 0,
+		926, 927, 5, 95, 0, 0, 927, 928, 5, 98, 0, 0, 928, 1049, 5, 121, 0, 0,
+		929, 930, 5, 58, 0, 0, 930, 931, 5, 109, 0, 0, 931, 932, 5, 97, 0, 0, 932,
+		1049, 5, 120, 0, 0, 933, 934, 5, 58, 0, 0, 934, 935, 5, 109, 0, 0, 935,
+		936, 5, 105, 0, 0, 936, 1049, 5, 110, 0, 0, 937, 938, 5, 58, 0, 0, 938,
+		939, 5, 111, 0, 0, 939, 940, 5, 114, 0, 0, 940, 941, 5, 100, 0, 0, 941,
+		942, 5, 101, 0, 0, 942, 943, 5, 114, 0, 0, 943, 944, 5, 95, 0, 0, 944,
+		945, 5, 98, 0, 0, 945, 1049, 5, 121, 0, 0, 946, 947, 5, 58, 0, 0, 947,
+		948, 5, 117, 0, 0, 948, 949, 5, 110, 0, 0, 949, 950, 5, 105, 0, 0, 950,
+		951, 5, 113, 0, 0, 951, 952, 5, 117, 0, 0, 952, 1049, 5, 101, 0, 0, 953,
+		954, 5, 58, 0, 0, 954, 955, 5, 116, 0, 0, 955, 956, 5, 111, 0, 0, 956,
+		1049, 5, 112, 0, 0, 957, 958, 5, 58, 0, 0, 958, 959, 5, 114, 0, 0, 959,
+		960, 5, 111, 0, 0, 960, 961, 5, 108, 0, 0, 961, 962, 5, 108, 0, 0, 962,
+		963, 5, 117, 0, 0, 963, 1049, 5, 112, 0, 0, 964, 965, 5, 58, 0, 0, 965,
+		966, 5, 99, 0, 0, 966, 967, 5, 117, 0, 0, 967, 968, 5, 98, 0, 0, 968, 1049,
+		5, 101, 0, 0, 969, 970, 5, 58, 0, 0, 970, 971, 5, 103, 0, 0, 971, 972,
+		5, 114, 0, 0, 972, 973, 5, 111, 0, 0, 973, 974, 5, 117, 0, 0, 974, 975,
+		5, 112, 0, 0, 975, 976, 5, 105, 0, 0, 976, 977, 5, 110, 0, 0, 977, 978,
+		5, 103, 0, 0, 978, 979, 5, 95, 0, 0, 979, 980, 5, 115, 0, 0, 980, 981,
+		5, 101, 0, 0, 981, 982, 5, 116, 0, 0, 982, 1049, 5, 115, 0, 0, 983, 984,
+		5, 58, 0, 0, 984, 985, 5, 114, 0, 0, 985, 986, 5, 111, 0, 0, 986, 987,
+		5, 119, 0, 0, 987, 988, 5, 95, 0, 0, 988, 989, 5, 110, 0, 0, 989, 990,
+		5, 117, 0, 0, 990, 991, 5, 109, 0, 0, 991, 992, 5, 98, 0, 0, 992, 993,
+		5, 101, 0, 0, 993, 1049, 5, 114, 0, 0, 994, 995, 5, 58, 0, 0, 995, 996,
+		5, 114, 0, 0, 996, 997, 5, 97, 0, 0, 997, 998, 5, 110, 0, 0, 998, 1049,
+		5, 107, 0, 0, 999, 1000, 5, 58, 0, 0, 1000, 1001, 5, 100, 0, 0, 1001, 1002,
+		5, 101, 0, 0, 1002, 1003, 5, 110, 0, 0, 1003, 1004, 5, 115, 0, 0, 1004,
+		1005, 5, 101, 0, 0, 1005, 1006, 5, 95, 0, 0, 1006, 1007, 5, 114, 0, 0,
+		1007, 1008, 5, 97, 0, 0, 1008, 1009, 5, 110, 0, 0, 1009, 1049, 5, 107,
+		0, 0, 1010, 1011, 5, 58, 0, 0, 1011, 1012, 5, 110, 0, 0, 1012, 1013, 5,
+		116, 0, 0, 1013, 1014, 5, 105, 0, 0, 1014, 1015, 5, 108, 0, 0, 1015, 1049,
+		5, 101, 0, 0, 1016, 1017, 5, 58, 0, 0, 1017, 1018, 5, 108, 0, 0, 1018,
+		1019, 5, 97, 0, 0, 1019, 1049, 5, 103, 0, 0, 1020, 1021, 5, 58, 0, 0, 1021,
+		1022, 5, 108, 0, 0, 1022, 1023, 5, 101, 0, 0, 1023, 1024, 5, 97, 0, 0,
+		1024, 1049, 5, 100, 0, 0, 1025, 1026, 5, 58, 0, 0, 1026, 1027, 5, 102,
+		0, 0, 1027, 1028, 5, 105, 0, 0, 1028, 1029, 5, 114, 0, 0, 1029, 1030, 5,
+		115, 0, 0, 1030, 1031, 5, 116, 0, 0, 1031, 1032, 5, 95, 0, 0, 1032, 1033,
+		5, 118, 0, 0, 1033, 1034, 5, 97, 0, 0, 1034, 1035, 5, 108, 0, 0, 1035,
+		1036, 5, 117, 0, 0, 1036, 1049, 5, 101, 0, 0, 1037, 1038, 5, 58, 0, 0,
+		1038, 1039, 5, 108, 0, 0, 1039, 1040, 5, 97, 0, 0, 1040, 1041, 5, 115,
+		0, 0, 1041, 1042, 5, 116, 0, 0, 1042, 1043, 5, 95, 0, 0, 1043, 1044, 5,
+		118, 0, 0, 1044, 1045, 5, 97, 0, 0, 1045, 1046, 5, 108, 0, 0, 1046, 1047,
+		5, 117, 0, 0, 1047, 1049, 5, 101, 0, 0, 1048, 897, 1, 0, 0, 0, 1048, 903,
+		1, 0, 0, 0, 1048, 916, 1, 0, 0, 0, 1048, 920, 1, 0, 0, 0, 1048, 929, 1,
+		0, 0, 0, 1048, 933, 1, 0, 0, 0, 1048, 937, 1, 0, 0, 0, 1048, 946, 1, 0,
+		0, 0, 1048, 953, 1, 0, 0, 0, 1048, 957, 1, 0, 0, 0, 1048, 964, 1, 0, 0,
+		0, 1048, 969, 1, 0, 0, 0, 1048, 983, 1, 0, 0, 0, 1048, 994, 1, 0, 0, 0,
+		1048, 999, 1, 0, 0, 0, 1048, 1010, 1, 0, 0, 0, 1048, 1016, 1, 0, 0, 0,
+		1048, 1020, 1, 0, 0, 0, 1048, 1025, 1, 0, 0, 0, 1048, 1037, 1, 0, 0, 0,
+		1049, 158, 1, 0, 0, 0, 1050, 1051, 5, 36, 0, 0, 1051, 1052, 3, 163, 81,
+		0, 1052, 160, 1, 0, 0, 0, 1053, 1054, 5, 110, 0, 0, 1054, 1055, 5, 117,
+		0, 0, 1055, 1056, 5, 108, 0, 0, 1056, 1057, 5, 108, 0, 0, 1057, 162, 1,
+		0, 0, 0, 1058, 1062, 7, 0, 0, 0, 1059, 1061, 7, 1, 0, 0, 1060, 1059, 1,
+		0, 0, 0, 1061, 1064, 1, 0, 0, 0, 1062, 1060, 1, 0, 0, 0, 1062, 1063, 1,
+		0, 0, 0, 1063, 164, 1, 0, 0, 0, 1064, 1062, 1, 0, 0, 0, 1065, 1067, 7,
+		2, 0, 0, 1066, 1065, 1, 0, 0, 0, 1067, 1068, 1, 0, 0, 0, 1068, 1066, 1,
+		0, 0, 0, 1068, 1069, 1, 0, 0, 0, 1069, 1070, 1, 0, 0, 0, 1070, 1071, 6,
+		82, 0, 0, 1071, 166, 1, 0, 0, 0, 1072, 1073, 5, 40, 0, 0, 1073, 168, 1,
+		0, 0, 0, 1074, 1075, 5, 41, 0, 0, 1075, 170, 1, 0, 0, 0, 1076, 1077, 5,
+		91, 0, 0, 1077, 172, 1, 0, 0, 0, 1078, 1079, 5, 93, 0, 0, 1079, 174, 1,
+		0, 0, 0, 1080, 1081, 5, 44, 0, 0, 1081, 176, 1, 0, 0, 0, 1082, 1083, 5,
+		124, 0, 0, 1083, 178, 1, 0, 0, 0, 1084, 1085, 5, 58, 0, 0, 1085, 180, 1,
+		0, 0, 0, 1086, 1087, 3, 185, 92, 0, 1087, 182, 1, 0, 0, 0, 1088, 1113,
+		3, 181, 90, 0, 1089, 1091, 5, 45, 0, 0, 1090, 1089, 1, 0, 0, 0, 1090, 1091,
+		1, 0, 0, 0, 1091, 1092, 1, 0, 0, 0, 1092, 1093, 3, 185, 92, 0, 1093, 1095,
+		5, 46, 0, 0, 1094, 1096, 7, 3, 0, 0, 1095, 1094, 1, 0, 0, 0, 1096, 1097,
+		1, 0, 0, 0, 1097, 1095, 1, 0, 0, 0, 1097, 1098, 1, 0, 0, 0, 1098, 1100,
+		1, 0, 0, 0, 1099, 1101, 3, 187, 93, 0, 1100, 1099, 1, 0, 0, 0, 1100, 1101,
+		1, 0, 0, 0, 1101, 1113, 1, 0, 0, 0, 1102, 1104, 5, 45, 0, 0, 1103, 1102,
+		1, 0, 0, 0, 1103, 1104, 1, 0, 0, 0, 1104, 1105, 1, 0, 0, 0, 1105, 1106,
+		3, 185, 92, 0, 1106, 1107, 3, 187, 93, 0, 1107, 1113, 1, 0, 0, 0, 1108,
+		1110, 5, 45, 0, 0, 1109, 1108, 1, 0, 0, 0, 1109, 1110, 1, 0, 0, 0, 1110,
+		1111, 1, 0, 0, 0, 1111, 1113, 3, 185, 92, 0, 1112, 1088, 1, 0, 0, 0, 1112,
+		1090, 1, 0, 0, 0, 1112, 1103, 1, 0, 0, 0, 1112, 1109, 1, 0, 0, 0, 1113,
+		184, 1, 0, 0, 0, 1114, 1123, 5, 48, 0, 0, 1115, 1119, 7, 4, 0, 0, 1116,
+		1118, 7, 3, 0, 0, 1117, 1116, 1, 0, 0, 0, 1118, 1121, 1, 0, 0, 0, 1119,
+		1117, 1, 0, 0, 0, 1119, 1120, 1, 0, 0, 0, 1120, 1123, 1, 0, 0, 0, 1121,
+		1119, 1, 0, 0, 0, 1122, 1114, 1, 0, 0, 0, 1122, 1115, 1, 0, 0, 0, 1123,
+		186, 1, 0, 0, 0, 1124, 1126, 7, 5, 0, 0, 1125, 1127, 7, 6, 0, 0, 1126,
+		1125, 1, 0, 0, 0, 1126, 1127, 1, 0, 0, 0, 1127, 1128, 1, 0, 0, 0, 1128,
+		1129, 3, 185, 92, 0, 1129, 188, 1, 0, 0, 0, 1130, 1131, 5, 60, 0, 0, 1131,
+		1132, 5, 61, 0, 0, 1132, 190, 1, 0, 0, 0, 1133, 1134, 5, 60, 0, 0, 1134,
+		192, 1, 0, 0, 0, 1135, 1136, 5, 62, 0, 0, 1136, 1137, 5, 61, 0, 0, 1137,
+		194, 1, 0, 0, 0, 1138, 1139, 5, 62, 0, 0, 1139, 196, 1, 0, 0, 0, 1140,
+		1141, 5, 33, 0, 0, 1141, 1142, 5, 61, 0, 0, 1142, 198, 1, 0, 0, 0, 1143,
+		1144, 5, 61, 0, 0, 1144, 1145, 5, 61, 0, 0, 1145, 200, 1, 0, 0, 0, 1146,
+		1150, 5, 46, 0, 0, 1147, 1151, 3, 159, 79, 0, 1148, 1151, 3, 163, 81, 0,
+		1149, 1151, 3, 205, 102, 0, 1150, 1147, 1, 0, 0, 0, 1150, 1148, 1, 0, 0,
+		0, 1150, 1149, 1, 0, 0, 0, 1151, 202, 1, 0, 0, 0, 1152, 1153, 5, 64, 0,
+		0, 1153, 1158, 3, 163, 81, 0, 1154, 1155, 5, 47, 0, 0, 1155, 1157, 3, 163,
+		81, 0, 1156, 1154, 1, 0, 0, 0, 1157, 1160, 1, 0, 0, 0, 1158, 1156, 1, 0,
+		0, 0, 1158, 1159, 1, 0, 0, 0, 1159, 204, 1, 0, 0, 0, 1160, 1158, 1, 0,
+		0, 0, 1161, 1166, 5, 34, 0, 0, 1162, 1165, 3, 207, 103, 0, 1163, 1165,
+		8, 7, 0, 0, 1164, 1162, 1, 0, 0, 0, 1164, 1163, 1, 0, 0, 0, 1165, 1168,
+		1, 0, 0, 0, 1166, 1164, 1, 0, 0, 0, 1166, 1167, 1, 0, 0, 0, 1167, 1169,
+		1, 0, 0, 0, 1168, 1166, 1, 0, 0, 0, 1169, 1170, 5, 34, 0, 0, 1170, 206,
+		1, 0, 0, 0, 1171, 1174, 5, 92, 0, 0, 1172, 1175, 7, 8, 0, 0, 1173, 1175,
+		3, 209, 104, 0, 1174, 1172, 1, 0, 0, 0, 1174, 1173, 1, 0, 0, 0, 1175, 208,
+		1, 0, 0, 0, 1176, 1177, 5, 117, 0, 0, 1177, 1178, 3, 211, 105, 0, 1178,
+		1179, 3, 211, 105, 0, 1179, 1180, 3, 211, 105, 0, 1180, 1181, 3, 211, 105,
+		0, 1181, 210, 1, 0, 0, 0, 1182, 1183, 7, 9, 0, 0, 1183, 212, 1, 0, 0, 0,
+		1184, 1185, 7, 3, 0, 0, 1185, 214, 1, 0, 0, 0, 1186, 1187, 7, 10, 0, 0,
+		1187, 216, 1, 0, 0, 0, 1188, 1189, 7, 11, 0, 0, 1189, 218, 1, 0, 0, 0,
+		1190, 1191, 7, 12, 0, 0, 1191, 220, 1, 0, 0, 0, 1192, 1193, 7, 13, 0, 0,
+		1193, 222, 1, 0, 0, 0, 1194, 1195, 7, 5, 0, 0, 1195, 224, 1, 0, 0, 0, 1196,
+		1197, 7, 14, 0, 0, 1197, 226, 1, 0, 0, 0, 1198, 1199, 7, 15, 0, 0, 1199,
+		228, 1, 0, 0, 0, 1200, 1201, 7, 16, 0, 0, 1201, 230, 1, 0, 0, 0, 1202,
+		1203, 7, 17, 0, 0, 1203, 232, 1, 0, 0, 0, 1204, 1205, 7, 18, 0, 0, 1205,
+		234, 1, 0, 0, 0, 1206, 1207, 7, 19, 0, 0, 1207, 236, 1, 0, 0, 0, 1208,
+		1209, 7, 20, 0, 0, 1209, 238, 1, 0, 0, 0, 1210, 1211, 7, 21, 0, 0, 1211,
+		240, 1, 0, 0, 0, 1212, 1213, 7, 22, 0, 0, 1213, 242, 1, 0, 0, 0, 1214,
+		1215, 7, 23, 0, 0, 1215, 244, 1, 0, 0, 0, 1216, 1217, 7, 24, 0, 0, 1217,
+		246, 1, 0, 0, 0, 1218, 1219, 7, 25, 0, 0, 1219, 248, 1, 0, 0, 0, 1220,
+		1221, 7, 26, 0, 0, 1221, 250, 1, 0, 0, 0, 1222, 1223, 7, 27, 0, 0, 1223,
+		252, 1, 0, 0, 0, 1224, 1225, 7, 28, 0, 0, 1225, 254, 1, 0, 0, 0, 1226,
+		1227, 7, 29, 0, 0, 1227, 256, 1, 0, 0, 0, 1228, 1229, 7, 30, 0, 0, 1229,
+		258, 1, 0, 0, 0, 1230, 1231, 7, 31, 0, 0, 1231, 260, 1, 0, 0, 0, 1232,
+		1233, 7, 32, 0, 0, 1233, 262, 1, 0, 0, 0, 1234, 1235, 7, 33, 0, 0, 1235,
+		264, 1, 0, 0, 0, 1236, 1237, 7, 34, 0, 0, 1237, 266, 1, 0, 0, 0, 1238,
+		1242, 5, 35, 0, 0, 1239, 1241, 9, 0, 0, 0, 1240, 1239, 1, 0, 0, 0, 1241,
+		1244, 1, 0, 0, 0, 1242, 1243, 1, 0, 0, 0, 1242, 1240, 1, 0, 0, 0, 1243,
+		1245, 1, 0, 0, 0, 1244, 1242, 1, 0, 0, 0, 1245, 1246, 5, 10, 0, 0, 1246,
+		1247, 1, 0, 0, 0, 1247, 1248, 6, 133, 0, 0, 1248, 268, 1, 0, 0, 0, 24,
+		0, 765, 796, 826, 842, 872, 1048, 1062, 1068, 1090, 1097, 1100, 1103, 1109,
+		1112, 1119, 1122, 1126, 1150, 1158, 1164, 1166, 1174, 1242, 1, 6, 0, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -420,35 +710,88 @@ const (
 	SLQLexerT__15                 = 16
 	SLQLexerT__16                 = 17
 	SLQLexerT__17                 = 18
-	SLQLexerPROPRIETARY_FUNC_NAME = 19
-	SLQLexerJOIN_TYPE             = 20
-	SLQLexerWHERE                 = 21
-	SLQLexerGROUP_BY              = 22
-	SLQLexerORDER_ASC             = 23
-	SLQLexerORDER_DESC            = 24
-	SLQLexerORDER_BY              = 25
-	SLQLexerALIAS_RESERVED        = 26
-	SLQLexerARG                   = 27
-	SLQLexerNULL                  = 28
-	SLQLexerID                    = 29
-	SLQLexerWS                    = 30
-	SLQLexerLPAR                  = 31
-	SLQLexerRPAR                  = 32
-	SLQLexerLBRA                  = 33
-	SLQLexerRBRA                  = 34
-	SLQLexerCOMMA                 = 35
-	SLQLexerPIPE                  = 36
-	SLQLexerCOLON                 = 37
-	SLQLexerNN                    = 38
-	SLQLexerNUMBER                = 39
-	SLQLexerLT_EQ                 = 40
-	SLQLexerLT                    = 41
-	SLQLexerGT_EQ                 = 42
-	SLQLexerGT                    = 43
-	SLQLexerNEQ                   = 44
-	SLQLexerEQ                    = 45
-	SLQLexerNAME                  = 46
-	SLQLexerHANDLE                = 47
-	SLQLexerSTRING                = 48
-	SLQLexerLINECOMMENT           = 49
+	SLQLexerT__18                 = 19
+	SLQLexerT__19                 = 20
+	SLQLexerT__20                 = 21
+	SLQLexerT__21                 = 22
+	SLQLexerT__22                 = 23
+	SLQLexerT__23                 = 24
+	SLQLexerT__24                 = 25
+	SLQLexerT__25                 = 26
+	SLQLexerT__26                 = 27
+	SLQLexerT__27                 = 28
+	SLQLexerT__28                 = 29
+	SLQLexerT__29                 = 30
+	SLQLexerT__30                 = 31
+	SLQLexerT__31                 = 32
+	SLQLexerT__32                 = 33
+	SLQLexerT__33                 = 34
+	SLQLexerT__34                 = 35
+	SLQLexerT__35                 = 36
+	SLQLexerT__36                 = 37
+	SLQLexerT__37                 = 38
+	SLQLexerT__38                 = 39
+	SLQLexerT__39                 = 40
+	SLQLexerT__40                 = 41
+	SLQLexerT__41                 = 42
+	SLQLexerT__42                 = 43
+	SLQLexerT__43                 = 44
+	SLQLexerT__44                 = 45
+	SLQLexerT__45                 = 46
+	SLQLexerT__46                 = 47
+	SLQLexerT__47                 = 48
+	SLQLexerT__48                 = 49
+	SLQLexerT__49                 = 50
+	SLQLexerT__50                 = 51
+	SLQLexerT__51                 = 52
+	SLQLexerT__52                 = 53
+	SLQLexerT__53                 = 54
+	SLQLexerT__54                 = 55
+	SLQLexerT__55                 = 56
+	SLQLexerT__56                 = 57
+	SLQLexerT__57                 = 58
+	SLQLexerT__58                 = 59
+	SLQLexerT__59                 = 60
+	SLQLexerT__60                 = 61
+	SLQLexerPARTITION_BY          = 62
+	SLQLexerPROPRIETARY_FUNC_NAME = 63
+	SLQLexerJOIN_TYPE             = 64
+	SLQLexerSET_OP                = 65
+	SLQLexerIN                    = 66
+	SLQLexerNOT                   = 67
+	SLQLexerBETWEEN               = 68
+	SLQLexerAND                   = 69
+	SLQLexerLIKE                  = 70
+	SLQLexerIS                    = 71
+	SLQLexerWHERE                 = 72
+	SLQLexerGROUP_BY              = 73
+	SLQLexerORDER_ASC             = 74
+	SLQLexerORDER_DESC            = 75
+	SLQLexerORDER_BY              = 76
+	SLQLexerNULLS_FIRST           = 77
+	SLQLexerNULLS_LAST            = 78
+	SLQLexerALIAS_RESERVED        = 79
+	SLQLexerARG                   = 80
+	SLQLexerNULL                  = 81
+	SLQLexerID                    = 82
+	SLQLexerWS                    = 83
+	SLQLexerLPAR                  = 84
+	SLQLexerRPAR                  = 85
+	SLQLexerLBRA                  = 86
+	SLQLexerRBRA                  = 87
+	SLQLexerCOMMA                 = 88
+	SLQLexerPIPE                  = 89
+	SLQLexerCOLON                 = 90
+	SLQLexerNN                    = 91
+	SLQLexerNUMBER                = 92
+	SLQLexerLT_EQ                 = 93
+	SLQLexerLT                    = 94
+	SLQLexerGT_EQ                 = 95
+	SLQLexerGT                    = 96
+	SLQLexerNEQ                   = 97
+	SLQLexerEQ                    = 98
+	SLQLexerNAME                  = 99
+	SLQLexerHANDLE                = 100
+	SLQLexerSTRING                = 101
+	SLQLexerLINECOMMENT           = 102
 )
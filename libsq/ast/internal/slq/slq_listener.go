@@ -28,15 +28,36 @@ type SLQListener interface {
 	// EnterFuncName is called when entering the funcName production.
 	EnterFuncName(c *FuncNameContext)
 
+	// EnterWindow is called when entering the window production.
+	EnterWindow(c *WindowContext)
+
+	// EnterPartitionBy is called when entering the partitionBy production.
+	EnterPartitionBy(c *PartitionByContext)
+
 	// EnterJoin is called when entering the join production.
 	EnterJoin(c *JoinContext)
 
 	// EnterJoinTable is called when entering the joinTable production.
 	EnterJoinTable(c *JoinTableContext)
 
+	// EnterSetOp is called when entering the setOp production.
+	EnterSetOp(c *SetOpContext)
+
+	// EnterSubquery is called when entering the subquery production.
+	EnterSubquery(c *SubqueryContext)
+
+	// EnterConditional is called when entering the conditional production.
+	EnterConditional(c *ConditionalContext)
+
+	// EnterCte is called when entering the cte production.
+	EnterCte(c *CteContext)
+
 	// EnterUniqueFunc is called when entering the uniqueFunc production.
 	EnterUniqueFunc(c *UniqueFuncContext)
 
+	// EnterTopFunc is called when entering the topFunc production.
+	EnterTopFunc(c *TopFuncContext)
+
 	// EnterCountFunc is called when entering the countFunc production.
 	EnterCountFunc(c *CountFuncContext)
 
@@ -49,6 +70,15 @@ type SLQListener interface {
 	// EnterGroupBy is called when entering the groupBy production.
 	EnterGroupBy(c *GroupByContext)
 
+	// EnterGrouping is called when entering the grouping production.
+	EnterGrouping(c *GroupingContext)
+
+	// EnterGroupingTerm is called when entering the groupingTerm production.
+	EnterGroupingTerm(c *GroupingTermContext)
+
+	// EnterGroupingSet is called when entering the groupingSet production.
+	EnterGroupingSet(c *GroupingSetContext)
+
 	// EnterOrderByTerm is called when entering the orderByTerm production.
 	EnterOrderByTerm(c *OrderByTermContext)
 
@@ -64,6 +94,9 @@ type SLQListener interface {
 	// EnterAlias is called when entering the alias production.
 	EnterAlias(c *AliasContext)
 
+	// EnterAliasKeyword is called when entering the aliasKeyword production.
+	EnterAliasKeyword(c *AliasKeywordContext)
+
 	// EnterArg is called when entering the arg production.
 	EnterArg(c *ArgContext)
 
@@ -76,6 +109,9 @@ type SLQListener interface {
 	// EnterRowRange is called when entering the rowRange production.
 	EnterRowRange(c *RowRangeContext)
 
+	// EnterRowRangeIndex is called when entering the rowRangeIndex production.
+	EnterRowRangeIndex(c *RowRangeIndexContext)
+
 	// EnterExprElement is called when entering the exprElement production.
 	EnterExprElement(c *ExprElementContext)
 
@@ -85,6 +121,9 @@ type SLQListener interface {
 	// EnterLiteral is called when entering the literal production.
 	EnterLiteral(c *LiteralContext)
 
+	// EnterList is called when entering the list production.
+	EnterList(c *ListContext)
+
 	// EnterUnaryOperator is called when entering the unaryOperator production.
 	EnterUnaryOperator(c *UnaryOperatorContext)
 
@@ -109,15 +148,36 @@ type SLQListener interface {
 	// ExitFuncName is called when exiting the funcName production.
 	ExitFuncName(c *FuncNameContext)
 
+	// ExitWindow is called when exiting the window production.
+	ExitWindow(c *WindowContext)
+
+	// ExitPartitionBy is called when exiting the partitionBy production.
+	ExitPartitionBy(c *PartitionByContext)
+
 	// ExitJoin is called when exiting the join production.
 	ExitJoin(c *JoinContext)
 
 	// ExitJoinTable is called when exiting the joinTable production.
 	ExitJoinTable(c *JoinTableContext)
 
+	// ExitSetOp is called when exiting the setOp production.
+	ExitSetOp(c *SetOpContext)
+
+	// ExitSubquery is called when exiting the subquery production.
+	ExitSubquery(c *SubqueryContext)
+
+	// ExitConditional is called when exiting the conditional production.
+	ExitConditional(c *ConditionalContext)
+
+	// ExitCte is called when exiting the cte production.
+	ExitCte(c *CteContext)
+
 	// ExitUniqueFunc is called when exiting the uniqueFunc production.
 	ExitUniqueFunc(c *UniqueFuncContext)
 
+	// ExitTopFunc is called when exiting the topFunc production.
+	ExitTopFunc(c *TopFuncContext)
+
 	// ExitCountFunc is called when exiting the countFunc production.
 	ExitCountFunc(c *CountFuncContext)
 
@@ -130,6 +190,15 @@ type SLQListener interface {
 	// ExitGroupBy is called when exiting the groupBy production.
 	ExitGroupBy(c *GroupByContext)
 
+	// ExitGrouping is called when exiting the grouping production.
+	ExitGrouping(c *GroupingContext)
+
+	// ExitGroupingTerm is called when exiting the groupingTerm production.
+	ExitGroupingTerm(c *GroupingTermContext)
+
+	// ExitGroupingSet is called when exiting the groupingSet production.
+	ExitGroupingSet(c *GroupingSetContext)
+
 	// ExitOrderByTerm is called when exiting the orderByTerm production.
 	ExitOrderByTerm(c *OrderByTermContext)
 
@@ -145,6 +214,9 @@ type SLQListener interface {
 	// ExitAlias is called when exiting the alias production.
 	ExitAlias(c *AliasContext)
 
+	// ExitAliasKeyword is called when exiting the aliasKeyword production.
+	ExitAliasKeyword(c *AliasKeywordContext)
+
 	// ExitArg is called when exiting the arg production.
 	ExitArg(c *ArgContext)
 
@@ -157,6 +229,9 @@ type SLQListener interface {
 	// ExitRowRange is called when exiting the rowRange production.
 	ExitRowRange(c *RowRangeContext)
 
+	// ExitRowRangeIndex is called when exiting the rowRangeIndex production.
+	ExitRowRangeIndex(c *RowRangeIndexContext)
+
 	// ExitExprElement is called when exiting the exprElement production.
 	ExitExprElement(c *ExprElementContext)
 
@@ -166,6 +241,9 @@ type SLQListener interface {
 	// ExitLiteral is called when exiting the literal production.
 	ExitLiteral(c *LiteralContext)
 
+	// ExitList is called when exiting the list production.
+	ExitList(c *ListContext)
+
 	// ExitUnaryOperator is called when exiting the unaryOperator production.
 	ExitUnaryOperator(c *UnaryOperatorContext)
 }
@@ -32,158 +32,279 @@ var SLQParserStaticData struct {
 func slqParserInit() {
 	staticData := &SLQParserStaticData
 	staticData.LiteralNames = []string{
-		"", "';'", "'*'", "'sum'", "'avg'", "'max'", "'min'", "'unique'", "'count'",
-		"'.['", "'||'", "'/'", "'%'", "'<<'", "'>>'", "'&'", "'&&'", "'~'",
-		"'!'", "", "", "", "'group_by'", "'+'", "'-'", "", "", "", "'null'",
-		"", "", "'('", "')'", "'['", "']'", "','", "'|'", "':'", "", "", "'<='",
-		"'<'", "'>='", "'>'", "'!='", "'=='",
+		"", "';'", "'*'", "'sum'", "'avg'", "'max'", "'min'", "'median'", "'percentile_cont'",
+		"'stddev'", "'variance'", "'string_agg'", "'upper'", "'lower'", "'trim'",
+		"'substr'", "'length'", "'replace'", "'concat'", "'round'", "'abs'",
+		"'ceil'", "'floor'", "'now'", "'date_trunc'", "'extract'", "'date_add'",
+		"'coalesce'", "'nullif'", "'cast'", "'row_number'", "'rank'", "'dense_rank'",
+		"'ntile'", "'lag'", "'lead'", "'first_value'", "'last_value'", "'over'",
+		"'if'", "'then'", "'elif'", "'else'", "'end'", "'with'", "'unique'",
+		"'top'", "'count'", "'rollup'", "'cube'", "'grouping_sets'", "'.['",
+		"'||'", "'/'", "'%'", "'<<'", "'>>'", "'&'", "'&&'", "'//'", "'~'",
+		"'!'", "'partition_by'", "", "", "", "'in'", "'not'", "'between'", "'and'",
+		"", "'is'", "", "'group_by'", "'+'", "'-'", "", "'nulls_first'", "'nulls_last'",
+		"", "", "'null'", "", "", "'('", "')'", "'['", "']'", "','", "'|'",
+		"':'", "", "", "'<='", "'<'", "'>='", "'>'", "'!='", "'=='",
 	}
 	staticData.SymbolicNames = []string{
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "PROPRIETARY_FUNC_NAME", "JOIN_TYPE", "WHERE", "GROUP_BY", "ORDER_ASC",
-		"ORDER_DESC", "ORDER_BY", "ALIAS_RESERVED", "ARG", "NULL", "ID", "WS",
-		"LPAR", "RPAR", "LBRA", "RBRA", "COMMA", "PIPE", "COLON", "NN", "NUMBER",
-		"LT_EQ", "LT", "GT_EQ", "GT", "NEQ", "EQ", "NAME", "HANDLE", "STRING",
-		"LINECOMMENT",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "PARTITION_BY", "PROPRIETARY_FUNC_NAME",
+		"JOIN_TYPE", "SET_OP", "IN", "NOT", "BETWEEN", "AND", "LIKE", "IS",
+		"WHERE", "GROUP_BY", "ORDER_ASC", "ORDER_DESC", "ORDER_BY", "NULLS_FIRST",
+		"NULLS_LAST", "ALIAS_RESERVED", "ARG", "NULL", "ID", "WS", "LPAR", "RPAR",
+		"LBRA", "RBRA", "COMMA", "PIPE", "COLON", "NN", "NUMBER", "LT_EQ", "LT",
+		"GT_EQ", "GT", "NEQ", "EQ", "NAME", "HANDLE", "STRING", "LINECOMMENT",
 	}
 	staticData.RuleNames = []string{
 		"stmtList", "query", "segment", "element", "funcElement", "func", "funcName",
-		"join", "joinTable", "uniqueFunc", "countFunc", "where", "groupByTerm",
-		"groupBy", "orderByTerm", "orderBy", "selector", "selectorElement",
-		"alias", "arg", "handleTable", "handle", "rowRange", "exprElement",
-		"expr", "literal", "unaryOperator",
+		"window", "partitionBy", "join", "joinTable", "setOp", "subquery", "conditional",
+		"cte", "uniqueFunc", "topFunc", "countFunc", "where", "groupByTerm",
+		"groupBy", "grouping", "groupingTerm", "groupingSet", "orderByTerm",
+		"orderBy", "selector", "selectorElement", "alias", "aliasKeyword", "arg",
+		"handleTable", "handle", "rowRange", "rowRangeIndex", "exprElement",
+		"expr", "literal", "list", "unaryOperator",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 49, 283, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 102, 510, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
 		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
-		7, 26, 1, 0, 5, 0, 56, 8, 0, 10, 0, 12, 0, 59, 9, 0, 1, 0, 1, 0, 4, 0,
-		63, 8, 0, 11, 0, 12, 0, 64, 1, 0, 5, 0, 68, 8, 0, 10, 0, 12, 0, 71, 9,
-		0, 1, 0, 5, 0, 74, 8, 0, 10, 0, 12, 0, 77, 9, 0, 1, 1, 1, 1, 1, 1, 5, 1,
-		82, 8, 1, 10, 1, 12, 1, 85, 9, 1, 1, 2, 1, 2, 1, 2, 5, 2, 90, 8, 2, 10,
-		2, 12, 2, 93, 9, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
-		3, 1, 3, 1, 3, 1, 3, 3, 3, 107, 8, 3, 1, 4, 1, 4, 3, 4, 111, 8, 4, 1, 5,
-		1, 5, 1, 5, 1, 5, 1, 5, 5, 5, 118, 8, 5, 10, 5, 12, 5, 121, 9, 5, 1, 5,
-		3, 5, 124, 8, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7,
-		3, 7, 135, 8, 7, 1, 7, 1, 7, 1, 8, 3, 8, 140, 8, 8, 1, 8, 1, 8, 3, 8, 144,
-		8, 8, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 3, 10, 151, 8, 10, 1, 10, 3, 10,
-		154, 8, 10, 1, 10, 3, 10, 157, 8, 10, 1, 11, 1, 11, 1, 11, 3, 11, 162,
-		8, 11, 1, 11, 1, 11, 1, 12, 1, 12, 3, 12, 168, 8, 12, 1, 13, 1, 13, 1,
-		13, 1, 13, 1, 13, 5, 13, 175, 8, 13, 10, 13, 12, 13, 178, 9, 13, 1, 13,
-		1, 13, 1, 14, 1, 14, 3, 14, 184, 8, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1,
-		15, 5, 15, 191, 8, 15, 10, 15, 12, 15, 194, 9, 15, 1, 15, 1, 15, 1, 16,
-		1, 16, 3, 16, 200, 8, 16, 1, 17, 1, 17, 3, 17, 204, 8, 17, 1, 18, 1, 18,
-		1, 18, 3, 18, 209, 8, 18, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 21, 1,
-		21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 3, 22,
-		227, 8, 22, 1, 22, 1, 22, 1, 23, 1, 23, 3, 23, 233, 8, 23, 1, 24, 1, 24,
-		1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 3,
-		24, 247, 8, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24,
-		1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1,
-		24, 3, 24, 268, 8, 24, 1, 24, 1, 24, 1, 24, 1, 24, 5, 24, 274, 8, 24, 10,
-		24, 12, 24, 277, 9, 24, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 0, 1, 48, 27,
-		0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36,
-		38, 40, 42, 44, 46, 48, 50, 52, 0, 8, 2, 0, 3, 6, 19, 19, 1, 0, 23, 24,
-		3, 0, 27, 27, 29, 29, 48, 48, 2, 0, 2, 2, 11, 12, 1, 0, 13, 15, 1, 0, 40,
-		43, 3, 0, 28, 28, 38, 39, 48, 48, 2, 0, 17, 18, 23, 24, 309, 0, 57, 1,
-		0, 0, 0, 2, 78, 1, 0, 0, 0, 4, 86, 1, 0, 0, 0, 6, 106, 1, 0, 0, 0, 8, 108,
-		1, 0, 0, 0, 10, 112, 1, 0, 0, 0, 12, 127, 1, 0, 0, 0, 14, 129, 1, 0, 0,
-		0, 16, 139, 1, 0, 0, 0, 18, 145, 1, 0, 0, 0, 20, 147, 1, 0, 0, 0, 22, 158,
-		1, 0, 0, 0, 24, 167, 1, 0, 0, 0, 26, 169, 1, 0, 0, 0, 28, 181, 1, 0, 0,
-		0, 30, 185, 1, 0, 0, 0, 32, 197, 1, 0, 0, 0, 34, 201, 1, 0, 0, 0, 36, 208,
-		1, 0, 0, 0, 38, 210, 1, 0, 0, 0, 40, 212, 1, 0, 0, 0, 42, 215, 1, 0, 0,
-		0, 44, 217, 1, 0, 0, 0, 46, 230, 1, 0, 0, 0, 48, 246, 1, 0, 0, 0, 50, 278,
-		1, 0, 0, 0, 52, 280, 1, 0, 0, 0, 54, 56, 5, 1, 0, 0, 55, 54, 1, 0, 0, 0,
-		56, 59, 1, 0, 0, 0, 57, 55, 1, 0, 0, 0, 57, 58, 1, 0, 0, 0, 58, 60, 1,
-		0, 0, 0, 59, 57, 1, 0, 0, 0, 60, 69, 3, 2, 1, 0, 61, 63, 5, 1, 0, 0, 62,
-		61, 1, 0, 0, 0, 63, 64, 1, 0, 0, 0, 64, 62, 1, 0, 0, 0, 64, 65, 1, 0, 0,
-		0, 65, 66, 1, 0, 0, 0, 66, 68, 3, 2, 1, 0, 67, 62, 1, 0, 0, 0, 68, 71,
-		1, 0, 0, 0, 69, 67, 1, 0, 0, 0, 69, 70, 1, 0, 0, 0, 70, 75, 1, 0, 0, 0,
-		71, 69, 1, 0, 0, 0, 72, 74, 5, 1, 0, 0, 73, 72, 1, 0, 0, 0, 74, 77, 1,
-		0, 0, 0, 75, 73, 1, 0, 0, 0, 75, 76, 1, 0, 0, 0, 76, 1, 1, 0, 0, 0, 77,
-		75, 1, 0, 0, 0, 78, 83, 3, 4, 2, 0, 79, 80, 5, 36, 0, 0, 80, 82, 3, 4,
-		2, 0, 81, 79, 1, 0, 0, 0, 82, 85, 1, 0, 0, 0, 83, 81, 1, 0, 0, 0, 83, 84,
-		1, 0, 0, 0, 84, 3, 1, 0, 0, 0, 85, 83, 1, 0, 0, 0, 86, 91, 3, 6, 3, 0,
-		87, 88, 5, 35, 0, 0, 88, 90, 3, 6, 3, 0, 89, 87, 1, 0, 0, 0, 90, 93, 1,
-		0, 0, 0, 91, 89, 1, 0, 0, 0, 91, 92, 1, 0, 0, 0, 92, 5, 1, 0, 0, 0, 93,
-		91, 1, 0, 0, 0, 94, 107, 3, 40, 20, 0, 95, 107, 3, 42, 21, 0, 96, 107,
-		3, 34, 17, 0, 97, 107, 3, 14, 7, 0, 98, 107, 3, 26, 13, 0, 99, 107, 3,
-		30, 15, 0, 100, 107, 3, 44, 22, 0, 101, 107, 3, 18, 9, 0, 102, 107, 3,
-		20, 10, 0, 103, 107, 3, 22, 11, 0, 104, 107, 3, 8, 4, 0, 105, 107, 3, 46,
-		23, 0, 106, 94, 1, 0, 0, 0, 106, 95, 1, 0, 0, 0, 106, 96, 1, 0, 0, 0, 106,
-		97, 1, 0, 0, 0, 106, 98, 1, 0, 0, 0, 106, 99, 1, 0, 0, 0, 106, 100, 1,
-		0, 0, 0, 106, 101, 1, 0, 0, 0, 106, 102, 1, 0, 0, 0, 106, 103, 1, 0, 0,
-		0, 106, 104, 1, 0, 0, 0, 106, 105, 1, 0, 0, 0, 107, 7, 1, 0, 0, 0, 108,
-		110, 3, 10, 5, 0, 109, 111, 3, 36, 18, 0, 110, 109, 1, 0, 0, 0, 110, 111,
-		1, 0, 0, 0, 111, 9, 1, 0, 0, 0, 112, 113, 3, 12, 6, 0, 113, 123, 5, 31,
-		0, 0, 114, 119, 3, 48, 24, 0, 115, 116, 5, 35, 0, 0, 116, 118, 3, 48, 24,
-		0, 117, 115, 1, 0, 0, 0, 118, 121, 1, 0, 0, 0, 119, 117, 1, 0, 0, 0, 119,
-		120, 1, 0, 0, 0, 120, 124, 1, 0, 0, 0, 121, 119, 1, 0, 0, 0, 122, 124,
-		5, 2, 0, 0, 123, 114, 1, 0, 0, 0, 123, 122, 1, 0, 0, 0, 123, 124, 1, 0,
-		0, 0, 124, 125, 1, 0, 0, 0, 125, 126, 5, 32, 0, 0, 126, 11, 1, 0, 0, 0,
-		127, 128, 7, 0, 0, 0, 128, 13, 1, 0, 0, 0, 129, 130, 5, 20, 0, 0, 130,
-		131, 5, 31, 0, 0, 131, 134, 3, 16, 8, 0, 132, 133, 5, 35, 0, 0, 133, 135,
-		3, 48, 24, 0, 134, 132, 1, 0, 0, 0, 134, 135, 1, 0, 0, 0, 135, 136, 1,
-		0, 0, 0, 136, 137, 5, 32, 0, 0, 137, 15, 1, 0, 0, 0, 138, 140, 5, 47, 0,
-		0, 139, 138, 1, 0, 0, 0, 139, 140, 1, 0, 0, 0, 140, 141, 1, 0, 0, 0, 141,
-		143, 5, 46, 0, 0, 142, 144, 3, 36, 18, 0, 143, 142, 1, 0, 0, 0, 143, 144,
-		1, 0, 0, 0, 144, 17, 1, 0, 0, 0, 145, 146, 5, 7, 0, 0, 146, 19, 1, 0, 0,
-		0, 147, 153, 5, 8, 0, 0, 148, 150, 5, 31, 0, 0, 149, 151, 3, 32, 16, 0,
-		150, 149, 1, 0, 0, 0, 150, 151, 1, 0, 0, 0, 151, 152, 1, 0, 0, 0, 152,
-		154, 5, 32, 0, 0, 153, 148, 1, 0, 0, 0, 153, 154, 1, 0, 0, 0, 154, 156,
-		1, 0, 0, 0, 155, 157, 3, 36, 18, 0, 156, 155, 1, 0, 0, 0, 156, 157, 1,
-		0, 0, 0, 157, 21, 1, 0, 0, 0, 158, 159, 5, 21, 0, 0, 159, 161, 5, 31, 0,
-		0, 160, 162, 3, 48, 24, 0, 161, 160, 1, 0, 0, 0, 161, 162, 1, 0, 0, 0,
-		162, 163, 1, 0, 0, 0, 163, 164, 5, 32, 0, 0, 164, 23, 1, 0, 0, 0, 165,
-		168, 3, 32, 16, 0, 166, 168, 3, 10, 5, 0, 167, 165, 1, 0, 0, 0, 167, 166,
-		1, 0, 0, 0, 168, 25, 1, 0, 0, 0, 169, 170, 5, 22, 0, 0, 170, 171, 5, 31,
-		0, 0, 171, 176, 3, 24, 12, 0, 172, 173, 5, 35, 0, 0, 173, 175, 3, 24, 12,
-		0, 174, 172, 1, 0, 0, 0, 175, 178, 1, 0, 0, 0, 176, 174, 1, 0, 0, 0, 176,
-		177, 1, 0, 0, 0, 177, 179, 1, 0, 0, 0, 178, 176, 1, 0, 0, 0, 179, 180,
-		5, 32, 0, 0, 180, 27, 1, 0, 0, 0, 181, 183, 3, 32, 16, 0, 182, 184, 7,
-		1, 0, 0, 183, 182, 1, 0, 0, 0, 183, 184, 1, 0, 0, 0, 184, 29, 1, 0, 0,
-		0, 185, 186, 5, 25, 0, 0, 186, 187, 5, 31, 0, 0, 187, 192, 3, 28, 14, 0,
-		188, 189, 5, 35, 0, 0, 189, 191, 3, 28, 14, 0, 190, 188, 1, 0, 0, 0, 191,
-		194, 1, 0, 0, 0, 192, 190, 1, 0, 0, 0, 192, 193, 1, 0, 0, 0, 193, 195,
-		1, 0, 0, 0, 194, 192, 1, 0, 0, 0, 195, 196, 5, 32, 0, 0, 196, 31, 1, 0,
-		0, 0, 197, 199, 5, 46, 0, 0, 198, 200, 5, 46, 0, 0, 199, 198, 1, 0, 0,
-		0, 199, 200, 1, 0, 0, 0, 200, 33, 1, 0, 0, 0, 201, 203, 3, 32, 16, 0, 202,
-		204, 3, 36, 18, 0, 203, 202, 1, 0, 0, 0, 203, 204, 1, 0, 0, 0, 204, 35,
-		1, 0, 0, 0, 205, 209, 5, 26, 0, 0, 206, 207, 5, 37, 0, 0, 207, 209, 7,
-		2, 0, 0, 208, 205, 1, 0, 0, 0, 208, 206, 1, 0, 0, 0, 209, 37, 1, 0, 0,
-		0, 210, 211, 5, 27, 0, 0, 211, 39, 1, 0, 0, 0, 212, 213, 5, 47, 0, 0, 213,
-		214, 5, 46, 0, 0, 214, 41, 1, 0, 0, 0, 215, 216, 5, 47, 0, 0, 216, 43,
-		1, 0, 0, 0, 217, 226, 5, 9, 0, 0, 218, 219, 5, 38, 0, 0, 219, 220, 5, 37,
-		0, 0, 220, 227, 5, 38, 0, 0, 221, 222, 5, 38, 0, 0, 222, 227, 5, 37, 0,
-		0, 223, 224, 5, 37, 0, 0, 224, 227, 5, 38, 0, 0, 225, 227, 5, 38, 0, 0,
-		226, 218, 1, 0, 0, 0, 226, 221, 1, 0, 0, 0, 226, 223, 1, 0, 0, 0, 226,
-		225, 1, 0, 0, 0, 226, 227, 1, 0, 0, 0, 227, 228, 1, 0, 0, 0, 228, 229,
-		5, 34, 0, 0, 229, 45, 1, 0, 0, 0, 230, 232, 3, 48, 24, 0, 231, 233, 3,
-		36, 18, 0, 232, 231, 1, 0, 0, 0, 232, 233, 1, 0, 0, 0, 233, 47, 1, 0, 0,
-		0, 234, 235, 6, 24, -1, 0, 235, 236, 5, 31, 0, 0, 236, 237, 3, 48, 24,
-		0, 237, 238, 5, 32, 0, 0, 238, 247, 1, 0, 0, 0, 239, 247, 3, 32, 16, 0,
-		240, 247, 3, 50, 25, 0, 241, 247, 3, 38, 19, 0, 242, 243, 3, 52, 26, 0,
-		243, 244, 3, 48, 24, 9, 244, 247, 1, 0, 0, 0, 245, 247, 3, 10, 5, 0, 246,
-		234, 1, 0, 0, 0, 246, 239, 1, 0, 0, 0, 246, 240, 1, 0, 0, 0, 246, 241,
-		1, 0, 0, 0, 246, 242, 1, 0, 0, 0, 246, 245, 1, 0, 0, 0, 247, 275, 1, 0,
-		0, 0, 248, 249, 10, 8, 0, 0, 249, 250, 5, 10, 0, 0, 250, 274, 3, 48, 24,
-		9, 251, 252, 10, 7, 0, 0, 252, 253, 7, 3, 0, 0, 253, 274, 3, 48, 24, 8,
-		254, 255, 10, 6, 0, 0, 255, 256, 7, 1, 0, 0, 256, 274, 3, 48, 24, 7, 257,
-		258, 10, 5, 0, 0, 258, 259, 7, 4, 0, 0, 259, 274, 3, 48, 24, 6, 260, 261,
-		10, 4, 0, 0, 261, 262, 7, 5, 0, 0, 262, 274, 3, 48, 24, 5, 263, 267, 10,
-		3, 0, 0, 264, 268, 5, 45, 0, 0, 265, 268, 5, 44, 0, 0, 266, 268, 1, 0,
-		0, 0, 267, 264, 1, 0, 0, 0, 267, 265, 1, 0, 0, 0, 267, 266, 1, 0, 0, 0,
-		268, 269, 1, 0, 0, 0, 269, 274, 3, 48, 24, 4, 270, 271, 10, 2, 0, 0, 271,
-		272, 5, 16, 0, 0, 272, 274, 3, 48, 24, 3, 273, 248, 1, 0, 0, 0, 273, 251,
-		1, 0, 0, 0, 273, 254, 1, 0, 0, 0, 273, 257, 1, 0, 0, 0, 273, 260, 1, 0,
-		0, 0, 273, 263, 1, 0, 0, 0, 273, 270, 1, 0, 0, 0, 274, 277, 1, 0, 0, 0,
-		275, 273, 1, 0, 0, 0, 275, 276, 1, 0, 0, 0, 276, 49, 1, 0, 0, 0, 277, 275,
-		1, 0, 0, 0, 278, 279, 7, 6, 0, 0, 279, 51, 1, 0, 0, 0, 280, 281, 7, 7,
-		0, 0, 281, 53, 1, 0, 0, 0, 30, 57, 64, 69, 75, 83, 91, 106, 110, 119, 123,
-		134, 139, 143, 150, 153, 156, 161, 167, 176, 183, 192, 199, 203, 208, 226,
-		232, 246, 267, 273, 275,
+		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
+		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
+		2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 1, 0, 5, 0, 82, 8, 0, 10, 0,
+		12, 0, 85, 9, 0, 1, 0, 1, 0, 4, 0, 89, 8, 0, 11, 0, 12, 0, 90, 1, 0, 5,
+		0, 94, 8, 0, 10, 0, 12, 0, 97, 9, 0, 1, 0, 5, 0, 100, 8, 0, 10, 0, 12,
+		0, 103, 9, 0, 1, 1, 1, 1, 1, 1, 5, 1, 108, 8, 1, 10, 1, 12, 1, 111, 9,
+		1, 1, 2, 1, 2, 1, 2, 5, 2, 116, 8, 2, 10, 2, 12, 2, 119, 9, 2, 1, 3, 1,
+		3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
+		3, 1, 3, 3, 3, 136, 8, 3, 1, 4, 1, 4, 3, 4, 140, 8, 4, 1, 5, 1, 5, 1, 5,
+		1, 5, 1, 5, 5, 5, 147, 8, 5, 10, 5, 12, 5, 150, 9, 5, 1, 5, 3, 5, 153,
+		8, 5, 1, 5, 1, 5, 3, 5, 157, 8, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 7,
+		1, 7, 3, 7, 166, 8, 7, 1, 7, 3, 7, 169, 8, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1,
+		8, 1, 8, 1, 8, 5, 8, 178, 8, 8, 10, 8, 12, 8, 181, 9, 8, 1, 8, 1, 8, 1,
+		9, 1, 9, 1, 9, 1, 9, 1, 9, 3, 9, 190, 8, 9, 1, 9, 1, 9, 1, 10, 3, 10, 195,
+		8, 10, 1, 10, 1, 10, 3, 10, 199, 8, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1,
+		11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13,
+		1, 13, 1, 13, 1, 13, 5, 13, 219, 8, 13, 10, 13, 12, 13, 222, 9, 13, 1,
+		13, 1, 13, 3, 13, 226, 8, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14,
+		1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 5, 15, 242, 8,
+		15, 10, 15, 12, 15, 245, 9, 15, 1, 15, 1, 15, 3, 15, 249, 8, 15, 1, 16,
+		1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 5, 16, 258, 8, 16, 10, 16, 12,
+		16, 261, 9, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 3, 17, 268, 8, 17, 1,
+		17, 3, 17, 271, 8, 17, 1, 17, 3, 17, 274, 8, 17, 1, 18, 1, 18, 1, 18, 3,
+		18, 279, 8, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 3, 19, 287, 8,
+		19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 5, 20, 294, 8, 20, 10, 20, 12, 20,
+		297, 9, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 5, 21, 306,
+		8, 21, 10, 21, 12, 21, 309, 9, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1,
+		21, 1, 21, 5, 21, 318, 8, 21, 10, 21, 12, 21, 321, 9, 21, 1, 21, 1, 21,
+		3, 21, 325, 8, 21, 1, 22, 1, 22, 1, 22, 3, 22, 330, 8, 22, 1, 23, 1, 23,
+		1, 23, 1, 23, 1, 23, 5, 23, 337, 8, 23, 10, 23, 12, 23, 340, 9, 23, 3,
+		23, 342, 8, 23, 1, 23, 3, 23, 345, 8, 23, 1, 24, 1, 24, 3, 24, 349, 8,
+		24, 1, 24, 3, 24, 352, 8, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 5, 25,
+		359, 8, 25, 10, 25, 12, 25, 362, 9, 25, 1, 25, 1, 25, 1, 26, 1, 26, 3,
+		26, 368, 8, 26, 1, 27, 1, 27, 3, 27, 372, 8, 27, 1, 28, 1, 28, 1, 28, 1,
+		28, 1, 28, 1, 28, 1, 28, 3, 28, 381, 8, 28, 3, 28, 383, 8, 28, 1, 29, 1,
+		29, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33,
+		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 3, 33, 405, 8,
+		33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 35, 1, 35, 3, 35, 413, 8, 35, 1, 36,
+		1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1,
+		36, 1, 36, 1, 36, 1, 36, 3, 36, 430, 8, 36, 1, 36, 1, 36, 1, 36, 1, 36,
+		1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1,
+		36, 1, 36, 1, 36, 1, 36, 1, 36, 3, 36, 451, 8, 36, 1, 36, 1, 36, 1, 36,
+		3, 36, 456, 8, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 3,
+		36, 465, 8, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 3, 36, 472, 8, 36, 1,
+		36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 3, 36, 483,
+		8, 36, 1, 36, 1, 36, 1, 36, 3, 36, 488, 8, 36, 5, 36, 490, 8, 36, 10, 36,
+		12, 36, 493, 9, 36, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 5, 38, 501,
+		8, 38, 10, 38, 12, 38, 504, 9, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 0,
+		1, 72, 40, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32,
+		34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68,
+		70, 72, 74, 76, 78, 0, 11, 2, 0, 3, 37, 63, 63, 1, 0, 48, 49, 1, 0, 74,
+		75, 1, 0, 77, 78, 4, 0, 38, 44, 62, 62, 65, 71, 77, 78, 1, 0, 91, 92, 2,
+		0, 2, 2, 53, 54, 1, 0, 55, 57, 1, 0, 93, 96, 3, 0, 81, 81, 91, 92, 101,
+		101, 2, 0, 60, 61, 74, 75, 565, 0, 83, 1, 0, 0, 0, 2, 104, 1, 0, 0, 0,
+		4, 112, 1, 0, 0, 0, 6, 135, 1, 0, 0, 0, 8, 137, 1, 0, 0, 0, 10, 141, 1,
+		0, 0, 0, 12, 158, 1, 0, 0, 0, 14, 160, 1, 0, 0, 0, 16, 172, 1, 0, 0, 0,
+		18, 184, 1, 0, 0, 0, 20, 194, 1, 0, 0, 0, 22, 200, 1, 0, 0, 0, 24, 205,
+		1, 0, 0, 0, 26, 209, 1, 0, 0, 0, 28, 229, 1, 0, 0, 0, 30, 236, 1, 0, 0,
+		0, 32, 250, 1, 0, 0, 0, 34, 264, 1, 0, 0, 0, 36, 275, 1, 0, 0, 0, 38, 286,
+		1, 0, 0, 0, 40, 288, 1, 0, 0, 0, 42, 324, 1, 0, 0, 0, 44, 329, 1, 0, 0,
+		0, 46, 344, 1, 0, 0, 0, 48, 346, 1, 0, 0, 0, 50, 353, 1, 0, 0, 0, 52, 365,
+		1, 0, 0, 0, 54, 369, 1, 0, 0, 0, 56, 382, 1, 0, 0, 0, 58, 384, 1, 0, 0,
+		0, 60, 386, 1, 0, 0, 0, 62, 388, 1, 0, 0, 0, 64, 391, 1, 0, 0, 0, 66, 393,
+		1, 0, 0, 0, 68, 408, 1, 0, 0, 0, 70, 410, 1, 0, 0, 0, 72, 429, 1, 0, 0,
+		0, 74, 494, 1, 0, 0, 0, 76, 496, 1, 0, 0, 0, 78, 507, 1, 0, 0, 0, 80, 82,
+		5, 1, 0, 0, 81, 80, 1, 0, 0, 0, 82, 85, 1, 0, 0, 0, 83, 81, 1, 0, 0, 0,
+		83, 84, 1, 0, 0, 0, 84, 86, 1, 0, 0, 0, 85, 83, 1, 0, 0, 0, 86, 95, 3,
+		2, 1, 0, 87, 89, 5, 1, 0, 0, 88, 87, 1, 0, 0, 0, 89, 90, 1, 0, 0, 0, 90,
+		88, 1, 0, 0, 0, 90, 91, 1, 0, 0, 0, 91, 92, 1, 0, 0, 0, 92, 94, 3, 2, 1,
+		0, 93, 88, 1, 0, 0, 0, 94, 97, 1, 0, 0, 0, 95, 93, 1, 0, 0, 0, 95, 96,
+		1, 0, 0, 0, 96, 101, 1, 0, 0, 0, 97, 95, 1, 0, 0, 0, 98, 100, 5, 1, 0,
+		0, 99, 98, 1, 0, 0, 0, 100, 103, 1, 0, 0, 0, 101, 99, 1, 0, 0, 0, 101,
+		102, 1, 0, 0, 0, 102, 1, 1, 0, 0, 0, 103, 101, 1, 0, 0, 0, 104, 109, 3,
+		4, 2, 0, 105, 106, 5, 89, 0, 0, 106, 108, 3, 4, 2, 0, 107, 105, 1, 0, 0,
+		0, 108, 111, 1, 0, 0, 0, 109, 107, 1, 0, 0, 0, 109, 110, 1, 0, 0, 0, 110,
+		3, 1, 0, 0, 0, 111, 109, 1, 0, 0, 0, 112, 117, 3, 6, 3, 0, 113, 114, 5,
+		88, 0, 0, 114, 116, 3, 6, 3, 0, 115, 113, 1, 0, 0, 0, 116, 119, 1, 0, 0,
+		0, 117, 115, 1, 0, 0, 0, 117, 118, 1, 0, 0, 0, 118, 5, 1, 0, 0, 0, 119,
+		117, 1, 0, 0, 0, 120, 136, 3, 62, 31, 0, 121, 136, 3, 64, 32, 0, 122, 136,
+		3, 54, 27, 0, 123, 136, 3, 18, 9, 0, 124, 136, 3, 40, 20, 0, 125, 136,
+		3, 50, 25, 0, 126, 136, 3, 66, 33, 0, 127, 136, 3, 30, 15, 0, 128, 136,
+		3, 32, 16, 0, 129, 136, 3, 34, 17, 0, 130, 136, 3, 36, 18, 0, 131, 136,
+		3, 22, 11, 0, 132, 136, 3, 28, 14, 0, 133, 136, 3, 8, 4, 0, 134, 136, 3,
+		70, 35, 0, 135, 120, 1, 0, 0, 0, 135, 121, 1, 0, 0, 0, 135, 122, 1, 0,
+		0, 0, 135, 123, 1, 0, 0, 0, 135, 124, 1, 0, 0, 0, 135, 125, 1, 0, 0, 0,
+		135, 126, 1, 0, 0, 0, 135, 127, 1, 0, 0, 0, 135, 128, 1, 0, 0, 0, 135,
+		129, 1, 0, 0, 0, 135, 130, 1, 0, 0, 0, 135, 131, 1, 0, 0, 0, 135, 132,
+		1, 0, 0, 0, 135, 133, 1, 0, 0, 0, 135, 134, 1, 0, 0, 0, 136, 7, 1, 0, 0,
+		0, 137, 139, 3, 10, 5, 0, 138, 140, 3, 56, 28, 0, 139, 138, 1, 0, 0, 0,
+		139, 140, 1, 0, 0, 0, 140, 9, 1, 0, 0, 0, 141, 142, 3, 12, 6, 0, 142, 152,
+		5, 84, 0, 0, 143, 148, 3, 72, 36, 0, 144, 145, 5, 88, 0, 0, 145, 147, 3,
+		72, 36, 0, 146, 144, 1, 0, 0, 0, 147, 150, 1, 0, 0, 0, 148, 146, 1, 0,
+		0, 0, 148, 149, 1, 0, 0, 0, 149, 153, 1, 0, 0, 0, 150, 148, 1, 0, 0, 0,
+		151, 153, 5, 2, 0, 0, 152, 143, 1, 0, 0, 0, 152, 151, 1, 0, 0, 0, 152,
+		153, 1, 0, 0, 0, 153, 154, 1, 0, 0, 0, 154, 156, 5, 85, 0, 0, 155, 157,
+		3, 14, 7, 0, 156, 155, 1, 0, 0, 0, 156, 157, 1, 0, 0, 0, 157, 11, 1, 0,
+		0, 0, 158, 159, 7, 0, 0, 0, 159, 13, 1, 0, 0, 0, 160, 161, 5, 38, 0, 0,
+		161, 168, 5, 84, 0, 0, 162, 165, 3, 16, 8, 0, 163, 164, 5, 88, 0, 0, 164,
+		166, 3, 50, 25, 0, 165, 163, 1, 0, 0, 0, 165, 166, 1, 0, 0, 0, 166, 169,
+		1, 0, 0, 0, 167, 169, 3, 50, 25, 0, 168, 162, 1, 0, 0, 0, 168, 167, 1,
+		0, 0, 0, 168, 169, 1, 0, 0, 0, 169, 170, 1, 0, 0, 0, 170, 171, 5, 85, 0,
+		0, 171, 15, 1, 0, 0, 0, 172, 173, 5, 62, 0, 0, 173, 174, 5, 84, 0, 0, 174,
+		179, 3, 52, 26, 0, 175, 176, 5, 88, 0, 0, 176, 178, 3, 52, 26, 0, 177,
+		175, 1, 0, 0, 0, 178, 181, 1, 0, 0, 0, 179, 177, 1, 0, 0, 0, 179, 180,
+		1, 0, 0, 0, 180, 182, 1, 0, 0, 0, 181, 179, 1, 0, 0, 0, 182, 183, 5, 85,
+		0, 0, 183, 17, 1, 0, 0, 0, 184, 185, 5, 64, 0, 0, 185, 186, 5, 84, 0, 0,
+		186, 189, 3, 20, 10, 0, 187, 188, 5, 88, 0, 0, 188, 190, 3, 72, 36, 0,
+		189, 187, 1, 0, 0, 0, 189, 190, 1, 0, 0, 0, 190, 191, 1, 0, 0, 0, 191,
+		192, 5, 85, 0, 0, 192, 19, 1, 0, 0, 0, 193, 195, 5, 100, 0, 0, 194, 193,
+		1, 0, 0, 0, 194, 195, 1, 0, 0, 0, 195, 196, 1, 0, 0, 0, 196, 198, 5, 99,
+		0, 0, 197, 199, 3, 56, 28, 0, 198, 197, 1, 0, 0, 0, 198, 199, 1, 0, 0,
+		0, 199, 21, 1, 0, 0, 0, 200, 201, 5, 65, 0, 0, 201, 202, 5, 84, 0, 0, 202,
+		203, 3, 2, 1, 0, 203, 204, 5, 85, 0, 0, 204, 23, 1, 0, 0, 0, 205, 206,
+		5, 84, 0, 0, 206, 207, 3, 2, 1, 0, 207, 208, 5, 85, 0, 0, 208, 25, 1, 0,
+		0, 0, 209, 210, 5, 39, 0, 0, 210, 211, 3, 72, 36, 0, 211, 212, 5, 40, 0,
+		0, 212, 220, 3, 72, 36, 0, 213, 214, 5, 41, 0, 0, 214, 215, 3, 72, 36,
+		0, 215, 216, 5, 40, 0, 0, 216, 217, 3, 72, 36, 0, 217, 219, 1, 0, 0, 0,
+		218, 213, 1, 0, 0, 0, 219, 222, 1, 0, 0, 0, 220, 218, 1, 0, 0, 0, 220,
+		221, 1, 0, 0, 0, 221, 225, 1, 0, 0, 0, 222, 220, 1, 0, 0, 0, 223, 224,
+		5, 42, 0, 0, 224, 226, 3, 72, 36, 0, 225, 223, 1, 0, 0, 0, 225, 226, 1,
+		0, 0, 0, 226, 227, 1, 0, 0, 0, 227, 228, 5, 43, 0, 0, 228, 27, 1, 0, 0,
+		0, 229, 230, 5, 44, 0, 0, 230, 231, 5, 84, 0, 0, 231, 232, 5, 99, 0, 0,
+		232, 233, 5, 88, 0, 0, 233, 234, 3, 2, 1, 0, 234, 235, 5, 85, 0, 0, 235,
+		29, 1, 0, 0, 0, 236, 248, 5, 45, 0, 0, 237, 238, 5, 84, 0, 0, 238, 243,
+		3, 52, 26, 0, 239, 240, 5, 88, 0, 0, 240, 242, 3, 52, 26, 0, 241, 239,
+		1, 0, 0, 0, 242, 245, 1, 0, 0, 0, 243, 241, 1, 0, 0, 0, 243, 244, 1, 0,
+		0, 0, 244, 246, 1, 0, 0, 0, 245, 243, 1, 0, 0, 0, 246, 247, 5, 85, 0, 0,
+		247, 249, 1, 0, 0, 0, 248, 237, 1, 0, 0, 0, 248, 249, 1, 0, 0, 0, 249,
+		31, 1, 0, 0, 0, 250, 251, 5, 46, 0, 0, 251, 252, 5, 84, 0, 0, 252, 253,
+		5, 91, 0, 0, 253, 254, 5, 88, 0, 0, 254, 259, 3, 52, 26, 0, 255, 256, 5,
+		88, 0, 0, 256, 258, 3, 52, 26, 0, 257, 255, 1, 0, 0, 0, 258, 261, 1, 0,
+		0, 0, 259, 257, 1, 0, 0, 0, 259, 260, 1, 0, 0, 0, 260, 262, 1, 0, 0, 0,
+		261, 259, 1, 0, 0, 0, 262, 263, 5, 85, 0, 0, 263, 33, 1, 0, 0, 0, 264,
+		270, 5, 47, 0, 0, 265, 267, 5, 84, 0, 0, 266, 268, 3, 52, 26, 0, 267, 266,
+		1, 0, 0, 0, 267, 268, 1, 0, 0, 0, 268, 269, 1, 0, 0, 0, 269, 271, 5, 85,
+		0, 0, 270, 265, 1, 0, 0, 0, 270, 271, 1, 0, 0, 0, 271, 273, 1, 0, 0, 0,
+		272, 274, 3, 56, 28, 0, 273, 272, 1, 0, 0, 0, 273, 274, 1, 0, 0, 0, 274,
+		35, 1, 0, 0, 0, 275, 276, 5, 72, 0, 0, 276, 278, 5, 84, 0, 0, 277, 279,
+		3, 72, 36, 0, 278, 277, 1, 0, 0, 0, 278, 279, 1, 0, 0, 0, 279, 280, 1,
+		0, 0, 0, 280, 281, 5, 85, 0, 0, 281, 37, 1, 0, 0, 0, 282, 287, 3, 52, 26,
+		0, 283, 287, 3, 10, 5, 0, 284, 287, 3, 26, 13, 0, 285, 287, 3, 42, 21,
+		0, 286, 282, 1, 0, 0, 0, 286, 283, 1, 0, 0, 0, 286, 284, 1, 0, 0, 0, 286,
+		285, 1, 0, 0, 0, 287, 39, 1, 0, 0, 0, 288, 289, 5, 73, 0, 0, 289, 290,
+		5, 84, 0, 0, 290, 295, 3, 38, 19, 0, 291, 292, 5, 88, 0, 0, 292, 294, 3,
+		38, 19, 0, 293, 291, 1, 0, 0, 0, 294, 297, 1, 0, 0, 0, 295, 293, 1, 0,
+		0, 0, 295, 296, 1, 0, 0, 0, 296, 298, 1, 0, 0, 0, 297, 295, 1, 0, 0, 0,
+		298, 299, 5, 85, 0, 0, 299, 41, 1, 0, 0, 0, 300, 301, 7, 1, 0, 0, 301,
+		302, 5, 84, 0, 0, 302, 307, 3, 44, 22, 0, 303, 304, 5, 88, 0, 0, 304, 306,
+		3, 44, 22, 0, 305, 303, 1, 0, 0, 0, 306, 309, 1, 0, 0, 0, 307, 305, 1,
+		0, 0, 0, 307, 308, 1, 0, 0, 0, 308, 310, 1, 0, 0, 0, 309, 307, 1, 0, 0,
+		0, 310, 311, 5, 85, 0, 0, 311, 325, 1, 0, 0, 0, 312, 313, 5, 50, 0, 0,
+		313, 314, 5, 84, 0, 0, 314, 319, 3, 46, 23, 0, 315, 316, 5, 88, 0, 0, 316,
+		318, 3, 46, 23, 0, 317, 315, 1, 0, 0, 0, 318, 321, 1, 0, 0, 0, 319, 317,
+		1, 0, 0, 0, 319, 320, 1, 0, 0, 0, 320, 322, 1, 0, 0, 0, 321, 319, 1, 0,
+		0, 0, 322, 323, 5, 85, 0, 0, 323, 325, 1, 0, 0, 0, 324, 300, 1, 0, 0, 0,
+		324, 312, 1, 0, 0, 0, 325, 43, 1, 0, 0, 0, 326, 330, 3, 52, 26, 0, 327,
+		330, 3, 10, 5, 0, 328, 330, 3, 26, 13, 0, 329, 326, 1, 0, 0, 0, 329, 327,
+		1, 0, 0, 0, 329, 328, 1, 0, 0, 0, 330, 45, 1, 0, 0, 0, 331, 345, 3, 44,
+		22, 0, 332, 341, 5, 84, 0, 0, 333, 338, 3, 44, 22, 0, 334, 335, 5, 88,
+		0, 0, 335, 337, 3, 44, 22, 0, 336, 334, 1, 0, 0, 0, 337, 340, 1, 0, 0,
+		0, 338, 336, 1, 0, 0, 0, 338, 339, 1, 0, 0, 0, 339, 342, 1, 0, 0, 0, 340,
+		338, 1, 0, 0, 0, 341, 333, 1, 0, 0, 0, 341, 342, 1, 0, 0, 0, 342, 343,
+		1, 0, 0, 0, 343, 345, 5, 85, 0, 0, 344, 331, 1, 0, 0, 0, 344, 332, 1, 0,
+		0, 0, 345, 47, 1, 0, 0, 0, 346, 348, 3, 72, 36, 0, 347, 349, 7, 2, 0, 0,
+		348, 347, 1, 0, 0, 0, 348, 349, 1, 0, 0, 0, 349, 351, 1, 0, 0, 0, 350,
+		352, 7, 3, 0, 0, 351, 350, 1, 0, 0, 0, 351, 352, 1, 0, 0, 0, 352, 49, 1,
+		0, 0, 0, 353, 354, 5, 76, 0, 0, 354, 355, 5, 84, 0, 0, 355, 360, 3, 48,
+		24, 0, 356, 357, 5, 88, 0, 0, 357, 359, 3, 48, 24, 0, 358, 356, 1, 0, 0,
+		0, 359, 362, 1, 0, 0, 0, 360, 358, 1, 0, 0, 0, 360, 361, 1, 0, 0, 0, 361,
+		363, 1, 0, 0, 0, 362, 360, 1, 0, 0, 0, 363, 364, 5, 85, 0, 0, 364, 51,
+		1, 0, 0, 0, 365, 367, 5, 99, 0, 0, 366, 368, 5, 99, 0, 0, 367, 366, 1,
+		0, 0, 0, 367, 368, 1, 0, 0, 0, 368, 53, 1, 0, 0, 0, 369, 371, 3, 52, 26,
+		0, 370, 372, 3, 56, 28, 0, 371, 370, 1, 0, 0, 0, 371, 372, 1, 0, 0, 0,
+		372, 55, 1, 0, 0, 0, 373, 383, 5, 79, 0, 0, 374, 380, 5, 90, 0, 0, 375,
+		381, 5, 80, 0, 0, 376, 381, 5, 82, 0, 0, 377, 381, 5, 101, 0, 0, 378, 381,
+		3, 12, 6, 0, 379, 381, 3, 58, 29, 0, 380, 375, 1, 0, 0, 0, 380, 376, 1,
+		0, 0, 0, 380, 377, 1, 0, 0, 0, 380, 378, 1, 0, 0, 0, 380, 379, 1, 0, 0,
+		0, 381, 383, 1, 0, 0, 0, 382, 373, 1, 0, 0, 0, 382, 374, 1, 0, 0, 0, 383,
+		57, 1, 0, 0, 0, 384, 385, 7, 4, 0, 0, 385, 59, 1, 0, 0, 0, 386, 387, 5,
+		80, 0, 0, 387, 61, 1, 0, 0, 0, 388, 389, 5, 100, 0, 0, 389, 390, 5, 99,
+		0, 0, 390, 63, 1, 0, 0, 0, 391, 392, 5, 100, 0, 0, 392, 65, 1, 0, 0, 0,
+		393, 404, 5, 51, 0, 0, 394, 395, 3, 68, 34, 0, 395, 396, 5, 90, 0, 0, 396,
+		397, 3, 68, 34, 0, 397, 405, 1, 0, 0, 0, 398, 399, 3, 68, 34, 0, 399, 400,
+		5, 90, 0, 0, 400, 405, 1, 0, 0, 0, 401, 402, 5, 90, 0, 0, 402, 405, 3,
+		68, 34, 0, 403, 405, 3, 68, 34, 0, 404, 394, 1, 0, 0, 0, 404, 398, 1, 0,
+		0, 0, 404, 401, 1, 0, 0, 0, 404, 403, 1, 0, 0, 0, 404, 405, 1, 0, 0, 0,
+		405, 406, 1, 0, 0, 0, 406, 407, 5, 87, 0, 0, 407, 67, 1, 0, 0, 0, 408,
+		409, 7, 5, 0, 0, 409, 69, 1, 0, 0, 0, 410, 412, 3, 72, 36, 0, 411, 413,
+		3, 56, 28, 0, 412, 411, 1, 0, 0, 0, 412, 413, 1, 0, 0, 0, 413, 71, 1, 0,
+		0, 0, 414, 415, 6, 36, -1, 0, 415, 416, 5, 84, 0, 0, 416, 417, 3, 72, 36,
+		0, 417, 418, 5, 85, 0, 0, 418, 430, 1, 0, 0, 0, 419, 430, 3, 52, 26, 0,
+		420, 430, 3, 74, 37, 0, 421, 430, 3, 60, 30, 0, 422, 430, 3, 24, 12, 0,
+		423, 430, 3, 26, 13, 0, 424, 425, 3, 78, 39, 0, 425, 426, 3, 72, 36, 15,
+		426, 430, 1, 0, 0, 0, 427, 430, 3, 10, 5, 0, 428, 430, 3, 34, 17, 0, 429,
+		414, 1, 0, 0, 0, 429, 419, 1, 0, 0, 0, 429, 420, 1, 0, 0, 0, 429, 421,
+		1, 0, 0, 0, 429, 422, 1, 0, 0, 0, 429, 423, 1, 0, 0, 0, 429, 424, 1, 0,
+		0, 0, 429, 427, 1, 0, 0, 0, 429, 428, 1, 0, 0, 0, 430, 491, 1, 0, 0, 0,
+		431, 432, 10, 14, 0, 0, 432, 433, 5, 52, 0, 0, 433, 490, 3, 72, 36, 15,
+		434, 435, 10, 13, 0, 0, 435, 436, 7, 6, 0, 0, 436, 490, 3, 72, 36, 14,
+		437, 438, 10, 12, 0, 0, 438, 439, 7, 2, 0, 0, 439, 490, 3, 72, 36, 13,
+		440, 441, 10, 11, 0, 0, 441, 442, 7, 7, 0, 0, 442, 490, 3, 72, 36, 12,
+		443, 444, 10, 10, 0, 0, 444, 445, 7, 8, 0, 0, 445, 490, 3, 72, 36, 11,
+		446, 450, 10, 9, 0, 0, 447, 451, 5, 98, 0, 0, 448, 451, 5, 97, 0, 0, 449,
+		451, 1, 0, 0, 0, 450, 447, 1, 0, 0, 0, 450, 448, 1, 0, 0, 0, 450, 449,
+		1, 0, 0, 0, 451, 452, 1, 0, 0, 0, 452, 490, 3, 72, 36, 10, 453, 455, 10,
+		7, 0, 0, 454, 456, 5, 67, 0, 0, 455, 454, 1, 0, 0, 0, 455, 456, 1, 0, 0,
+		0, 456, 457, 1, 0, 0, 0, 457, 458, 5, 68, 0, 0, 458, 459, 3, 72, 36, 0,
+		459, 460, 5, 69, 0, 0, 460, 461, 3, 72, 36, 8, 461, 490, 1, 0, 0, 0, 462,
+		464, 10, 6, 0, 0, 463, 465, 5, 67, 0, 0, 464, 463, 1, 0, 0, 0, 464, 465,
+		1, 0, 0, 0, 465, 466, 1, 0, 0, 0, 466, 467, 5, 70, 0, 0, 467, 490, 3, 72,
+		36, 7, 468, 469, 10, 5, 0, 0, 469, 471, 5, 71, 0, 0, 470, 472, 5, 67, 0,
+		0, 471, 470, 1, 0, 0, 0, 471, 472, 1, 0, 0, 0, 472, 473, 1, 0, 0, 0, 473,
+		490, 3, 72, 36, 6, 474, 475, 10, 4, 0, 0, 475, 476, 5, 58, 0, 0, 476, 490,
+		3, 72, 36, 5, 477, 478, 10, 3, 0, 0, 478, 479, 5, 59, 0, 0, 479, 490, 3,
+		72, 36, 4, 480, 482, 10, 8, 0, 0, 481, 483, 5, 67, 0, 0, 482, 481, 1, 0,
+		0, 0, 482, 483, 1, 0, 0, 0, 483, 484, 1, 0, 0, 0, 484, 487, 5, 66, 0, 0,
+		485, 488, 3, 24, 12, 0, 486, 488, 3, 76, 38, 0, 487, 485, 1, 0, 0, 0, 487,
+		486, 1, 0, 0, 0, 488, 490, 1, 0, 0, 0, 489, 431, 1, 0, 0, 0, 489, 434,
+		1, 0, 0, 0, 489, 437, 1, 0, 0, 0, 489, 440, 1, 0, 0, 0, 489, 443, 1, 0,
+		0, 0, 489, 446, 1, 0, 0, 0, 489, 453, 1, 0, 0, 0, 489, 462, 1, 0, 0, 0,
+		489, 468, 1, 0, 0, 0, 489, 474, 1, 0, 0, 0, 489, 477, 1, 0, 0, 0, 489,
+		480, 1, 0, 0, 0, 490, 493, 1, 0, 0, 0, 491, 489, 1, 0, 0, 0, 491, 492,
+		1, 0, 0, 0, 492, 73, 1, 0, 0, 0, 493, 491, 1, 0, 0, 0, 494, 495, 7, 9,
+		0, 0, 495, 75, 1, 0, 0, 0, 496, 497, 5, 86, 0, 0, 497, 502, 3, 72, 36,
+		0, 498, 499, 5, 88, 0, 0, 499, 501, 3, 72, 36, 0, 500, 498, 1, 0, 0, 0,
+		501, 504, 1, 0, 0, 0, 502, 500, 1, 0, 0, 0, 502, 503, 1, 0, 0, 0, 503,
+		505, 1, 0, 0, 0, 504, 502, 1, 0, 0, 0, 505, 506, 5, 87, 0, 0, 506, 77,
+		1, 0, 0, 0, 507, 508, 7, 10, 0, 0, 508, 79, 1, 0, 0, 0, 54, 83, 90, 95,
+		101, 109, 117, 135, 139, 148, 152, 156, 165, 168, 179, 189, 194, 198, 220,
+		225, 243, 248, 259, 267, 270, 273, 278, 286, 295, 307, 319, 324, 329, 338,
+		341, 344, 348, 351, 360, 367, 371, 380, 382, 404, 412, 429, 450, 455, 464,
+		471, 482, 487, 489, 491, 502,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -240,37 +361,90 @@ const (
 	SLQParserT__15                 = 16
 	SLQParserT__16                 = 17
 	SLQParserT__17                 = 18
-	SLQParserPROPRIETARY_FUNC_NAME = 19
-	SLQParserJOIN_TYPE             = 20
-	SLQParserWHERE                 = 21
-	SLQParserGROUP_BY              = 22
-	SLQParserORDER_ASC             = 23
-	SLQParserORDER_DESC            = 24
-	SLQParserORDER_BY              = 25
-	SLQParserALIAS_RESERVED        = 26
-	SLQParserARG                   = 27
-	SLQParserNULL                  = 28
-	SLQParserID                    = 29
-	SLQParserWS                    = 30
-	SLQParserLPAR                  = 31
-	SLQParserRPAR                  = 32
-	SLQParserLBRA                  = 33
-	SLQParserRBRA                  = 34
-	SLQParserCOMMA                 = 35
-	SLQParserPIPE                  = 36
-	SLQParserCOLON                 = 37
-	SLQParserNN                    = 38
-	SLQParserNUMBER                = 39
-	SLQParserLT_EQ                 = 40
-	SLQParserLT                    = 41
-	SLQParserGT_EQ                 = 42
-	SLQParserGT                    = 43
-	SLQParserNEQ                   = 44
-	SLQParserEQ                    = 45
-	SLQParserNAME                  = 46
-	SLQParserHANDLE                = 47
-	SLQParserSTRING                = 48
-	SLQParserLINECOMMENT           = 49
+	SLQParserT__18                 = 19
+	SLQParserT__19                 = 20
+	SLQParserT__20                 = 21
+	SLQParserT__21                 = 22
+	SLQParserT__22                 = 23
+	SLQParserT__23                 = 24
+	SLQParserT__24                 = 25
+	SLQParserT__25                 = 26
+	SLQParserT__26                 = 27
+	SLQParserT__27                 = 28
+	SLQParserT__28                 = 29
+	SLQParserT__29                 = 30
+	SLQParserT__30                 = 31
+	SLQParserT__31                 = 32
+	SLQParserT__32                 = 33
+	SLQParserT__33                 = 34
+	SLQParserT__34                 = 35
+	SLQParserT__35                 = 36
+	SLQParserT__36                 = 37
+	SLQParserT__37                 = 38
+	SLQParserT__38                 = 39
+	SLQParserT__39                 = 40
+	SLQParserT__40                 = 41
+	SLQParserT__41                 = 42
+	SLQParserT__42                 = 43
+	SLQParserT__43                 = 44
+	SLQParserT__44                 = 45
+	SLQParserT__45                 = 46
+	SLQParserT__46                 = 47
+	SLQParserT__47                 = 48
+	SLQParserT__48                 = 49
+	SLQParserT__49                 = 50
+	SLQParserT__50                 = 51
+	SLQParserT__51                 = 52
+	SLQParserT__52                 = 53
+	SLQParserT__53                 = 54
+	SLQParserT__54                 = 55
+	SLQParserT__55                 = 56
+	SLQParserT__56                 = 57
+	SLQParserT__57                 = 58
+	SLQParserT__58                 = 59
+	SLQParserT__59                 = 60
+	SLQParserT__60                 = 61
+	SLQParserPARTITION_BY          = 62
+	SLQParserPROPRIETARY_FUNC_NAME = 63
+	SLQParserJOIN_TYPE             = 64
+	SLQParserSET_OP                = 65
+	SLQParserIN                    = 66
+	SLQParserNOT                   = 67
+	SLQParserBETWEEN               = 68
+	SLQParserAND                   = 69
+	SLQParserLIKE                  = 70
+	SLQParserIS                    = 71
+	SLQParserWHERE                 = 72
+	SLQParserGROUP_BY              = 73
+	SLQParserORDER_ASC             = 74
+	SLQParserORDER_DESC            = 75
+	SLQParserORDER_BY              = 76
+	SLQParserNULLS_FIRST           = 77
+	SLQParserNULLS_LAST            = 78
+	SLQParserALIAS_RESERVED        = 79
+	SLQParserARG                   = 80
+	SLQParserNULL                  = 81
+	SLQParserID                    = 82
+	SLQParserWS                    = 83
+	SLQParserLPAR                  = 84
+	SLQParserRPAR                  = 85
+	SLQParserLBRA                  = 86
+	SLQParserRBRA                  = 87
+	SLQParserCOMMA                 = 88
+	SLQParserPIPE                  = 89
+	SLQParserCOLON                 = 90
+	SLQParserNN                    = 91
+	SLQParserNUMBER                = 92
+	SLQParserLT_EQ                 = 93
+	SLQParserLT                    = 94
+	SLQParserGT_EQ                 = 95
+	SLQParserGT                    = 96
+	SLQParserNEQ                   = 97
+	SLQParserEQ                    = 98
+	SLQParserNAME                  = 99
+	SLQParserHANDLE                = 100
+	SLQParserSTRING                = 101
+	SLQParserLINECOMMENT           = 102
 )
 
 // SLQParser rules.
@@ -282,26 +456,39 @@ const (
 	SLQParserRULE_funcElement     = 4
 	SLQParserRULE_func            = 5
 	SLQParserRULE_funcName        = 6
-	SLQParserRULE_join            = 7
-	SLQParserRULE_joinTable       = 8
-	SLQParserRULE_uniqueFunc      = 9
-	SLQParserRULE_countFunc       = 10
-	SLQParserRULE_where           = 11
-	SLQParserRULE_groupByTerm     = 12
-	SLQParserRULE_groupBy         = 13
-	SLQParserRULE_orderByTerm     = 14
-	SLQParserRULE_orderBy         = 15
-	SLQParserRULE_selector        = 16
-	SLQParserRULE_selectorElement = 17
-	SLQParserRULE_alias           = 18
-	SLQParserRULE_arg             = 19
-	SLQParserRULE_handleTable     = 20
-	SLQParserRULE_handle          = 21
-	SLQParserRULE_rowRange        = 22
-	SLQParserRULE_exprElement     = 23
-	SLQParserRULE_expr            = 24
-	SLQParserRULE_literal         = 25
-	SLQParserRULE_unaryOperator   = 26
+	SLQParserRULE_window          = 7
+	SLQParserRULE_partitionBy     = 8
+	SLQParserRULE_join            = 9
+	SLQParserRULE_joinTable       = 10
+	SLQParserRULE_setOp           = 11
+	SLQParserRULE_subquery        = 12
+	SLQParserRULE_conditional     = 13
+	SLQParserRULE_cte             = 14
+	SLQParserRULE_uniqueFunc      = 15
+	SLQParserRULE_topFunc         = 16
+	SLQParserRULE_countFunc       = 17
+	SLQParserRULE_where           = 18
+	SLQParserRULE_groupByTerm     = 19
+	SLQParserRULE_groupBy         = 20
+	SLQParserRULE_grouping        = 21
+	SLQParserRULE_groupingTerm    = 22
+	SLQParserRULE_groupingSet     = 23
+	SLQParserRULE_orderByTerm     = 24
+	SLQParserRULE_orderBy         = 25
+	SLQParserRULE_selector        = 26
+	SLQParserRULE_selectorElement = 27
+	SLQParserRULE_alias           = 28
+	SLQParserRULE_aliasKeyword    = 29
+	SLQParserRULE_arg             = 30
+	SLQParserRULE_handleTable     = 31
+	SLQParserRULE_handle          = 32
+	SLQParserRULE_rowRange        = 33
+	SLQParserRULE_rowRangeIndex   = 34
+	SLQParserRULE_exprElement     = 35
+	SLQParserRULE_expr            = 36
+	SLQParserRULE_literal         = 37
+	SLQParserRULE_list            = 38
+	SLQParserRULE_unaryOperator   = 39
 )
 
 // IStmtListContext is an interface to support dynamic dispatch.
@@ -430,7 +617,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(57)
+	p.SetState(83)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -439,7 +626,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 
 	for _la == SLQParserT__0 {
 		{
-			p.SetState(54)
+			p.SetState(80)
 			p.Match(SLQParserT__0)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -447,7 +634,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 			}
 		}
 
-		p.SetState(59)
+		p.SetState(85)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -455,10 +642,10 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(60)
+		p.SetState(86)
 		p.Query()
 	}
-	p.SetState(69)
+	p.SetState(95)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -469,7 +656,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(62)
+			p.SetState(88)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -478,7 +665,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 
 			for ok := true; ok; ok = _la == SLQParserT__0 {
 				{
-					p.SetState(61)
+					p.SetState(87)
 					p.Match(SLQParserT__0)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -486,7 +673,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 					}
 				}
 
-				p.SetState(64)
+				p.SetState(90)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -494,12 +681,12 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 				_la = p.GetTokenStream().LA(1)
 			}
 			{
-				p.SetState(66)
+				p.SetState(92)
 				p.Query()
 			}
 
 		}
-		p.SetState(71)
+		p.SetState(97)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -509,7 +696,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 			goto errorExit
 		}
 	}
-	p.SetState(75)
+	p.SetState(101)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -518,7 +705,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 
 	for _la == SLQParserT__0 {
 		{
-			p.SetState(72)
+			p.SetState(98)
 			p.Match(SLQParserT__0)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -526,7 +713,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 			}
 		}
 
-		p.SetState(77)
+		p.SetState(103)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -682,10 +869,10 @@ func (p *SLQParser) Query() (localctx IQueryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(78)
+		p.SetState(104)
 		p.Segment()
 	}
-	p.SetState(83)
+	p.SetState(109)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -694,7 +881,7 @@ func (p *SLQParser) Query() (localctx IQueryContext) {
 
 	for _la == SLQParserPIPE {
 		{
-			p.SetState(79)
+			p.SetState(105)
 			p.Match(SLQParserPIPE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -702,11 +889,11 @@ func (p *SLQParser) Query() (localctx IQueryContext) {
 			}
 		}
 		{
-			p.SetState(80)
+			p.SetState(106)
 			p.Segment()
 		}
 
-		p.SetState(85)
+		p.SetState(111)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -862,11 +1049,11 @@ func (p *SLQParser) Segment() (localctx ISegmentContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(86)
+		p.SetState(112)
 		p.Element()
 	}
 
-	p.SetState(91)
+	p.SetState(117)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -875,7 +1062,7 @@ func (p *SLQParser) Segment() (localctx ISegmentContext) {
 
 	for _la == SLQParserCOMMA {
 		{
-			p.SetState(87)
+			p.SetState(113)
 			p.Match(SLQParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -883,11 +1070,11 @@ func (p *SLQParser) Segment() (localctx ISegmentContext) {
 			}
 		}
 		{
-			p.SetState(88)
+			p.SetState(114)
 			p.Element()
 		}
 
-		p.SetState(93)
+		p.SetState(119)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -924,8 +1111,11 @@ type IElementContext interface {
 	OrderBy() IOrderByContext
 	RowRange() IRowRangeContext
 	UniqueFunc() IUniqueFuncContext
+	TopFunc() ITopFuncContext
 	CountFunc() ICountFuncContext
 	Where() IWhereContext
+	SetOp() ISetOpContext
+	Cte() ICteContext
 	FuncElement() IFuncElementContext
 	ExprElement() IExprElementContext
 
@@ -1093,6 +1283,22 @@ func (s *ElementContext) UniqueFunc() IUniqueFuncContext {
 	return t.(IUniqueFuncContext)
 }
 
+func (s *ElementContext) TopFunc() ITopFuncContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITopFuncContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITopFuncContext)
+}
+
 func (s *ElementContext) CountFunc() ICountFuncContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -1125,6 +1331,38 @@ func (s *ElementContext) Where() IWhereContext {
 	return t.(IWhereContext)
 }
 
+func (s *ElementContext) SetOp() ISetOpContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISetOpContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISetOpContext)
+}
+
+func (s *ElementContext) Cte() ICteContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICteContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICteContext)
+}
+
 func (s *ElementContext) FuncElement() IFuncElementContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -1190,7 +1428,7 @@ func (s *ElementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 func (p *SLQParser) Element() (localctx IElementContext) {
 	localctx = NewElementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 6, SLQParserRULE_element)
-	p.SetState(106)
+	p.SetState(135)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1200,84 +1438,105 @@ func (p *SLQParser) Element() (localctx IElementContext) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(94)
+			p.SetState(120)
 			p.HandleTable()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(95)
+			p.SetState(121)
 			p.Handle()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(96)
+			p.SetState(122)
 			p.SelectorElement()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(97)
+			p.SetState(123)
 			p.Join()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(98)
+			p.SetState(124)
 			p.GroupBy()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(99)
+			p.SetState(125)
 			p.OrderBy()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(100)
+			p.SetState(126)
 			p.RowRange()
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(101)
+			p.SetState(127)
 			p.UniqueFunc()
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(102)
-			p.CountFunc()
+			p.SetState(128)
+			p.TopFunc()
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(103)
-			p.Where()
+			p.SetState(129)
+			p.CountFunc()
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(104)
-			p.FuncElement()
+			p.SetState(130)
+			p.Where()
 		}
 
 	case 12:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(105)
+			p.SetState(131)
+			p.SetOp()
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(132)
+			p.Cte()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(133)
+			p.FuncElement()
+		}
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(134)
 			p.ExprElement()
 		}
 
@@ -1414,10 +1673,10 @@ func (p *SLQParser) FuncElement() (localctx IFuncElementContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(108)
+		p.SetState(137)
 		p.Func_()
 	}
-	p.SetState(110)
+	p.SetState(139)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1426,7 +1685,7 @@ func (p *SLQParser) FuncElement() (localctx IFuncElementContext) {
 
 	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
 		{
-			p.SetState(109)
+			p.SetState(138)
 			p.Alias()
 		}
 
@@ -1458,6 +1717,7 @@ type IFuncContext interface {
 	RPAR() antlr.TerminalNode
 	AllExpr() []IExprContext
 	Expr(i int) IExprContext
+	Window() IWindowContext
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
 
@@ -1562,6 +1822,22 @@ func (s *FuncContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
+func (s *FuncContext) Window() IWindowContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWindowContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWindowContext)
+}
+
 func (s *FuncContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(SLQParserCOMMA)
 }
@@ -1607,29 +1883,29 @@ func (p *SLQParser) Func_() (localctx IFuncContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(112)
+		p.SetState(141)
 		p.FuncName()
 	}
 	{
-		p.SetState(113)
+		p.SetState(142)
 		p.Match(SLQParserLPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(123)
+	p.SetState(152)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	switch p.GetTokenStream().LA(1) {
-	case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__16, SLQParserT__17, SLQParserPROPRIETARY_FUNC_NAME, SLQParserORDER_ASC, SLQParserORDER_DESC, SLQParserARG, SLQParserNULL, SLQParserLPAR, SLQParserNN, SLQParserNUMBER, SLQParserNAME, SLQParserSTRING:
+	case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__6, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserT__38, SLQParserT__46, SLQParserT__59, SLQParserT__60, SLQParserPROPRIETARY_FUNC_NAME, SLQParserORDER_ASC, SLQParserORDER_DESC, SLQParserARG, SLQParserNULL, SLQParserLPAR, SLQParserNN, SLQParserNUMBER, SLQParserNAME, SLQParserSTRING:
 		{
-			p.SetState(114)
+			p.SetState(143)
 			p.expr(0)
 		}
-		p.SetState(119)
+		p.SetState(148)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1638,7 +1914,7 @@ func (p *SLQParser) Func_() (localctx IFuncContext) {
 
 		for _la == SLQParserCOMMA {
 			{
-				p.SetState(115)
+				p.SetState(144)
 				p.Match(SLQParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -1646,11 +1922,11 @@ func (p *SLQParser) Func_() (localctx IFuncContext) {
 				}
 			}
 			{
-				p.SetState(116)
+				p.SetState(145)
 				p.expr(0)
 			}
 
-			p.SetState(121)
+			p.SetState(150)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -1660,7 +1936,7 @@ func (p *SLQParser) Func_() (localctx IFuncContext) {
 
 	case SLQParserT__1:
 		{
-			p.SetState(122)
+			p.SetState(151)
 			p.Match(SLQParserT__1)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1673,13 +1949,25 @@ func (p *SLQParser) Func_() (localctx IFuncContext) {
 	default:
 	}
 	{
-		p.SetState(125)
+		p.SetState(154)
 		p.Match(SLQParserRPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	p.SetState(156)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 10, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(155)
+			p.Window()
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
 
 errorExit:
 	if p.HasError() {
@@ -1781,10 +2069,10 @@ func (p *SLQParser) FuncName() (localctx IFuncNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(127)
+		p.SetState(158)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&524408) != 0) {
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-9223371761976868872) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -1805,69 +2093,68 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IJoinContext is an interface to support dynamic dispatch.
-type IJoinContext interface {
+// IWindowContext is an interface to support dynamic dispatch.
+type IWindowContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	JOIN_TYPE() antlr.TerminalNode
 	LPAR() antlr.TerminalNode
-	JoinTable() IJoinTableContext
 	RPAR() antlr.TerminalNode
+	PartitionBy() IPartitionByContext
+	OrderBy() IOrderByContext
 	COMMA() antlr.TerminalNode
-	Expr() IExprContext
 
-	// IsJoinContext differentiates from other interfaces.
-	IsJoinContext()
+	// IsWindowContext differentiates from other interfaces.
+	IsWindowContext()
 }
 
-type JoinContext struct {
+type WindowContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyJoinContext() *JoinContext {
-	var p = new(JoinContext)
+func NewEmptyWindowContext() *WindowContext {
+	var p = new(WindowContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_join
+	p.RuleIndex = SLQParserRULE_window
 	return p
 }
 
-func InitEmptyJoinContext(p *JoinContext) {
+func InitEmptyWindowContext(p *WindowContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_join
+	p.RuleIndex = SLQParserRULE_window
 }
 
-func (*JoinContext) IsJoinContext() {}
+func (*WindowContext) IsWindowContext() {}
 
-func NewJoinContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinContext {
-	var p = new(JoinContext)
+func NewWindowContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WindowContext {
+	var p = new(WindowContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_join
+	p.RuleIndex = SLQParserRULE_window
 
 	return p
 }
 
-func (s *JoinContext) GetParser() antlr.Parser { return s.parser }
+func (s *WindowContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *JoinContext) JOIN_TYPE() antlr.TerminalNode {
-	return s.GetToken(SLQParserJOIN_TYPE, 0)
+func (s *WindowContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *JoinContext) LPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserLPAR, 0)
+func (s *WindowContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
 }
 
-func (s *JoinContext) JoinTable() IJoinTableContext {
+func (s *WindowContext) PartitionBy() IPartitionByContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJoinTableContext); ok {
+		if _, ok := ctx.(IPartitionByContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1877,21 +2164,13 @@ func (s *JoinContext) JoinTable() IJoinTableContext {
 		return nil
 	}
 
-	return t.(IJoinTableContext)
-}
-
-func (s *JoinContext) RPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserRPAR, 0)
-}
-
-func (s *JoinContext) COMMA() antlr.TerminalNode {
-	return s.GetToken(SLQParserCOMMA, 0)
+	return t.(IPartitionByContext)
 }
 
-func (s *JoinContext) Expr() IExprContext {
+func (s *WindowContext) OrderBy() IOrderByContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExprContext); ok {
+		if _, ok := ctx.(IOrderByContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -1901,89 +2180,111 @@ func (s *JoinContext) Expr() IExprContext {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IOrderByContext)
 }
 
-func (s *JoinContext) GetRuleContext() antlr.RuleContext {
+func (s *WindowContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, 0)
+}
+
+func (s *WindowContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *JoinContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *WindowContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *JoinContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *WindowContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterJoin(s)
+		listenerT.EnterWindow(s)
 	}
 }
 
-func (s *JoinContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *WindowContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitJoin(s)
+		listenerT.ExitWindow(s)
 	}
 }
 
-func (s *JoinContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *WindowContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitJoin(s)
+		return t.VisitWindow(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) Join() (localctx IJoinContext) {
-	localctx = NewJoinContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, SLQParserRULE_join)
+func (p *SLQParser) Window() (localctx IWindowContext) {
+	localctx = NewWindowContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, SLQParserRULE_window)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(129)
-		p.Match(SLQParserJOIN_TYPE)
+		p.SetState(160)
+		p.Match(SLQParserT__37)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(130)
+		p.SetState(161)
 		p.Match(SLQParserLPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(131)
-		p.JoinTable()
-	}
-	p.SetState(134)
+	p.SetState(168)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SLQParserCOMMA {
+	switch p.GetTokenStream().LA(1) {
+	case SLQParserPARTITION_BY:
 		{
-			p.SetState(132)
-			p.Match(SLQParserCOMMA)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+			p.SetState(162)
+			p.PartitionBy()
+		}
+		p.SetState(165)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SLQParserCOMMA {
+			{
+				p.SetState(163)
+				p.Match(SLQParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(164)
+				p.OrderBy()
 			}
+
 		}
+
+	case SLQParserORDER_BY:
 		{
-			p.SetState(133)
-			p.expr(0)
+			p.SetState(167)
+			p.OrderBy()
 		}
 
+	case SLQParserRPAR:
+
+	default:
 	}
 	{
-		p.SetState(136)
+		p.SetState(170)
 		p.Match(SLQParserRPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2004,68 +2305,97 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IJoinTableContext is an interface to support dynamic dispatch.
-type IJoinTableContext interface {
+// IPartitionByContext is an interface to support dynamic dispatch.
+type IPartitionByContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	NAME() antlr.TerminalNode
-	HANDLE() antlr.TerminalNode
-	Alias() IAliasContext
+	PARTITION_BY() antlr.TerminalNode
+	LPAR() antlr.TerminalNode
+	AllSelector() []ISelectorContext
+	Selector(i int) ISelectorContext
+	RPAR() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsJoinTableContext differentiates from other interfaces.
-	IsJoinTableContext()
+	// IsPartitionByContext differentiates from other interfaces.
+	IsPartitionByContext()
 }
 
-type JoinTableContext struct {
+type PartitionByContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyJoinTableContext() *JoinTableContext {
-	var p = new(JoinTableContext)
+func NewEmptyPartitionByContext() *PartitionByContext {
+	var p = new(PartitionByContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_joinTable
+	p.RuleIndex = SLQParserRULE_partitionBy
 	return p
 }
 
-func InitEmptyJoinTableContext(p *JoinTableContext) {
+func InitEmptyPartitionByContext(p *PartitionByContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_joinTable
+	p.RuleIndex = SLQParserRULE_partitionBy
 }
 
-func (*JoinTableContext) IsJoinTableContext() {}
+func (*PartitionByContext) IsPartitionByContext() {}
 
-func NewJoinTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinTableContext {
-	var p = new(JoinTableContext)
+func NewPartitionByContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PartitionByContext {
+	var p = new(PartitionByContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_joinTable
+	p.RuleIndex = SLQParserRULE_partitionBy
 
 	return p
 }
 
-func (s *JoinTableContext) GetParser() antlr.Parser { return s.parser }
+func (s *PartitionByContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *JoinTableContext) NAME() antlr.TerminalNode {
-	return s.GetToken(SLQParserNAME, 0)
+func (s *PartitionByContext) PARTITION_BY() antlr.TerminalNode {
+	return s.GetToken(SLQParserPARTITION_BY, 0)
 }
 
-func (s *JoinTableContext) HANDLE() antlr.TerminalNode {
-	return s.GetToken(SLQParserHANDLE, 0)
+func (s *PartitionByContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *JoinTableContext) Alias() IAliasContext {
+func (s *PartitionByContext) AllSelector() []ISelectorContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISelectorContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISelectorContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISelectorContext); ok {
+			tst[i] = t.(ISelectorContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *PartitionByContext) Selector(i int) ISelectorContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAliasContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ISelectorContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -2073,177 +2403,108 @@ func (s *JoinTableContext) Alias() IAliasContext {
 		return nil
 	}
 
-	return t.(IAliasContext)
+	return t.(ISelectorContext)
 }
 
-func (s *JoinTableContext) GetRuleContext() antlr.RuleContext {
+func (s *PartitionByContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *PartitionByContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserCOMMA)
+}
+
+func (s *PartitionByContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, i)
+}
+
+func (s *PartitionByContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *JoinTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PartitionByContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *JoinTableContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PartitionByContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterJoinTable(s)
+		listenerT.EnterPartitionBy(s)
 	}
 }
 
-func (s *JoinTableContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PartitionByContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitJoinTable(s)
+		listenerT.ExitPartitionBy(s)
 	}
 }
 
-func (s *JoinTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PartitionByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitJoinTable(s)
+		return t.VisitPartitionBy(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) JoinTable() (localctx IJoinTableContext) {
-	localctx = NewJoinTableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, SLQParserRULE_joinTable)
+func (p *SLQParser) PartitionBy() (localctx IPartitionByContext) {
+	localctx = NewPartitionByContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, SLQParserRULE_partitionBy)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(139)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SLQParserHANDLE {
-		{
-			p.SetState(138)
-			p.Match(SLQParserHANDLE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+	{
+		p.SetState(172)
+		p.Match(SLQParserPARTITION_BY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
 	}
 	{
-		p.SetState(141)
-		p.Match(SLQParserNAME)
+		p.SetState(173)
+		p.Match(SLQParserLPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(143)
+	{
+		p.SetState(174)
+		p.Selector()
+	}
+	p.SetState(179)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
+	for _la == SLQParserCOMMA {
 		{
-			p.SetState(142)
-			p.Alias()
+			p.SetState(175)
+			p.Match(SLQParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(176)
+			p.Selector()
 		}
 
+		p.SetState(181)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
-
-errorExit:
-	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
-	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
-
-// IUniqueFuncContext is an interface to support dynamic dispatch.
-type IUniqueFuncContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-	// IsUniqueFuncContext differentiates from other interfaces.
-	IsUniqueFuncContext()
-}
-
-type UniqueFuncContext struct {
-	antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyUniqueFuncContext() *UniqueFuncContext {
-	var p = new(UniqueFuncContext)
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_uniqueFunc
-	return p
-}
-
-func InitEmptyUniqueFuncContext(p *UniqueFuncContext) {
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_uniqueFunc
-}
-
-func (*UniqueFuncContext) IsUniqueFuncContext() {}
-
-func NewUniqueFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UniqueFuncContext {
-	var p = new(UniqueFuncContext)
-
-	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = SLQParserRULE_uniqueFunc
-
-	return p
-}
-
-func (s *UniqueFuncContext) GetParser() antlr.Parser { return s.parser }
-func (s *UniqueFuncContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *UniqueFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *UniqueFuncContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterUniqueFunc(s)
-	}
-}
-
-func (s *UniqueFuncContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitUniqueFunc(s)
-	}
-}
-
-func (s *UniqueFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SLQVisitor:
-		return t.VisitUniqueFunc(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-func (p *SLQParser) UniqueFunc() (localctx IUniqueFuncContext) {
-	localctx = NewUniqueFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, SLQParserRULE_uniqueFunc)
-	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(145)
-		p.Match(SLQParserT__6)
+		p.SetState(182)
+		p.Match(SLQParserRPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -2263,67 +2524,69 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ICountFuncContext is an interface to support dynamic dispatch.
-type ICountFuncContext interface {
+// IJoinContext is an interface to support dynamic dispatch.
+type IJoinContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
+	JOIN_TYPE() antlr.TerminalNode
 	LPAR() antlr.TerminalNode
+	JoinTable() IJoinTableContext
 	RPAR() antlr.TerminalNode
-	Alias() IAliasContext
-	Selector() ISelectorContext
+	COMMA() antlr.TerminalNode
+	Expr() IExprContext
 
-	// IsCountFuncContext differentiates from other interfaces.
-	IsCountFuncContext()
+	// IsJoinContext differentiates from other interfaces.
+	IsJoinContext()
 }
 
-type CountFuncContext struct {
+type JoinContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCountFuncContext() *CountFuncContext {
-	var p = new(CountFuncContext)
+func NewEmptyJoinContext() *JoinContext {
+	var p = new(JoinContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_countFunc
+	p.RuleIndex = SLQParserRULE_join
 	return p
 }
 
-func InitEmptyCountFuncContext(p *CountFuncContext) {
+func InitEmptyJoinContext(p *JoinContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_countFunc
+	p.RuleIndex = SLQParserRULE_join
 }
 
-func (*CountFuncContext) IsCountFuncContext() {}
+func (*JoinContext) IsJoinContext() {}
 
-func NewCountFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CountFuncContext {
-	var p = new(CountFuncContext)
+func NewJoinContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinContext {
+	var p = new(JoinContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_countFunc
+	p.RuleIndex = SLQParserRULE_join
 
 	return p
 }
 
-func (s *CountFuncContext) GetParser() antlr.Parser { return s.parser }
+func (s *JoinContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CountFuncContext) LPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserLPAR, 0)
+func (s *JoinContext) JOIN_TYPE() antlr.TerminalNode {
+	return s.GetToken(SLQParserJOIN_TYPE, 0)
 }
 
-func (s *CountFuncContext) RPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserRPAR, 0)
+func (s *JoinContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *CountFuncContext) Alias() IAliasContext {
+func (s *JoinContext) JoinTable() IJoinTableContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAliasContext); ok {
+		if _, ok := ctx.(IJoinTableContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2333,13 +2596,21 @@ func (s *CountFuncContext) Alias() IAliasContext {
 		return nil
 	}
 
-	return t.(IAliasContext)
+	return t.(IJoinTableContext)
 }
 
-func (s *CountFuncContext) Selector() ISelectorContext {
+func (s *JoinContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *JoinContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, 0)
+}
+
+func (s *JoinContext) Expr() IExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISelectorContext); ok {
+		if _, ok := ctx.(IExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2349,86 +2620,228 @@ func (s *CountFuncContext) Selector() ISelectorContext {
 		return nil
 	}
 
-	return t.(ISelectorContext)
+	return t.(IExprContext)
 }
 
-func (s *CountFuncContext) GetRuleContext() antlr.RuleContext {
+func (s *JoinContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CountFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *JoinContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CountFuncContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *JoinContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterCountFunc(s)
+		listenerT.EnterJoin(s)
 	}
 }
 
-func (s *CountFuncContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *JoinContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitCountFunc(s)
+		listenerT.ExitJoin(s)
 	}
 }
 
-func (s *CountFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *JoinContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitCountFunc(s)
+		return t.VisitJoin(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) CountFunc() (localctx ICountFuncContext) {
-	localctx = NewCountFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, SLQParserRULE_countFunc)
+func (p *SLQParser) Join() (localctx IJoinContext) {
+	localctx = NewJoinContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, SLQParserRULE_join)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(147)
-		p.Match(SLQParserT__7)
+		p.SetState(184)
+		p.Match(SLQParserJOIN_TYPE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(185)
+		p.Match(SLQParserLPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(153)
+	{
+		p.SetState(186)
+		p.JoinTable()
+	}
+	p.SetState(189)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SLQParserLPAR {
+	if _la == SLQParserCOMMA {
 		{
-			p.SetState(148)
-			p.Match(SLQParserLPAR)
+			p.SetState(187)
+			p.Match(SLQParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(150)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		{
+			p.SetState(188)
+			p.expr(0)
 		}
-		_la = p.GetTokenStream().LA(1)
 
-		if _la == SLQParserNAME {
-			{
-				p.SetState(149)
-				p.Selector()
-			}
+	}
+	{
+		p.SetState(191)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IJoinTableContext is an interface to support dynamic dispatch.
+type IJoinTableContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	NAME() antlr.TerminalNode
+	HANDLE() antlr.TerminalNode
+	Alias() IAliasContext
+
+	// IsJoinTableContext differentiates from other interfaces.
+	IsJoinTableContext()
+}
+
+type JoinTableContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyJoinTableContext() *JoinTableContext {
+	var p = new(JoinTableContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_joinTable
+	return p
+}
+
+func InitEmptyJoinTableContext(p *JoinTableContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_joinTable
+}
+
+func (*JoinTableContext) IsJoinTableContext() {}
 
+func NewJoinTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinTableContext {
+	var p = new(JoinTableContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_joinTable
+
+	return p
+}
+
+func (s *JoinTableContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *JoinTableContext) NAME() antlr.TerminalNode {
+	return s.GetToken(SLQParserNAME, 0)
+}
+
+func (s *JoinTableContext) HANDLE() antlr.TerminalNode {
+	return s.GetToken(SLQParserHANDLE, 0)
+}
+
+func (s *JoinTableContext) Alias() IAliasContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAliasContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAliasContext)
+}
+
+func (s *JoinTableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *JoinTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *JoinTableContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterJoinTable(s)
+	}
+}
+
+func (s *JoinTableContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitJoinTable(s)
+	}
+}
+
+func (s *JoinTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitJoinTable(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) JoinTable() (localctx IJoinTableContext) {
+	localctx = NewJoinTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, SLQParserRULE_joinTable)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(194)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SLQParserHANDLE {
 		{
-			p.SetState(152)
-			p.Match(SLQParserRPAR)
+			p.SetState(193)
+			p.Match(SLQParserHANDLE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
@@ -2436,7 +2849,15 @@ func (p *SLQParser) CountFunc() (localctx ICountFuncContext) {
 		}
 
 	}
-	p.SetState(156)
+	{
+		p.SetState(196)
+		p.Match(SLQParserNAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(198)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2445,7 +2866,7 @@ func (p *SLQParser) CountFunc() (localctx ICountFuncContext) {
 
 	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
 		{
-			p.SetState(155)
+			p.SetState(197)
 			p.Alias()
 		}
 
@@ -2464,71 +2885,67 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IWhereContext is an interface to support dynamic dispatch.
-type IWhereContext interface {
+// ISetOpContext is an interface to support dynamic dispatch.
+type ISetOpContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	WHERE() antlr.TerminalNode
+	SET_OP() antlr.TerminalNode
 	LPAR() antlr.TerminalNode
+	Query() IQueryContext
 	RPAR() antlr.TerminalNode
-	Expr() IExprContext
 
-	// IsWhereContext differentiates from other interfaces.
-	IsWhereContext()
+	// IsSetOpContext differentiates from other interfaces.
+	IsSetOpContext()
 }
 
-type WhereContext struct {
+type SetOpContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyWhereContext() *WhereContext {
-	var p = new(WhereContext)
+func NewEmptySetOpContext() *SetOpContext {
+	var p = new(SetOpContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_where
+	p.RuleIndex = SLQParserRULE_setOp
 	return p
 }
 
-func InitEmptyWhereContext(p *WhereContext) {
+func InitEmptySetOpContext(p *SetOpContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_where
+	p.RuleIndex = SLQParserRULE_setOp
 }
 
-func (*WhereContext) IsWhereContext() {}
+func (*SetOpContext) IsSetOpContext() {}
 
-func NewWhereContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WhereContext {
-	var p = new(WhereContext)
+func NewSetOpContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SetOpContext {
+	var p = new(SetOpContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_where
+	p.RuleIndex = SLQParserRULE_setOp
 
 	return p
 }
 
-func (s *WhereContext) GetParser() antlr.Parser { return s.parser }
+func (s *SetOpContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WhereContext) WHERE() antlr.TerminalNode {
-	return s.GetToken(SLQParserWHERE, 0)
+func (s *SetOpContext) SET_OP() antlr.TerminalNode {
+	return s.GetToken(SLQParserSET_OP, 0)
 }
 
-func (s *WhereContext) LPAR() antlr.TerminalNode {
+func (s *SetOpContext) LPAR() antlr.TerminalNode {
 	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *WhereContext) RPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserRPAR, 0)
-}
-
-func (s *WhereContext) Expr() IExprContext {
+func (s *SetOpContext) Query() IQueryContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExprContext); ok {
+		if _, ok := ctx.(IQueryContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2538,82 +2955,2191 @@ func (s *WhereContext) Expr() IExprContext {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IQueryContext)
 }
 
-func (s *WhereContext) GetRuleContext() antlr.RuleContext {
+func (s *SetOpContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *SetOpContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *WhereContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SetOpContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *WhereContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SetOpContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterWhere(s)
+		listenerT.EnterSetOp(s)
 	}
 }
 
-func (s *WhereContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SetOpContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitWhere(s)
+		listenerT.ExitSetOp(s)
 	}
 }
 
-func (s *WhereContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SetOpContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitWhere(s)
+		return t.VisitSetOp(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) Where() (localctx IWhereContext) {
-	localctx = NewWhereContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, SLQParserRULE_where)
-	var _la int
-
+func (p *SLQParser) SetOp() (localctx ISetOpContext) {
+	localctx = NewSetOpContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, SLQParserRULE_setOp)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(158)
-		p.Match(SLQParserWHERE)
+		p.SetState(200)
+		p.Match(SLQParserSET_OP)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(159)
+		p.SetState(201)
 		p.Match(SLQParserLPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(161)
+	{
+		p.SetState(202)
+		p.Query()
+	}
+	{
+		p.SetState(203)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISubqueryContext is an interface to support dynamic dispatch.
+type ISubqueryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LPAR() antlr.TerminalNode
+	Query() IQueryContext
+	RPAR() antlr.TerminalNode
+
+	// IsSubqueryContext differentiates from other interfaces.
+	IsSubqueryContext()
+}
+
+type SubqueryContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySubqueryContext() *SubqueryContext {
+	var p = new(SubqueryContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_subquery
+	return p
+}
+
+func InitEmptySubqueryContext(p *SubqueryContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_subquery
+}
+
+func (*SubqueryContext) IsSubqueryContext() {}
+
+func NewSubqueryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SubqueryContext {
+	var p = new(SubqueryContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_subquery
+
+	return p
+}
+
+func (s *SubqueryContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SubqueryContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
+
+func (s *SubqueryContext) Query() IQueryContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQueryContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQueryContext)
+}
+
+func (s *SubqueryContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *SubqueryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SubqueryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SubqueryContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterSubquery(s)
+	}
+}
+
+func (s *SubqueryContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitSubquery(s)
+	}
+}
+
+func (s *SubqueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitSubquery(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Subquery() (localctx ISubqueryContext) {
+	localctx = NewSubqueryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, SLQParserRULE_subquery)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(205)
+		p.Match(SLQParserLPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(206)
+		p.Query()
+	}
+	{
+		p.SetState(207)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IConditionalContext is an interface to support dynamic dispatch.
+type IConditionalContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllExpr() []IExprContext
+	Expr(i int) IExprContext
+
+	// IsConditionalContext differentiates from other interfaces.
+	IsConditionalContext()
+}
+
+type ConditionalContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyConditionalContext() *ConditionalContext {
+	var p = new(ConditionalContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_conditional
+	return p
+}
+
+func InitEmptyConditionalContext(p *ConditionalContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_conditional
+}
+
+func (*ConditionalContext) IsConditionalContext() {}
+
+func NewConditionalContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConditionalContext {
+	var p = new(ConditionalContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_conditional
+
+	return p
+}
+
+func (s *ConditionalContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ConditionalContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ConditionalContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ConditionalContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ConditionalContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ConditionalContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterConditional(s)
+	}
+}
+
+func (s *ConditionalContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitConditional(s)
+	}
+}
+
+func (s *ConditionalContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitConditional(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Conditional() (localctx IConditionalContext) {
+	localctx = NewConditionalContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, SLQParserRULE_conditional)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(209)
+		p.Match(SLQParserT__38)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(210)
+		p.expr(0)
+	}
+	{
+		p.SetState(211)
+		p.Match(SLQParserT__39)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(212)
+		p.expr(0)
+	}
+	p.SetState(220)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SLQParserT__40 {
+		{
+			p.SetState(213)
+			p.Match(SLQParserT__40)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(214)
+			p.expr(0)
+		}
+		{
+			p.SetState(215)
+			p.Match(SLQParserT__39)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(216)
+			p.expr(0)
+		}
+
+		p.SetState(222)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(225)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SLQParserT__41 {
+		{
+			p.SetState(223)
+			p.Match(SLQParserT__41)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(224)
+			p.expr(0)
+		}
+
+	}
+	{
+		p.SetState(227)
+		p.Match(SLQParserT__42)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICteContext is an interface to support dynamic dispatch.
+type ICteContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LPAR() antlr.TerminalNode
+	NAME() antlr.TerminalNode
+	COMMA() antlr.TerminalNode
+	Query() IQueryContext
+	RPAR() antlr.TerminalNode
+
+	// IsCteContext differentiates from other interfaces.
+	IsCteContext()
+}
+
+type CteContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCteContext() *CteContext {
+	var p = new(CteContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_cte
+	return p
+}
+
+func InitEmptyCteContext(p *CteContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_cte
+}
+
+func (*CteContext) IsCteContext() {}
+
+func NewCteContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CteContext {
+	var p = new(CteContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_cte
+
+	return p
+}
+
+func (s *CteContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CteContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
+
+func (s *CteContext) NAME() antlr.TerminalNode {
+	return s.GetToken(SLQParserNAME, 0)
+}
+
+func (s *CteContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, 0)
+}
+
+func (s *CteContext) Query() IQueryContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQueryContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQueryContext)
+}
+
+func (s *CteContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *CteContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CteContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CteContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterCte(s)
+	}
+}
+
+func (s *CteContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitCte(s)
+	}
+}
+
+func (s *CteContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitCte(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Cte() (localctx ICteContext) {
+	localctx = NewCteContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, SLQParserRULE_cte)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(229)
+		p.Match(SLQParserT__43)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(230)
+		p.Match(SLQParserLPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(231)
+		p.Match(SLQParserNAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(232)
+		p.Match(SLQParserCOMMA)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(233)
+		p.Query()
+	}
+	{
+		p.SetState(234)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IUniqueFuncContext is an interface to support dynamic dispatch.
+type IUniqueFuncContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LPAR() antlr.TerminalNode
+	AllSelector() []ISelectorContext
+	Selector(i int) ISelectorContext
+	RPAR() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsUniqueFuncContext differentiates from other interfaces.
+	IsUniqueFuncContext()
+}
+
+type UniqueFuncContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUniqueFuncContext() *UniqueFuncContext {
+	var p = new(UniqueFuncContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_uniqueFunc
+	return p
+}
+
+func InitEmptyUniqueFuncContext(p *UniqueFuncContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_uniqueFunc
+}
+
+func (*UniqueFuncContext) IsUniqueFuncContext() {}
+
+func NewUniqueFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UniqueFuncContext {
+	var p = new(UniqueFuncContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_uniqueFunc
+
+	return p
+}
+
+func (s *UniqueFuncContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *UniqueFuncContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
+
+func (s *UniqueFuncContext) AllSelector() []ISelectorContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISelectorContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISelectorContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISelectorContext); ok {
+			tst[i] = t.(ISelectorContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *UniqueFuncContext) Selector(i int) ISelectorContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectorContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectorContext)
+}
+
+func (s *UniqueFuncContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *UniqueFuncContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserCOMMA)
+}
+
+func (s *UniqueFuncContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, i)
+}
+
+func (s *UniqueFuncContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UniqueFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *UniqueFuncContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterUniqueFunc(s)
+	}
+}
+
+func (s *UniqueFuncContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitUniqueFunc(s)
+	}
+}
+
+func (s *UniqueFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitUniqueFunc(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) UniqueFunc() (localctx IUniqueFuncContext) {
+	localctx = NewUniqueFuncContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, SLQParserRULE_uniqueFunc)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(236)
+		p.Match(SLQParserT__44)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(248)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SLQParserLPAR {
+		{
+			p.SetState(237)
+			p.Match(SLQParserLPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(238)
+			p.Selector()
+		}
+		p.SetState(243)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == SLQParserCOMMA {
+			{
+				p.SetState(239)
+				p.Match(SLQParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(240)
+				p.Selector()
+			}
+
+			p.SetState(245)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(246)
+			p.Match(SLQParserRPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITopFuncContext is an interface to support dynamic dispatch.
+type ITopFuncContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LPAR() antlr.TerminalNode
+	NN() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+	AllSelector() []ISelectorContext
+	Selector(i int) ISelectorContext
+	RPAR() antlr.TerminalNode
+
+	// IsTopFuncContext differentiates from other interfaces.
+	IsTopFuncContext()
+}
+
+type TopFuncContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTopFuncContext() *TopFuncContext {
+	var p = new(TopFuncContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_topFunc
+	return p
+}
+
+func InitEmptyTopFuncContext(p *TopFuncContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_topFunc
+}
+
+func (*TopFuncContext) IsTopFuncContext() {}
+
+func NewTopFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TopFuncContext {
+	var p = new(TopFuncContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_topFunc
+
+	return p
+}
+
+func (s *TopFuncContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TopFuncContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
+
+func (s *TopFuncContext) NN() antlr.TerminalNode {
+	return s.GetToken(SLQParserNN, 0)
+}
+
+func (s *TopFuncContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserCOMMA)
+}
+
+func (s *TopFuncContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, i)
+}
+
+func (s *TopFuncContext) AllSelector() []ISelectorContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISelectorContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISelectorContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISelectorContext); ok {
+			tst[i] = t.(ISelectorContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TopFuncContext) Selector(i int) ISelectorContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectorContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectorContext)
+}
+
+func (s *TopFuncContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *TopFuncContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TopFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TopFuncContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterTopFunc(s)
+	}
+}
+
+func (s *TopFuncContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitTopFunc(s)
+	}
+}
+
+func (s *TopFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitTopFunc(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) TopFunc() (localctx ITopFuncContext) {
+	localctx = NewTopFuncContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, SLQParserRULE_topFunc)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(250)
+		p.Match(SLQParserT__45)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(251)
+		p.Match(SLQParserLPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(252)
+		p.Match(SLQParserNN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(253)
+		p.Match(SLQParserCOMMA)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(254)
+		p.Selector()
+	}
+	p.SetState(259)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SLQParserCOMMA {
+		{
+			p.SetState(255)
+			p.Match(SLQParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(256)
+			p.Selector()
+		}
+
+		p.SetState(261)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(262)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICountFuncContext is an interface to support dynamic dispatch.
+type ICountFuncContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LPAR() antlr.TerminalNode
+	RPAR() antlr.TerminalNode
+	Alias() IAliasContext
+	Selector() ISelectorContext
+
+	// IsCountFuncContext differentiates from other interfaces.
+	IsCountFuncContext()
+}
+
+type CountFuncContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCountFuncContext() *CountFuncContext {
+	var p = new(CountFuncContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_countFunc
+	return p
+}
+
+func InitEmptyCountFuncContext(p *CountFuncContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_countFunc
+}
+
+func (*CountFuncContext) IsCountFuncContext() {}
+
+func NewCountFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CountFuncContext {
+	var p = new(CountFuncContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_countFunc
+
+	return p
+}
+
+func (s *CountFuncContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CountFuncContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
+
+func (s *CountFuncContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *CountFuncContext) Alias() IAliasContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAliasContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAliasContext)
+}
+
+func (s *CountFuncContext) Selector() ISelectorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectorContext)
+}
+
+func (s *CountFuncContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CountFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CountFuncContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterCountFunc(s)
+	}
+}
+
+func (s *CountFuncContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitCountFunc(s)
+	}
+}
+
+func (s *CountFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitCountFunc(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) CountFunc() (localctx ICountFuncContext) {
+	localctx = NewCountFuncContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, SLQParserRULE_countFunc)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(264)
+		p.Match(SLQParserT__46)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(270)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 23, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(265)
+			p.Match(SLQParserLPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(267)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SLQParserNAME {
+			{
+				p.SetState(266)
+				p.Selector()
+			}
+
+		}
+		{
+			p.SetState(269)
+			p.Match(SLQParserRPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	p.SetState(273)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 24, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(272)
+			p.Alias()
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IWhereContext is an interface to support dynamic dispatch.
+type IWhereContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	WHERE() antlr.TerminalNode
+	LPAR() antlr.TerminalNode
+	RPAR() antlr.TerminalNode
+	Expr() IExprContext
+
+	// IsWhereContext differentiates from other interfaces.
+	IsWhereContext()
+}
+
+type WhereContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyWhereContext() *WhereContext {
+	var p = new(WhereContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_where
+	return p
+}
+
+func InitEmptyWhereContext(p *WhereContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_where
+}
+
+func (*WhereContext) IsWhereContext() {}
+
+func NewWhereContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WhereContext {
+	var p = new(WhereContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_where
+
+	return p
+}
+
+func (s *WhereContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *WhereContext) WHERE() antlr.TerminalNode {
+	return s.GetToken(SLQParserWHERE, 0)
+}
+
+func (s *WhereContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
+
+func (s *WhereContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *WhereContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *WhereContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WhereContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *WhereContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterWhere(s)
+	}
+}
+
+func (s *WhereContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitWhere(s)
+	}
+}
+
+func (s *WhereContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitWhere(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Where() (localctx IWhereContext) {
+	localctx = NewWhereContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, SLQParserRULE_where)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(275)
+		p.Match(SLQParserWHERE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(276)
+		p.Match(SLQParserLPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(278)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-5764465960912158728) != 0) || ((int64((_la-74)) & ^0x3f) == 0 && ((int64(1)<<(_la-74))&168166595) != 0) {
+		{
+			p.SetState(277)
+			p.expr(0)
+		}
+
+	}
+	{
+		p.SetState(280)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IGroupByTermContext is an interface to support dynamic dispatch.
+type IGroupByTermContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Selector() ISelectorContext
+	Func_() IFuncContext
+	Conditional() IConditionalContext
+	Grouping() IGroupingContext
+
+	// IsGroupByTermContext differentiates from other interfaces.
+	IsGroupByTermContext()
+}
+
+type GroupByTermContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGroupByTermContext() *GroupByTermContext {
+	var p = new(GroupByTermContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_groupByTerm
+	return p
+}
+
+func InitEmptyGroupByTermContext(p *GroupByTermContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_groupByTerm
+}
+
+func (*GroupByTermContext) IsGroupByTermContext() {}
+
+func NewGroupByTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByTermContext {
+	var p = new(GroupByTermContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_groupByTerm
+
+	return p
+}
+
+func (s *GroupByTermContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GroupByTermContext) Selector() ISelectorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectorContext)
+}
+
+func (s *GroupByTermContext) Func_() IFuncContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFuncContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFuncContext)
+}
+
+func (s *GroupByTermContext) Conditional() IConditionalContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConditionalContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConditionalContext)
+}
+
+func (s *GroupByTermContext) Grouping() IGroupingContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGroupingContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupingContext)
+}
+
+func (s *GroupByTermContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GroupByTermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GroupByTermContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterGroupByTerm(s)
+	}
+}
+
+func (s *GroupByTermContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitGroupByTerm(s)
+	}
+}
+
+func (s *GroupByTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitGroupByTerm(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) GroupByTerm() (localctx IGroupByTermContext) {
+	localctx = NewGroupByTermContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, SLQParserRULE_groupByTerm)
+	p.SetState(286)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case SLQParserNAME:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(282)
+			p.Selector()
+		}
+
+	case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__6, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserPROPRIETARY_FUNC_NAME:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(283)
+			p.Func_()
+		}
+
+	case SLQParserT__38:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(284)
+			p.Conditional()
+		}
+
+	case SLQParserT__47, SLQParserT__48, SLQParserT__49:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(285)
+			p.Grouping()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IGroupByContext is an interface to support dynamic dispatch.
+type IGroupByContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	GROUP_BY() antlr.TerminalNode
+	LPAR() antlr.TerminalNode
+	AllGroupByTerm() []IGroupByTermContext
+	GroupByTerm(i int) IGroupByTermContext
+	RPAR() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsGroupByContext differentiates from other interfaces.
+	IsGroupByContext()
+}
+
+type GroupByContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGroupByContext() *GroupByContext {
+	var p = new(GroupByContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_groupBy
+	return p
+}
+
+func InitEmptyGroupByContext(p *GroupByContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_groupBy
+}
+
+func (*GroupByContext) IsGroupByContext() {}
+
+func NewGroupByContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByContext {
+	var p = new(GroupByContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_groupBy
+
+	return p
+}
+
+func (s *GroupByContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GroupByContext) GROUP_BY() antlr.TerminalNode {
+	return s.GetToken(SLQParserGROUP_BY, 0)
+}
+
+func (s *GroupByContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
+
+func (s *GroupByContext) AllGroupByTerm() []IGroupByTermContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IGroupByTermContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IGroupByTermContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IGroupByTermContext); ok {
+			tst[i] = t.(IGroupByTermContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *GroupByContext) GroupByTerm(i int) IGroupByTermContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGroupByTermContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupByTermContext)
+}
+
+func (s *GroupByContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *GroupByContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserCOMMA)
+}
+
+func (s *GroupByContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, i)
+}
+
+func (s *GroupByContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GroupByContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GroupByContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterGroupBy(s)
+	}
+}
+
+func (s *GroupByContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitGroupBy(s)
+	}
+}
+
+func (s *GroupByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitGroupBy(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) GroupBy() (localctx IGroupByContext) {
+	localctx = NewGroupByContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, SLQParserRULE_groupBy)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(288)
+		p.Match(SLQParserGROUP_BY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(289)
+		p.Match(SLQParserLPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(290)
+		p.GroupByTerm()
+	}
+	p.SetState(295)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SLQParserCOMMA {
+		{
+			p.SetState(291)
+			p.Match(SLQParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(292)
+			p.GroupByTerm()
+		}
+
+		p.SetState(297)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(298)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IGroupingContext is an interface to support dynamic dispatch.
+type IGroupingContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LPAR() antlr.TerminalNode
+	AllGroupingTerm() []IGroupingTermContext
+	GroupingTerm(i int) IGroupingTermContext
+	RPAR() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+	AllGroupingSet() []IGroupingSetContext
+	GroupingSet(i int) IGroupingSetContext
+
+	// IsGroupingContext differentiates from other interfaces.
+	IsGroupingContext()
+}
+
+type GroupingContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGroupingContext() *GroupingContext {
+	var p = new(GroupingContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_grouping
+	return p
+}
+
+func InitEmptyGroupingContext(p *GroupingContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_grouping
+}
+
+func (*GroupingContext) IsGroupingContext() {}
+
+func NewGroupingContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupingContext {
+	var p = new(GroupingContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_grouping
+
+	return p
+}
+
+func (s *GroupingContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GroupingContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
+
+func (s *GroupingContext) AllGroupingTerm() []IGroupingTermContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IGroupingTermContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IGroupingTermContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IGroupingTermContext); ok {
+			tst[i] = t.(IGroupingTermContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *GroupingContext) GroupingTerm(i int) IGroupingTermContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGroupingTermContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupingTermContext)
+}
+
+func (s *GroupingContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *GroupingContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserCOMMA)
+}
+
+func (s *GroupingContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, i)
+}
+
+func (s *GroupingContext) AllGroupingSet() []IGroupingSetContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IGroupingSetContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IGroupingSetContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IGroupingSetContext); ok {
+			tst[i] = t.(IGroupingSetContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *GroupingContext) GroupingSet(i int) IGroupingSetContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGroupingSetContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupingSetContext)
+}
+
+func (s *GroupingContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GroupingContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GroupingContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterGrouping(s)
+	}
+}
+
+func (s *GroupingContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitGrouping(s)
+	}
+}
+
+func (s *GroupingContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitGrouping(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Grouping() (localctx IGroupingContext) {
+	localctx = NewGroupingContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, SLQParserRULE_grouping)
+	var _la int
+
+	p.SetState(324)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&352670930829432) != 0 {
+	switch p.GetTokenStream().LA(1) {
+	case SLQParserT__47, SLQParserT__48:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(160)
-			p.expr(0)
+			p.SetState(300)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SLQParserT__47 || _la == SLQParserT__48) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(301)
+			p.Match(SLQParserLPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(302)
+			p.GroupingTerm()
 		}
+		p.SetState(307)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-	}
-	{
-		p.SetState(163)
-		p.Match(SLQParserRPAR)
+		for _la == SLQParserCOMMA {
+			{
+				p.SetState(303)
+				p.Match(SLQParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(304)
+				p.GroupingTerm()
+			}
+
+			p.SetState(309)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(310)
+			p.Match(SLQParserRPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SLQParserT__49:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(312)
+			p.Match(SLQParserT__49)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(313)
+			p.Match(SLQParserLPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(314)
+			p.GroupingSet()
+		}
+		p.SetState(319)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == SLQParserCOMMA {
+			{
+				p.SetState(315)
+				p.Match(SLQParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(316)
+				p.GroupingSet()
+			}
+
+			p.SetState(321)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(322)
+			p.Match(SLQParserRPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -2629,8 +5155,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IGroupByTermContext is an interface to support dynamic dispatch.
-type IGroupByTermContext interface {
+// IGroupingTermContext is an interface to support dynamic dispatch.
+type IGroupingTermContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -2639,44 +5165,45 @@ type IGroupByTermContext interface {
 	// Getter signatures
 	Selector() ISelectorContext
 	Func_() IFuncContext
+	Conditional() IConditionalContext
 
-	// IsGroupByTermContext differentiates from other interfaces.
-	IsGroupByTermContext()
+	// IsGroupingTermContext differentiates from other interfaces.
+	IsGroupingTermContext()
 }
 
-type GroupByTermContext struct {
+type GroupingTermContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyGroupByTermContext() *GroupByTermContext {
-	var p = new(GroupByTermContext)
+func NewEmptyGroupingTermContext() *GroupingTermContext {
+	var p = new(GroupingTermContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_groupByTerm
+	p.RuleIndex = SLQParserRULE_groupingTerm
 	return p
 }
 
-func InitEmptyGroupByTermContext(p *GroupByTermContext) {
+func InitEmptyGroupingTermContext(p *GroupingTermContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_groupByTerm
+	p.RuleIndex = SLQParserRULE_groupingTerm
 }
 
-func (*GroupByTermContext) IsGroupByTermContext() {}
+func (*GroupingTermContext) IsGroupingTermContext() {}
 
-func NewGroupByTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByTermContext {
-	var p = new(GroupByTermContext)
+func NewGroupingTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupingTermContext {
+	var p = new(GroupingTermContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_groupByTerm
+	p.RuleIndex = SLQParserRULE_groupingTerm
 
 	return p
 }
 
-func (s *GroupByTermContext) GetParser() antlr.Parser { return s.parser }
+func (s *GroupingTermContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *GroupByTermContext) Selector() ISelectorContext {
+func (s *GroupingTermContext) Selector() ISelectorContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(ISelectorContext); ok {
@@ -2692,7 +5219,7 @@ func (s *GroupByTermContext) Selector() ISelectorContext {
 	return t.(ISelectorContext)
 }
 
-func (s *GroupByTermContext) Func_() IFuncContext {
+func (s *GroupingTermContext) Func_() IFuncContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IFuncContext); ok {
@@ -2708,40 +5235,56 @@ func (s *GroupByTermContext) Func_() IFuncContext {
 	return t.(IFuncContext)
 }
 
-func (s *GroupByTermContext) GetRuleContext() antlr.RuleContext {
+func (s *GroupingTermContext) Conditional() IConditionalContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConditionalContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConditionalContext)
+}
+
+func (s *GroupingTermContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GroupByTermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GroupingTermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GroupByTermContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GroupingTermContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterGroupByTerm(s)
+		listenerT.EnterGroupingTerm(s)
 	}
 }
 
-func (s *GroupByTermContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GroupingTermContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitGroupByTerm(s)
+		listenerT.ExitGroupingTerm(s)
 	}
 }
 
-func (s *GroupByTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GroupingTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitGroupByTerm(s)
+		return t.VisitGroupingTerm(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) GroupByTerm() (localctx IGroupByTermContext) {
-	localctx = NewGroupByTermContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, SLQParserRULE_groupByTerm)
-	p.SetState(167)
+func (p *SLQParser) GroupingTerm() (localctx IGroupingTermContext) {
+	localctx = NewGroupingTermContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, SLQParserRULE_groupingTerm)
+	p.SetState(329)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2751,17 +5294,24 @@ func (p *SLQParser) GroupByTerm() (localctx IGroupByTermContext) {
 	case SLQParserNAME:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(165)
+			p.SetState(326)
 			p.Selector()
 		}
 
-	case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserPROPRIETARY_FUNC_NAME:
+	case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__6, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserPROPRIETARY_FUNC_NAME:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(166)
+			p.SetState(327)
 			p.Func_()
 		}
 
+	case SLQParserT__38:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(328)
+			p.Conditional()
+		}
+
 	default:
 		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
@@ -2780,80 +5330,71 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IGroupByContext is an interface to support dynamic dispatch.
-type IGroupByContext interface {
+// IGroupingSetContext is an interface to support dynamic dispatch.
+type IGroupingSetContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	GROUP_BY() antlr.TerminalNode
+	AllGroupingTerm() []IGroupingTermContext
+	GroupingTerm(i int) IGroupingTermContext
 	LPAR() antlr.TerminalNode
-	AllGroupByTerm() []IGroupByTermContext
-	GroupByTerm(i int) IGroupByTermContext
 	RPAR() antlr.TerminalNode
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
 
-	// IsGroupByContext differentiates from other interfaces.
-	IsGroupByContext()
+	// IsGroupingSetContext differentiates from other interfaces.
+	IsGroupingSetContext()
 }
 
-type GroupByContext struct {
+type GroupingSetContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyGroupByContext() *GroupByContext {
-	var p = new(GroupByContext)
+func NewEmptyGroupingSetContext() *GroupingSetContext {
+	var p = new(GroupingSetContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_groupBy
+	p.RuleIndex = SLQParserRULE_groupingSet
 	return p
 }
 
-func InitEmptyGroupByContext(p *GroupByContext) {
+func InitEmptyGroupingSetContext(p *GroupingSetContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_groupBy
+	p.RuleIndex = SLQParserRULE_groupingSet
 }
 
-func (*GroupByContext) IsGroupByContext() {}
+func (*GroupingSetContext) IsGroupingSetContext() {}
 
-func NewGroupByContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByContext {
-	var p = new(GroupByContext)
+func NewGroupingSetContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupingSetContext {
+	var p = new(GroupingSetContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_groupBy
+	p.RuleIndex = SLQParserRULE_groupingSet
 
 	return p
 }
 
-func (s *GroupByContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *GroupByContext) GROUP_BY() antlr.TerminalNode {
-	return s.GetToken(SLQParserGROUP_BY, 0)
-}
-
-func (s *GroupByContext) LPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserLPAR, 0)
-}
+func (s *GroupingSetContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *GroupByContext) AllGroupByTerm() []IGroupByTermContext {
+func (s *GroupingSetContext) AllGroupingTerm() []IGroupingTermContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IGroupByTermContext); ok {
+		if _, ok := ctx.(IGroupingTermContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IGroupByTermContext, len)
+	tst := make([]IGroupingTermContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IGroupByTermContext); ok {
-			tst[i] = t.(IGroupByTermContext)
+		if t, ok := ctx.(IGroupingTermContext); ok {
+			tst[i] = t.(IGroupingTermContext)
 			i++
 		}
 	}
@@ -2861,11 +5402,11 @@ func (s *GroupByContext) AllGroupByTerm() []IGroupByTermContext {
 	return tst
 }
 
-func (s *GroupByContext) GroupByTerm(i int) IGroupByTermContext {
+func (s *GroupingSetContext) GroupingTerm(i int) IGroupingTermContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IGroupByTermContext); ok {
+		if _, ok := ctx.(IGroupingTermContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -2878,112 +5419,138 @@ func (s *GroupByContext) GroupByTerm(i int) IGroupByTermContext {
 		return nil
 	}
 
-	return t.(IGroupByTermContext)
+	return t.(IGroupingTermContext)
 }
 
-func (s *GroupByContext) RPAR() antlr.TerminalNode {
+func (s *GroupingSetContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
+
+func (s *GroupingSetContext) RPAR() antlr.TerminalNode {
 	return s.GetToken(SLQParserRPAR, 0)
 }
 
-func (s *GroupByContext) AllCOMMA() []antlr.TerminalNode {
+func (s *GroupingSetContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(SLQParserCOMMA)
 }
 
-func (s *GroupByContext) COMMA(i int) antlr.TerminalNode {
+func (s *GroupingSetContext) COMMA(i int) antlr.TerminalNode {
 	return s.GetToken(SLQParserCOMMA, i)
 }
 
-func (s *GroupByContext) GetRuleContext() antlr.RuleContext {
+func (s *GroupingSetContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GroupByContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GroupingSetContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GroupByContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GroupingSetContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterGroupBy(s)
+		listenerT.EnterGroupingSet(s)
 	}
 }
 
-func (s *GroupByContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GroupingSetContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitGroupBy(s)
+		listenerT.ExitGroupingSet(s)
 	}
 }
 
-func (s *GroupByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GroupingSetContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitGroupBy(s)
+		return t.VisitGroupingSet(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) GroupBy() (localctx IGroupByContext) {
-	localctx = NewGroupByContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, SLQParserRULE_groupBy)
+func (p *SLQParser) GroupingSet() (localctx IGroupingSetContext) {
+	localctx = NewGroupingSetContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, SLQParserRULE_groupingSet)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(169)
-		p.Match(SLQParserGROUP_BY)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(170)
-		p.Match(SLQParserLPAR)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(171)
-		p.GroupByTerm()
-	}
-	p.SetState(176)
+	p.SetState(344)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == SLQParserCOMMA {
+	switch p.GetTokenStream().LA(1) {
+	case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__6, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserT__38, SLQParserPROPRIETARY_FUNC_NAME, SLQParserNAME:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(331)
+			p.GroupingTerm()
+		}
+
+	case SLQParserLPAR:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(332)
+			p.Match(SLQParserLPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(341)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-9223371212221054984) != 0) || _la == SLQParserNAME {
+			{
+				p.SetState(333)
+				p.GroupingTerm()
+			}
+			p.SetState(338)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == SLQParserCOMMA {
+				{
+					p.SetState(334)
+					p.Match(SLQParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(335)
+					p.GroupingTerm()
+				}
+
+				p.SetState(340)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		}
 		{
-			p.SetState(172)
-			p.Match(SLQParserCOMMA)
+			p.SetState(343)
+			p.Match(SLQParserRPAR)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		{
-			p.SetState(173)
-			p.GroupByTerm()
-		}
 
-		p.SetState(178)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(179)
-		p.Match(SLQParserRPAR)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -3007,9 +5574,11 @@ type IOrderByTermContext interface {
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Selector() ISelectorContext
+	Expr() IExprContext
 	ORDER_ASC() antlr.TerminalNode
 	ORDER_DESC() antlr.TerminalNode
+	NULLS_FIRST() antlr.TerminalNode
+	NULLS_LAST() antlr.TerminalNode
 
 	// IsOrderByTermContext differentiates from other interfaces.
 	IsOrderByTermContext()
@@ -3047,10 +5616,10 @@ func NewOrderByTermContext(parser antlr.Parser, parent antlr.ParserRuleContext,
 
 func (s *OrderByTermContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OrderByTermContext) Selector() ISelectorContext {
+func (s *OrderByTermContext) Expr() IExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISelectorContext); ok {
+		if _, ok := ctx.(IExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3060,7 +5629,7 @@ func (s *OrderByTermContext) Selector() ISelectorContext {
 		return nil
 	}
 
-	return t.(ISelectorContext)
+	return t.(IExprContext)
 }
 
 func (s *OrderByTermContext) ORDER_ASC() antlr.TerminalNode {
@@ -3071,6 +5640,14 @@ func (s *OrderByTermContext) ORDER_DESC() antlr.TerminalNode {
 	return s.GetToken(SLQParserORDER_DESC, 0)
 }
 
+func (s *OrderByTermContext) NULLS_FIRST() antlr.TerminalNode {
+	return s.GetToken(SLQParserNULLS_FIRST, 0)
+}
+
+func (s *OrderByTermContext) NULLS_LAST() antlr.TerminalNode {
+	return s.GetToken(SLQParserNULLS_LAST, 0)
+}
+
 func (s *OrderByTermContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -3103,15 +5680,15 @@ func (s *OrderByTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SLQParser) OrderByTerm() (localctx IOrderByTermContext) {
 	localctx = NewOrderByTermContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, SLQParserRULE_orderByTerm)
+	p.EnterRule(localctx, 48, SLQParserRULE_orderByTerm)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(181)
-		p.Selector()
+		p.SetState(346)
+		p.expr(0)
 	}
-	p.SetState(183)
+	p.SetState(348)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3120,7 +5697,7 @@ func (p *SLQParser) OrderByTerm() (localctx IOrderByTermContext) {
 
 	if _la == SLQParserORDER_ASC || _la == SLQParserORDER_DESC {
 		{
-			p.SetState(182)
+			p.SetState(347)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SLQParserORDER_ASC || _la == SLQParserORDER_DESC) {
@@ -3132,6 +5709,27 @@ func (p *SLQParser) OrderByTerm() (localctx IOrderByTermContext) {
 		}
 
 	}
+	p.SetState(351)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SLQParserNULLS_FIRST || _la == SLQParserNULLS_LAST {
+		{
+			p.SetState(350)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SLQParserNULLS_FIRST || _la == SLQParserNULLS_LAST) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	}
 
 errorExit:
 	if p.HasError() {
@@ -3291,12 +5889,12 @@ func (s *OrderByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
 	localctx = NewOrderByContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, SLQParserRULE_orderBy)
+	p.EnterRule(localctx, 50, SLQParserRULE_orderBy)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(185)
+		p.SetState(353)
 		p.Match(SLQParserORDER_BY)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3304,7 +5902,7 @@ func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
 		}
 	}
 	{
-		p.SetState(186)
+		p.SetState(354)
 		p.Match(SLQParserLPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3312,10 +5910,10 @@ func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
 		}
 	}
 	{
-		p.SetState(187)
+		p.SetState(355)
 		p.OrderByTerm()
 	}
-	p.SetState(192)
+	p.SetState(360)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3324,7 +5922,7 @@ func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
 
 	for _la == SLQParserCOMMA {
 		{
-			p.SetState(188)
+			p.SetState(356)
 			p.Match(SLQParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3332,11 +5930,11 @@ func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
 			}
 		}
 		{
-			p.SetState(189)
+			p.SetState(357)
 			p.OrderByTerm()
 		}
 
-		p.SetState(194)
+		p.SetState(362)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -3344,7 +5942,7 @@ func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(195)
+		p.SetState(363)
 		p.Match(SLQParserRPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3452,22 +6050,22 @@ func (s *SelectorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SLQParser) Selector() (localctx ISelectorContext) {
 	localctx = NewSelectorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, SLQParserRULE_selector)
+	p.EnterRule(localctx, 52, SLQParserRULE_selector)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(197)
+		p.SetState(365)
 		p.Match(SLQParserNAME)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(199)
+	p.SetState(367)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 38, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(198)
+			p.SetState(366)
 			p.Match(SLQParserNAME)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3603,16 +6201,16 @@ func (s *SelectorElementContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *SLQParser) SelectorElement() (localctx ISelectorElementContext) {
 	localctx = NewSelectorElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, SLQParserRULE_selectorElement)
+	p.EnterRule(localctx, 54, SLQParserRULE_selectorElement)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(201)
+		p.SetState(369)
 		p.Selector()
 	}
 
-	p.SetState(203)
+	p.SetState(371)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3621,7 +6219,7 @@ func (p *SLQParser) SelectorElement() (localctx ISelectorElementContext) {
 
 	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
 		{
-			p.SetState(202)
+			p.SetState(370)
 			p.Alias()
 		}
 
@@ -3653,6 +6251,8 @@ type IAliasContext interface {
 	ARG() antlr.TerminalNode
 	ID() antlr.TerminalNode
 	STRING() antlr.TerminalNode
+	FuncName() IFuncNameContext
+	AliasKeyword() IAliasKeywordContext
 
 	// IsAliasContext differentiates from other interfaces.
 	IsAliasContext()
@@ -3666,128 +6266,356 @@ type AliasContext struct {
 func NewEmptyAliasContext() *AliasContext {
 	var p = new(AliasContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_alias
+	p.RuleIndex = SLQParserRULE_alias
+	return p
+}
+
+func InitEmptyAliasContext(p *AliasContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_alias
+}
+
+func (*AliasContext) IsAliasContext() {}
+
+func NewAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasContext {
+	var p = new(AliasContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_alias
+
+	return p
+}
+
+func (s *AliasContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AliasContext) ALIAS_RESERVED() antlr.TerminalNode {
+	return s.GetToken(SLQParserALIAS_RESERVED, 0)
+}
+
+func (s *AliasContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SLQParserCOLON, 0)
+}
+
+func (s *AliasContext) ARG() antlr.TerminalNode {
+	return s.GetToken(SLQParserARG, 0)
+}
+
+func (s *AliasContext) ID() antlr.TerminalNode {
+	return s.GetToken(SLQParserID, 0)
+}
+
+func (s *AliasContext) STRING() antlr.TerminalNode {
+	return s.GetToken(SLQParserSTRING, 0)
+}
+
+func (s *AliasContext) FuncName() IFuncNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFuncNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFuncNameContext)
+}
+
+func (s *AliasContext) AliasKeyword() IAliasKeywordContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAliasKeywordContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAliasKeywordContext)
+}
+
+func (s *AliasContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AliasContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterAlias(s)
+	}
+}
+
+func (s *AliasContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitAlias(s)
+	}
+}
+
+func (s *AliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitAlias(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Alias() (localctx IAliasContext) {
+	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, SLQParserRULE_alias)
+	p.SetState(382)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case SLQParserALIAS_RESERVED:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(373)
+			p.Match(SLQParserALIAS_RESERVED)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SLQParserCOLON:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(374)
+			p.Match(SLQParserCOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(380)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case SLQParserARG:
+			{
+				p.SetState(375)
+				p.Match(SLQParserARG)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SLQParserID:
+			{
+				p.SetState(376)
+				p.Match(SLQParserID)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SLQParserSTRING:
+			{
+				p.SetState(377)
+				p.Match(SLQParserSTRING)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__6, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserPROPRIETARY_FUNC_NAME:
+			{
+				p.SetState(378)
+				p.FuncName()
+			}
+
+		case SLQParserT__37, SLQParserT__38, SLQParserT__39, SLQParserT__40, SLQParserT__41, SLQParserT__42, SLQParserT__43, SLQParserPARTITION_BY, SLQParserSET_OP, SLQParserIN, SLQParserNOT, SLQParserBETWEEN, SLQParserAND, SLQParserLIKE, SLQParserIS, SLQParserNULLS_FIRST, SLQParserNULLS_LAST:
+			{
+				p.SetState(379)
+				p.AliasKeyword()
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAliasKeywordContext is an interface to support dynamic dispatch.
+type IAliasKeywordContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	IN() antlr.TerminalNode
+	NOT() antlr.TerminalNode
+	BETWEEN() antlr.TerminalNode
+	AND() antlr.TerminalNode
+	LIKE() antlr.TerminalNode
+	IS() antlr.TerminalNode
+	NULLS_FIRST() antlr.TerminalNode
+	NULLS_LAST() antlr.TerminalNode
+	SET_OP() antlr.TerminalNode
+	PARTITION_BY() antlr.TerminalNode
+
+	// IsAliasKeywordContext differentiates from other interfaces.
+	IsAliasKeywordContext()
+}
+
+type AliasKeywordContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAliasKeywordContext() *AliasKeywordContext {
+	var p = new(AliasKeywordContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_aliasKeyword
 	return p
 }
 
-func InitEmptyAliasContext(p *AliasContext) {
+func InitEmptyAliasKeywordContext(p *AliasKeywordContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_alias
+	p.RuleIndex = SLQParserRULE_aliasKeyword
 }
 
-func (*AliasContext) IsAliasContext() {}
+func (*AliasKeywordContext) IsAliasKeywordContext() {}
 
-func NewAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasContext {
-	var p = new(AliasContext)
+func NewAliasKeywordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasKeywordContext {
+	var p = new(AliasKeywordContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_alias
+	p.RuleIndex = SLQParserRULE_aliasKeyword
 
 	return p
 }
 
-func (s *AliasContext) GetParser() antlr.Parser { return s.parser }
+func (s *AliasKeywordContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AliasContext) ALIAS_RESERVED() antlr.TerminalNode {
-	return s.GetToken(SLQParserALIAS_RESERVED, 0)
+func (s *AliasKeywordContext) IN() antlr.TerminalNode {
+	return s.GetToken(SLQParserIN, 0)
 }
 
-func (s *AliasContext) COLON() antlr.TerminalNode {
-	return s.GetToken(SLQParserCOLON, 0)
+func (s *AliasKeywordContext) NOT() antlr.TerminalNode {
+	return s.GetToken(SLQParserNOT, 0)
 }
 
-func (s *AliasContext) ARG() antlr.TerminalNode {
-	return s.GetToken(SLQParserARG, 0)
+func (s *AliasKeywordContext) BETWEEN() antlr.TerminalNode {
+	return s.GetToken(SLQParserBETWEEN, 0)
 }
 
-func (s *AliasContext) ID() antlr.TerminalNode {
-	return s.GetToken(SLQParserID, 0)
+func (s *AliasKeywordContext) AND() antlr.TerminalNode {
+	return s.GetToken(SLQParserAND, 0)
 }
 
-func (s *AliasContext) STRING() antlr.TerminalNode {
-	return s.GetToken(SLQParserSTRING, 0)
+func (s *AliasKeywordContext) LIKE() antlr.TerminalNode {
+	return s.GetToken(SLQParserLIKE, 0)
 }
 
-func (s *AliasContext) GetRuleContext() antlr.RuleContext {
+func (s *AliasKeywordContext) IS() antlr.TerminalNode {
+	return s.GetToken(SLQParserIS, 0)
+}
+
+func (s *AliasKeywordContext) NULLS_FIRST() antlr.TerminalNode {
+	return s.GetToken(SLQParserNULLS_FIRST, 0)
+}
+
+func (s *AliasKeywordContext) NULLS_LAST() antlr.TerminalNode {
+	return s.GetToken(SLQParserNULLS_LAST, 0)
+}
+
+func (s *AliasKeywordContext) SET_OP() antlr.TerminalNode {
+	return s.GetToken(SLQParserSET_OP, 0)
+}
+
+func (s *AliasKeywordContext) PARTITION_BY() antlr.TerminalNode {
+	return s.GetToken(SLQParserPARTITION_BY, 0)
+}
+
+func (s *AliasKeywordContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AliasKeywordContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AliasContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AliasKeywordContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterAlias(s)
+		listenerT.EnterAliasKeyword(s)
 	}
 }
 
-func (s *AliasContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AliasKeywordContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitAlias(s)
+		listenerT.ExitAliasKeyword(s)
 	}
 }
 
-func (s *AliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AliasKeywordContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitAlias(s)
+		return t.VisitAliasKeyword(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) Alias() (localctx IAliasContext) {
-	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, SLQParserRULE_alias)
+func (p *SLQParser) AliasKeyword() (localctx IAliasKeywordContext) {
+	localctx = NewAliasKeywordContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SLQParserRULE_aliasKeyword)
 	var _la int
 
-	p.SetState(208)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetTokenStream().LA(1) {
-	case SLQParserALIAS_RESERVED:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(205)
-			p.Match(SLQParserALIAS_RESERVED)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	case SLQParserCOLON:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(206)
-			p.Match(SLQParserCOLON)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(207)
-			_la = p.GetTokenStream().LA(1)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(384)
+		_la = p.GetTokenStream().LA(1)
 
-			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&281475647799296) != 0) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
+		if !((int64((_la-38)) & ^0x3f) == 0 && ((int64(1)<<(_la-38))&1666329870463) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -3885,10 +6713,10 @@ func (s *ArgContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SLQParser) Arg() (localctx IArgContext) {
 	localctx = NewArgContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, SLQParserRULE_arg)
+	p.EnterRule(localctx, 60, SLQParserRULE_arg)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(210)
+		p.SetState(386)
 		p.Match(SLQParserARG)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3996,10 +6824,10 @@ func (s *HandleTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SLQParser) HandleTable() (localctx IHandleTableContext) {
 	localctx = NewHandleTableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, SLQParserRULE_handleTable)
+	p.EnterRule(localctx, 62, SLQParserRULE_handleTable)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(212)
+		p.SetState(388)
 		p.Match(SLQParserHANDLE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4007,7 +6835,7 @@ func (p *SLQParser) HandleTable() (localctx IHandleTableContext) {
 		}
 	}
 	{
-		p.SetState(213)
+		p.SetState(389)
 		p.Match(SLQParserNAME)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4110,10 +6938,10 @@ func (s *HandleContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SLQParser) Handle() (localctx IHandleContext) {
 	localctx = NewHandleContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, SLQParserRULE_handle)
+	p.EnterRule(localctx, 64, SLQParserRULE_handle)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(215)
+		p.SetState(391)
 		p.Match(SLQParserHANDLE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4143,8 +6971,8 @@ type IRowRangeContext interface {
 
 	// Getter signatures
 	RBRA() antlr.TerminalNode
-	AllNN() []antlr.TerminalNode
-	NN(i int) antlr.TerminalNode
+	AllRowRangeIndex() []IRowRangeIndexContext
+	RowRangeIndex(i int) IRowRangeIndexContext
 	COLON() antlr.TerminalNode
 
 	// IsRowRangeContext differentiates from other interfaces.
@@ -4187,12 +7015,45 @@ func (s *RowRangeContext) RBRA() antlr.TerminalNode {
 	return s.GetToken(SLQParserRBRA, 0)
 }
 
-func (s *RowRangeContext) AllNN() []antlr.TerminalNode {
-	return s.GetTokens(SLQParserNN)
+func (s *RowRangeContext) AllRowRangeIndex() []IRowRangeIndexContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IRowRangeIndexContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IRowRangeIndexContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IRowRangeIndexContext); ok {
+			tst[i] = t.(IRowRangeIndexContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *RowRangeContext) NN(i int) antlr.TerminalNode {
-	return s.GetToken(SLQParserNN, i)
+func (s *RowRangeContext) RowRangeIndex(i int) IRowRangeIndexContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRowRangeIndexContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRowRangeIndexContext)
 }
 
 func (s *RowRangeContext) COLON() antlr.TerminalNode {
@@ -4231,30 +7092,26 @@ func (s *RowRangeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SLQParser) RowRange() (localctx IRowRangeContext) {
 	localctx = NewRowRangeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, SLQParserRULE_rowRange)
+	p.EnterRule(localctx, 66, SLQParserRULE_rowRange)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(217)
-		p.Match(SLQParserT__8)
+		p.SetState(393)
+		p.Match(SLQParserT__50)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(226)
+	p.SetState(404)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 24, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(218)
-			p.Match(SLQParserNN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(394)
+			p.RowRangeIndex()
 		}
 		{
-			p.SetState(219)
+			p.SetState(395)
 			p.Match(SLQParserCOLON)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4262,27 +7119,19 @@ func (p *SLQParser) RowRange() (localctx IRowRangeContext) {
 			}
 		}
 		{
-			p.SetState(220)
-			p.Match(SLQParserNN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(396)
+			p.RowRangeIndex()
 		}
 
 	} else if p.HasError() { // JIM
 		goto errorExit
-	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 24, p.GetParserRuleContext()) == 2 {
+	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext()) == 2 {
 		{
-			p.SetState(221)
-			p.Match(SLQParserNN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(398)
+			p.RowRangeIndex()
 		}
 		{
-			p.SetState(222)
+			p.SetState(399)
 			p.Match(SLQParserCOLON)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4292,9 +7141,9 @@ func (p *SLQParser) RowRange() (localctx IRowRangeContext) {
 
 	} else if p.HasError() { // JIM
 		goto errorExit
-	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 24, p.GetParserRuleContext()) == 3 {
+	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext()) == 3 {
 		{
-			p.SetState(223)
+			p.SetState(401)
 			p.Match(SLQParserCOLON)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4302,31 +7151,23 @@ func (p *SLQParser) RowRange() (localctx IRowRangeContext) {
 			}
 		}
 		{
-			p.SetState(224)
-			p.Match(SLQParserNN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(402)
+			p.RowRangeIndex()
 		}
 
 	} else if p.HasError() { // JIM
 		goto errorExit
-	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 24, p.GetParserRuleContext()) == 4 {
+	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext()) == 4 {
 		{
-			p.SetState(225)
-			p.Match(SLQParserNN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(403)
+			p.RowRangeIndex()
 		}
 
 	} else if p.HasError() { // JIM
 		goto errorExit
 	}
 	{
-		p.SetState(228)
+		p.SetState(406)
 		p.Match(SLQParserRBRA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4347,6 +7188,122 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
+// IRowRangeIndexContext is an interface to support dynamic dispatch.
+type IRowRangeIndexContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	NN() antlr.TerminalNode
+	NUMBER() antlr.TerminalNode
+
+	// IsRowRangeIndexContext differentiates from other interfaces.
+	IsRowRangeIndexContext()
+}
+
+type RowRangeIndexContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRowRangeIndexContext() *RowRangeIndexContext {
+	var p = new(RowRangeIndexContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_rowRangeIndex
+	return p
+}
+
+func InitEmptyRowRangeIndexContext(p *RowRangeIndexContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_rowRangeIndex
+}
+
+func (*RowRangeIndexContext) IsRowRangeIndexContext() {}
+
+func NewRowRangeIndexContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RowRangeIndexContext {
+	var p = new(RowRangeIndexContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_rowRangeIndex
+
+	return p
+}
+
+func (s *RowRangeIndexContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RowRangeIndexContext) NN() antlr.TerminalNode {
+	return s.GetToken(SLQParserNN, 0)
+}
+
+func (s *RowRangeIndexContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(SLQParserNUMBER, 0)
+}
+
+func (s *RowRangeIndexContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RowRangeIndexContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RowRangeIndexContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterRowRangeIndex(s)
+	}
+}
+
+func (s *RowRangeIndexContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitRowRangeIndex(s)
+	}
+}
+
+func (s *RowRangeIndexContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitRowRangeIndex(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) RowRangeIndex() (localctx IRowRangeIndexContext) {
+	localctx = NewRowRangeIndexContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, SLQParserRULE_rowRangeIndex)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(408)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SLQParserNN || _la == SLQParserNUMBER) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
 // IExprElementContext is an interface to support dynamic dispatch.
 type IExprElementContext interface {
 	antlr.ParserRuleContext
@@ -4458,15 +7415,15 @@ func (s *ExprElementContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SLQParser) ExprElement() (localctx IExprElementContext) {
 	localctx = NewExprElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, SLQParserRULE_exprElement)
+	p.EnterRule(localctx, 70, SLQParserRULE_exprElement)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(230)
+		p.SetState(410)
 		p.expr(0)
 	}
-	p.SetState(232)
+	p.SetState(412)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4475,7 +7432,7 @@ func (p *SLQParser) ExprElement() (localctx IExprElementContext) {
 
 	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
 		{
-			p.SetState(231)
+			p.SetState(411)
 			p.Alias()
 		}
 
@@ -4509,8 +7466,11 @@ type IExprContext interface {
 	Selector() ISelectorContext
 	Literal() ILiteralContext
 	Arg() IArgContext
+	Subquery() ISubqueryContext
+	Conditional() IConditionalContext
 	UnaryOperator() IUnaryOperatorContext
 	Func_() IFuncContext
+	CountFunc() ICountFuncContext
 	ORDER_ASC() antlr.TerminalNode
 	ORDER_DESC() antlr.TerminalNode
 	LT() antlr.TerminalNode
@@ -4519,6 +7479,13 @@ type IExprContext interface {
 	GT_EQ() antlr.TerminalNode
 	EQ() antlr.TerminalNode
 	NEQ() antlr.TerminalNode
+	BETWEEN() antlr.TerminalNode
+	AND() antlr.TerminalNode
+	NOT() antlr.TerminalNode
+	LIKE() antlr.TerminalNode
+	IS() antlr.TerminalNode
+	IN() antlr.TerminalNode
+	List() IListContext
 
 	// IsExprContext differentiates from other interfaces.
 	IsExprContext()
@@ -4618,13 +7585,45 @@ func (s *ExprContext) Selector() ISelectorContext {
 		return nil
 	}
 
-	return t.(ISelectorContext)
+	return t.(ISelectorContext)
+}
+
+func (s *ExprContext) Literal() ILiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILiteralContext)
+}
+
+func (s *ExprContext) Arg() IArgContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArgContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgContext)
 }
 
-func (s *ExprContext) Literal() ILiteralContext {
+func (s *ExprContext) Subquery() ISubqueryContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILiteralContext); ok {
+		if _, ok := ctx.(ISubqueryContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4634,13 +7633,13 @@ func (s *ExprContext) Literal() ILiteralContext {
 		return nil
 	}
 
-	return t.(ILiteralContext)
+	return t.(ISubqueryContext)
 }
 
-func (s *ExprContext) Arg() IArgContext {
+func (s *ExprContext) Conditional() IConditionalContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IArgContext); ok {
+		if _, ok := ctx.(IConditionalContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4650,7 +7649,7 @@ func (s *ExprContext) Arg() IArgContext {
 		return nil
 	}
 
-	return t.(IArgContext)
+	return t.(IConditionalContext)
 }
 
 func (s *ExprContext) UnaryOperator() IUnaryOperatorContext {
@@ -4685,6 +7684,22 @@ func (s *ExprContext) Func_() IFuncContext {
 	return t.(IFuncContext)
 }
 
+func (s *ExprContext) CountFunc() ICountFuncContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICountFuncContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICountFuncContext)
+}
+
 func (s *ExprContext) ORDER_ASC() antlr.TerminalNode {
 	return s.GetToken(SLQParserORDER_ASC, 0)
 }
@@ -4717,6 +7732,46 @@ func (s *ExprContext) NEQ() antlr.TerminalNode {
 	return s.GetToken(SLQParserNEQ, 0)
 }
 
+func (s *ExprContext) BETWEEN() antlr.TerminalNode {
+	return s.GetToken(SLQParserBETWEEN, 0)
+}
+
+func (s *ExprContext) AND() antlr.TerminalNode {
+	return s.GetToken(SLQParserAND, 0)
+}
+
+func (s *ExprContext) NOT() antlr.TerminalNode {
+	return s.GetToken(SLQParserNOT, 0)
+}
+
+func (s *ExprContext) LIKE() antlr.TerminalNode {
+	return s.GetToken(SLQParserLIKE, 0)
+}
+
+func (s *ExprContext) IS() antlr.TerminalNode {
+	return s.GetToken(SLQParserIS, 0)
+}
+
+func (s *ExprContext) IN() antlr.TerminalNode {
+	return s.GetToken(SLQParserIN, 0)
+}
+
+func (s *ExprContext) List() IListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IListContext)
+}
+
 func (s *ExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -4758,23 +7813,23 @@ func (p *SLQParser) expr(_p int) (localctx IExprContext) {
 	localctx = NewExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 48
-	p.EnterRecursionRule(localctx, 48, SLQParserRULE_expr, _p)
+	_startState := 72
+	p.EnterRecursionRule(localctx, 72, SLQParserRULE_expr, _p)
 	var _la int
 
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(246)
+	p.SetState(429)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetTokenStream().LA(1) {
-	case SLQParserLPAR:
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 44, p.GetParserRuleContext()) {
+	case 1:
 		{
-			p.SetState(235)
+			p.SetState(415)
 			p.Match(SLQParserLPAR)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4782,11 +7837,11 @@ func (p *SLQParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(236)
+			p.SetState(416)
 			p.expr(0)
 		}
 		{
-			p.SetState(237)
+			p.SetState(417)
 			p.Match(SLQParserRPAR)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4794,51 +7849,68 @@ func (p *SLQParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 
-	case SLQParserNAME:
+	case 2:
 		{
-			p.SetState(239)
+			p.SetState(419)
 			p.Selector()
 		}
 
-	case SLQParserNULL, SLQParserNN, SLQParserNUMBER, SLQParserSTRING:
+	case 3:
 		{
-			p.SetState(240)
+			p.SetState(420)
 			p.Literal()
 		}
 
-	case SLQParserARG:
+	case 4:
 		{
-			p.SetState(241)
+			p.SetState(421)
 			p.Arg()
 		}
 
-	case SLQParserT__16, SLQParserT__17, SLQParserORDER_ASC, SLQParserORDER_DESC:
+	case 5:
+		{
+			p.SetState(422)
+			p.Subquery()
+		}
+
+	case 6:
+		{
+			p.SetState(423)
+			p.Conditional()
+		}
+
+	case 7:
 		{
-			p.SetState(242)
+			p.SetState(424)
 			p.UnaryOperator()
 		}
 		{
-			p.SetState(243)
-			p.expr(9)
+			p.SetState(425)
+			p.expr(15)
 		}
 
-	case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserPROPRIETARY_FUNC_NAME:
+	case 8:
 		{
-			p.SetState(245)
+			p.SetState(427)
 			p.Func_()
 		}
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case 9:
+		{
+			p.SetState(428)
+			p.CountFunc()
+		}
+
+	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(275)
+	p.SetState(491)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 52, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -4848,49 +7920,49 @@ func (p *SLQParser) expr(_p int) (localctx IExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(273)
+			p.SetState(489)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 28, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 51, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(248)
+				p.SetState(431)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(249)
-					p.Match(SLQParserT__9)
+					p.SetState(432)
+					p.Match(SLQParserT__51)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
 				{
-					p.SetState(250)
-					p.expr(9)
+					p.SetState(433)
+					p.expr(15)
 				}
 
 			case 2:
 				localctx = NewExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(251)
+				p.SetState(434)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(252)
+					p.SetState(435)
 					_la = p.GetTokenStream().LA(1)
 
-					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&6148) != 0) {
+					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&27021597764222980) != 0) {
 						p.GetErrorHandler().RecoverInline(p)
 					} else {
 						p.GetErrorHandler().ReportMatch(p)
@@ -4898,21 +7970,21 @@ func (p *SLQParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(253)
-					p.expr(8)
+					p.SetState(436)
+					p.expr(14)
 				}
 
 			case 3:
 				localctx = NewExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(254)
+				p.SetState(437)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(255)
+					p.SetState(438)
 					_la = p.GetTokenStream().LA(1)
 
 					if !(_la == SLQParserORDER_ASC || _la == SLQParserORDER_DESC) {
@@ -4923,24 +7995,49 @@ func (p *SLQParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(256)
-					p.expr(7)
+					p.SetState(439)
+					p.expr(13)
 				}
 
 			case 4:
 				localctx = NewExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(257)
+				p.SetState(440)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(441)
+					_la = p.GetTokenStream().LA(1)
+
+					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&252201579132747776) != 0) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(442)
+					p.expr(12)
+				}
+
+			case 5:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(443)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(258)
+					p.SetState(444)
 					_la = p.GetTokenStream().LA(1)
 
-					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&57344) != 0) {
+					if !((int64((_la-93)) & ^0x3f) == 0 && ((int64(1)<<(_la-93))&15) != 0) {
 						p.GetErrorHandler().RecoverInline(p)
 					} else {
 						p.GetErrorHandler().ReportMatch(p)
@@ -4948,102 +8045,290 @@ func (p *SLQParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(259)
-					p.expr(6)
+					p.SetState(445)
+					p.expr(11)
+				}
+
+			case 6:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(446)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+					goto errorExit
+				}
+				p.SetState(450)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+
+				switch p.GetTokenStream().LA(1) {
+				case SLQParserEQ:
+					{
+						p.SetState(447)
+						p.Match(SLQParserEQ)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+				case SLQParserNEQ:
+					{
+						p.SetState(448)
+						p.Match(SLQParserNEQ)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+				case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__6, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserT__38, SLQParserT__46, SLQParserT__59, SLQParserT__60, SLQParserPROPRIETARY_FUNC_NAME, SLQParserORDER_ASC, SLQParserORDER_DESC, SLQParserARG, SLQParserNULL, SLQParserLPAR, SLQParserNN, SLQParserNUMBER, SLQParserNAME, SLQParserSTRING:
+
+				default:
+					p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+					goto errorExit
+				}
+				{
+					p.SetState(452)
+					p.expr(10)
+				}
+
+			case 7:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(453)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+					goto errorExit
+				}
+				p.SetState(455)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == SLQParserNOT {
+					{
+						p.SetState(454)
+						p.Match(SLQParserNOT)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+				}
+				{
+					p.SetState(457)
+					p.Match(SLQParserBETWEEN)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(458)
+					p.expr(0)
+				}
+				{
+					p.SetState(459)
+					p.Match(SLQParserAND)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(460)
+					p.expr(8)
+				}
+
+			case 8:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(462)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+					goto errorExit
+				}
+				p.SetState(464)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == SLQParserNOT {
+					{
+						p.SetState(463)
+						p.Match(SLQParserNOT)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+				}
+				{
+					p.SetState(466)
+					p.Match(SLQParserLIKE)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(467)
+					p.expr(7)
+				}
+
+			case 9:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(468)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(469)
+					p.Match(SLQParserIS)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				p.SetState(471)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+
+				if _la == SLQParserNOT {
+					{
+						p.SetState(470)
+						p.Match(SLQParserNOT)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+				}
+				{
+					p.SetState(473)
+					p.expr(6)
+				}
+
+			case 10:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(474)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(475)
+					p.Match(SLQParserT__57)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(476)
+					p.expr(5)
 				}
 
-			case 5:
+			case 11:
 				localctx = NewExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(260)
+				p.SetState(477)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(261)
-					_la = p.GetTokenStream().LA(1)
-
-					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&16492674416640) != 0) {
-						p.GetErrorHandler().RecoverInline(p)
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
+					p.SetState(478)
+					p.Match(SLQParserT__58)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
 					}
 				}
 				{
-					p.SetState(262)
-					p.expr(5)
+					p.SetState(479)
+					p.expr(4)
 				}
 
-			case 6:
+			case 12:
 				localctx = NewExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(263)
+				p.SetState(480)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 					goto errorExit
 				}
-				p.SetState(267)
+				p.SetState(482)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
 				}
+				_la = p.GetTokenStream().LA(1)
 
-				switch p.GetTokenStream().LA(1) {
-				case SLQParserEQ:
-					{
-						p.SetState(264)
-						p.Match(SLQParserEQ)
-						if p.HasError() {
-							// Recognition error - abort rule
-							goto errorExit
-						}
-					}
-
-				case SLQParserNEQ:
+				if _la == SLQParserNOT {
 					{
-						p.SetState(265)
-						p.Match(SLQParserNEQ)
+						p.SetState(481)
+						p.Match(SLQParserNOT)
 						if p.HasError() {
 							// Recognition error - abort rule
 							goto errorExit
 						}
 					}
 
-				case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__16, SLQParserT__17, SLQParserPROPRIETARY_FUNC_NAME, SLQParserORDER_ASC, SLQParserORDER_DESC, SLQParserARG, SLQParserNULL, SLQParserLPAR, SLQParserNN, SLQParserNUMBER, SLQParserNAME, SLQParserSTRING:
-
-				default:
-					p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-					goto errorExit
-				}
-				{
-					p.SetState(269)
-					p.expr(4)
-				}
-
-			case 7:
-				localctx = NewExprContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(270)
-
-				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
-					goto errorExit
 				}
 				{
-					p.SetState(271)
-					p.Match(SLQParserT__15)
+					p.SetState(484)
+					p.Match(SLQParserIN)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
-				{
-					p.SetState(272)
-					p.expr(3)
+				p.SetState(487)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+
+				switch p.GetTokenStream().LA(1) {
+				case SLQParserLPAR:
+					{
+						p.SetState(485)
+						p.Subquery()
+					}
+
+				case SLQParserLBRA:
+					{
+						p.SetState(486)
+						p.List()
+					}
+
+				default:
+					p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+					goto errorExit
 				}
 
 			case antlr.ATNInvalidAltNumber:
@@ -5051,12 +8336,12 @@ func (p *SLQParser) expr(_p int) (localctx IExprContext) {
 			}
 
 		}
-		p.SetState(277)
+		p.SetState(493)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 52, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -5172,15 +8457,15 @@ func (s *LiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SLQParser) Literal() (localctx ILiteralContext) {
 	localctx = NewLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, SLQParserRULE_literal)
+	p.EnterRule(localctx, 74, SLQParserRULE_literal)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(278)
+		p.SetState(494)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&282299878866944) != 0) {
+		if !((int64((_la-81)) & ^0x3f) == 0 && ((int64(1)<<(_la-81))&1051649) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -5201,6 +8486,212 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
+// IListContext is an interface to support dynamic dispatch.
+type IListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LBRA() antlr.TerminalNode
+	AllExpr() []IExprContext
+	Expr(i int) IExprContext
+	RBRA() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsListContext differentiates from other interfaces.
+	IsListContext()
+}
+
+type ListContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyListContext() *ListContext {
+	var p = new(ListContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_list
+	return p
+}
+
+func InitEmptyListContext(p *ListContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_list
+}
+
+func (*ListContext) IsListContext() {}
+
+func NewListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ListContext {
+	var p = new(ListContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_list
+
+	return p
+}
+
+func (s *ListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ListContext) LBRA() antlr.TerminalNode {
+	return s.GetToken(SLQParserLBRA, 0)
+}
+
+func (s *ListContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ListContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ListContext) RBRA() antlr.TerminalNode {
+	return s.GetToken(SLQParserRBRA, 0)
+}
+
+func (s *ListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserCOMMA)
+}
+
+func (s *ListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, i)
+}
+
+func (s *ListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ListContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterList(s)
+	}
+}
+
+func (s *ListContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitList(s)
+	}
+}
+
+func (s *ListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitList(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) List() (localctx IListContext) {
+	localctx = NewListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, SLQParserRULE_list)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(496)
+		p.Match(SLQParserLBRA)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(497)
+		p.expr(0)
+	}
+	p.SetState(502)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SLQParserCOMMA {
+		{
+			p.SetState(498)
+			p.Match(SLQParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(499)
+			p.expr(0)
+		}
+
+		p.SetState(504)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(505)
+		p.Match(SLQParserRBRA)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
 // IUnaryOperatorContext is an interface to support dynamic dispatch.
 type IUnaryOperatorContext interface {
 	antlr.ParserRuleContext
@@ -5288,15 +8779,15 @@ func (s *UnaryOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SLQParser) UnaryOperator() (localctx IUnaryOperatorContext) {
 	localctx = NewUnaryOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, SLQParserRULE_unaryOperator)
+	p.EnterRule(localctx, 78, SLQParserRULE_unaryOperator)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(280)
+		p.SetState(507)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&25559040) != 0) {
+		if !((int64((_la-60)) & ^0x3f) == 0 && ((int64(1)<<(_la-60))&49155) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -5319,7 +8810,7 @@ errorExit:
 
 func (p *SLQParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 24:
+	case 36:
 		var t *ExprContext = nil
 		if localctx != nil {
 			t = localctx.(*ExprContext)
@@ -5334,25 +8825,40 @@ func (p *SLQParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int
 func (p *SLQParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 8)
+		return p.Precpred(p.GetParserRuleContext(), 14)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 7)
+		return p.Precpred(p.GetParserRuleContext(), 13)
 
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 6)
+		return p.Precpred(p.GetParserRuleContext(), 12)
 
 	case 3:
-		return p.Precpred(p.GetParserRuleContext(), 5)
+		return p.Precpred(p.GetParserRuleContext(), 11)
 
 	case 4:
-		return p.Precpred(p.GetParserRuleContext(), 4)
+		return p.Precpred(p.GetParserRuleContext(), 10)
 
 	case 5:
-		return p.Precpred(p.GetParserRuleContext(), 3)
+		return p.Precpred(p.GetParserRuleContext(), 9)
 
 	case 6:
-		return p.Precpred(p.GetParserRuleContext(), 2)
+		return p.Precpred(p.GetParserRuleContext(), 7)
+
+	case 7:
+		return p.Precpred(p.GetParserRuleContext(), 6)
+
+	case 8:
+		return p.Precpred(p.GetParserRuleContext(), 5)
+
+	case 9:
+		return p.Precpred(p.GetParserRuleContext(), 4)
+
+	case 10:
+		return p.Precpred(p.GetParserRuleContext(), 3)
+
+	case 11:
+		return p.Precpred(p.GetParserRuleContext(), 8)
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
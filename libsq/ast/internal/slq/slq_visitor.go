@@ -28,15 +28,36 @@ type SLQVisitor interface {
 	// Visit a parse tree produced by SLQParser#funcName.
 	VisitFuncName(ctx *FuncNameContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#window.
+	VisitWindow(ctx *WindowContext) interface{}
+
+	// Visit a parse tree produced by SLQParser#partitionBy.
+	VisitPartitionBy(ctx *PartitionByContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#join.
 	VisitJoin(ctx *JoinContext) interface{}
 
 	// Visit a parse tree produced by SLQParser#joinTable.
 	VisitJoinTable(ctx *JoinTableContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#setOp.
+	VisitSetOp(ctx *SetOpContext) interface{}
+
+	// Visit a parse tree produced by SLQParser#subquery.
+	VisitSubquery(ctx *SubqueryContext) interface{}
+
+	// Visit a parse tree produced by SLQParser#conditional.
+	VisitConditional(ctx *ConditionalContext) interface{}
+
+	// Visit a parse tree produced by SLQParser#cte.
+	VisitCte(ctx *CteContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#uniqueFunc.
 	VisitUniqueFunc(ctx *UniqueFuncContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#topFunc.
+	VisitTopFunc(ctx *TopFuncContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#countFunc.
 	VisitCountFunc(ctx *CountFuncContext) interface{}
 
@@ -49,6 +70,15 @@ type SLQVisitor interface {
 	// Visit a parse tree produced by SLQParser#groupBy.
 	VisitGroupBy(ctx *GroupByContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#grouping.
+	VisitGrouping(ctx *GroupingContext) interface{}
+
+	// Visit a parse tree produced by SLQParser#groupingTerm.
+	VisitGroupingTerm(ctx *GroupingTermContext) interface{}
+
+	// Visit a parse tree produced by SLQParser#groupingSet.
+	VisitGroupingSet(ctx *GroupingSetContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#orderByTerm.
 	VisitOrderByTerm(ctx *OrderByTermContext) interface{}
 
@@ -64,6 +94,9 @@ type SLQVisitor interface {
 	// Visit a parse tree produced by SLQParser#alias.
 	VisitAlias(ctx *AliasContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#aliasKeyword.
+	VisitAliasKeyword(ctx *AliasKeywordContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#arg.
 	VisitArg(ctx *ArgContext) interface{}
 
@@ -76,6 +109,9 @@ type SLQVisitor interface {
 	// Visit a parse tree produced by SLQParser#rowRange.
 	VisitRowRange(ctx *RowRangeContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#rowRangeIndex.
+	VisitRowRangeIndex(ctx *RowRangeIndexContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#exprElement.
 	VisitExprElement(ctx *ExprElementContext) interface{}
 
@@ -85,6 +121,9 @@ type SLQVisitor interface {
 	// Visit a parse tree produced by SLQParser#literal.
 	VisitLiteral(ctx *LiteralContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#list.
+	VisitList(ctx *ListContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#unaryOperator.
 	VisitUnaryOperator(ctx *UnaryOperatorContext) interface{}
 }
@@ -326,20 +326,36 @@ func NodeUnwrap[T Node](node Node) (T, bool) {
 // Results from reflect.TypeOf for node types.
 var (
 	typeAST                = reflect.TypeOf((*AST)(nil))
+	typeArgNode            = reflect.TypeOf((*ArgNode)(nil))
 	typeColSelectorNode    = reflect.TypeOf((*ColSelectorNode)(nil))
-	_                      = reflect.TypeOf((*ExprNode)(nil))
+	typeConditionalNode    = reflect.TypeOf((*ConditionalNode)(nil))
+	typeInNode             = reflect.TypeOf((*InNode)(nil))
+	typeBetweenNode        = reflect.TypeOf((*BetweenNode)(nil))
+	typeLikeNode           = reflect.TypeOf((*LikeNode)(nil))
+	typeIsNode             = reflect.TypeOf((*IsNode)(nil))
+	typeCTENode            = reflect.TypeOf((*CTENode)(nil))
+	typeExprNode           = reflect.TypeOf((*ExprNode)(nil))
 	typeFuncNode           = reflect.TypeOf((*FuncNode)(nil))
 	typeGroupByNode        = reflect.TypeOf((*GroupByNode)(nil))
+	typeGroupingNode       = reflect.TypeOf((*GroupingNode)(nil))
+	typeGroupingSetNode    = reflect.TypeOf((*GroupingSetNode)(nil))
 	typeHandleNode         = reflect.TypeOf((*HandleNode)(nil))
+	typeHavingNode         = reflect.TypeOf((*HavingNode)(nil))
 	typeJoinNode           = reflect.TypeOf((*JoinNode)(nil))
 	typeNode               = reflect.TypeOf((*Node)(nil)).Elem()
 	_                      = reflect.TypeOf((*OperatorNode)(nil))
 	typeOrderByNode        = reflect.TypeOf((*OrderByNode)(nil))
+	_                      = reflect.TypeOf((*PartitionByNode)(nil))
 	typeRowRangeNode       = reflect.TypeOf((*RowRangeNode)(nil))
 	typeSegmentNode        = reflect.TypeOf((*SegmentNode)(nil))
 	_                      = reflect.TypeOf((*Selector)(nil)).Elem()
 	typeSelectorNode       = reflect.TypeOf((*SelectorNode)(nil))
+	typeSetOpNode          = reflect.TypeOf((*SetOpNode)(nil))
+	typeSubqueryNode       = reflect.TypeOf((*SubqueryNode)(nil))
 	typeTblColSelectorNode = reflect.TypeOf((*TblColSelectorNode)(nil))
 	typeTblSelectorNode    = reflect.TypeOf((*TblSelectorNode)(nil))
+	typeTopNode            = reflect.TypeOf((*TopNode)(nil))
 	typeUniqueNode         = reflect.TypeOf((*UniqueNode)(nil))
+	typeWhereNode          = reflect.TypeOf((*WhereNode)(nil))
+	typeWindowNode         = reflect.TypeOf((*WindowNode)(nil))
 )
@@ -13,7 +13,7 @@ func (n *OperatorNode) String() string {
 // isOperator returns true if the supplied string is a recognized operator, e.g. "!=" or ">".
 func isOperator(text string) bool {
 	switch text {
-	case "-", "+", "~", "!", "||", "*", "/", "%", "<<", ">>", "&", "<", "<=", ">", ">=", "==", "!=", "&&":
+	case "-", "+", "~", "!", "//", "||", "*", "/", "%", "<<", ">>", "&", "<", "<=", ">", ">=", "==", "!=", "&&":
 		return true
 	default:
 		return false
@@ -1,6 +1,10 @@
 package ast
 
-import "github.com/neilotoole/sq/libsq/ast/internal/slq"
+import (
+	"reflect"
+
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
 
 // OrderByNode implements the SQL "ORDER BY" clause.
 type OrderByNode struct {
@@ -65,47 +69,67 @@ const (
 	OrderByDirectionDesc OrderByDirection = "DESC"
 )
 
-// OrderByTermNode is a child of OrderByNode.
+// OrderByNulls specifies the ordering of nulls, i.e.
+// "NULLS FIRST" or "NULLS LAST".
+type OrderByNulls string
+
+const (
+	// OrderByNullsDefault is the DB's default ordering of nulls.
+	OrderByNullsDefault OrderByNulls = ""
+
+	// OrderByNullsFirst orders nulls before non-null values.
+	OrderByNullsFirst OrderByNulls = "NULLS FIRST"
+
+	// OrderByNullsLast orders nulls after non-null values.
+	OrderByNullsLast OrderByNulls = "NULLS LAST"
+)
+
+// OrderByTermNode is a child of OrderByNode. Its single
+// child is a selector, or an expression (ExprNode).
 type OrderByTermNode struct {
 	baseNode
 	direction OrderByDirection
+	nulls     OrderByNulls
 }
 
-// AddChild accepts a single child of type *SelectorNode.
+// AddChild accepts a single child, of type selector or *ExprNode.
 func (n *OrderByTermNode) AddChild(child Node) error {
 	if len(n.children) > 0 {
 		return errorf("%T is only allowed a single child", n)
 	}
 
-	selNode, ok := child.(*SelectorNode)
-	if !ok {
-		return errorf("illegal %T child type %T: %s", n, child, child)
+	if err := nodesAreOnlyOfType([]Node{child}, orderByTermAllowedChildren...); err != nil {
+		return err
 	}
 
-	n.addChild(selNode)
+	n.addChild(child)
 	return child.SetParent(n)
 }
 
 // SetChildren implements ast.Node.
 func (n *OrderByTermNode) SetChildren(children []Node) error {
-	switch len(children) {
-	case 0:
-		// fallthrough
-	case 1:
-		if _, ok := children[0].(Selector); !ok {
-			return errorf("illegal child type %T {%s} for %T", children[0], children[0], n)
-		}
-	default:
+	if len(children) > 1 {
 		return errorf("%T can have only 1 child but attempt to set %d children",
 			n, len(children))
 	}
 
+	if err := nodesAreOnlyOfType(children, orderByTermAllowedChildren...); err != nil {
+		return err
+	}
+
 	n.doSetChildren(children)
 	return nil
 }
 
-// Selector returns the ordering term's selector.
-func (n *OrderByTermNode) Selector() Node {
+var orderByTermAllowedChildren = []reflect.Type{
+	typeSelectorNode,
+	typeColSelectorNode,
+	typeTblColSelectorNode,
+	typeExprNode,
+}
+
+// Expr returns the ordering term's selector or expression.
+func (n *OrderByTermNode) Expr() Node {
 	return n.children[0]
 }
 
@@ -114,6 +138,11 @@ func (n *OrderByTermNode) Direction() OrderByDirection {
 	return n.direction
 }
 
+// Nulls returns the ordering term's ordering of nulls.
+func (n *OrderByTermNode) Nulls() OrderByNulls {
+	return n.nulls
+}
+
 // String returns a log/debug-friendly representation.
 func (n *OrderByTermNode) String() string {
 	return nodeString(n)
@@ -143,19 +172,24 @@ func (v *parseTreeVisitor) VisitOrderByTerm(ctx *slq.OrderByTermContext) interfa
 	node.ctx = ctx
 	node.text = ctx.GetText()
 
-	selNode, err := newSelectorNode(node, ctx.Selector())
-	if err != nil {
-		return nil
-	}
-
 	if ctx.ORDER_ASC() != nil {
 		node.direction = OrderByDirectionAsc
 	} else if ctx.ORDER_DESC() != nil {
 		node.direction = OrderByDirectionDesc
 	}
 
-	if err := node.AddChild(selNode); err != nil {
-		return err
+	if ctx.NULLS_FIRST() != nil {
+		node.nulls = OrderByNullsFirst
+	} else if ctx.NULLS_LAST() != nil {
+		node.nulls = OrderByNullsLast
+	}
+
+	if e := v.using(node, func() any {
+		// Only the expression is visited: the direction
+		// tokens would otherwise be visited as operators.
+		return v.Visit(ctx.Expr())
+	}); e != nil {
+		return e
 	}
 
 	return v.cur.AddChild(node)
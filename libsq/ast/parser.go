@@ -19,15 +19,24 @@ import (
 func parseSLQ(log *slog.Logger, input string) (*slq.QueryContext, error) {
 	lex := slq.NewSLQLexer(antlr.NewInputStream(input))
 	lex.RemoveErrorListeners() // the generated lexer has default listeners we don't want
-	lexErrs := &antlrErrorListener{name: "lexer", log: log}
+	lexErrs := &antlrErrorListener{name: "lexer", log: log, input: input}
 	lex.AddErrorListener(lexErrs)
 
 	p := slq.NewSLQParser(antlr.NewCommonTokenStream(lex, 0))
 	p.RemoveErrorListeners() // the generated parser has default listeners we don't want
-	parseErrs := &antlrErrorListener{name: "parser", log: log}
+	parseErrs := &antlrErrorListener{name: "parser", log: log, input: input}
 	p.AddErrorListener(parseErrs)
 
 	qCtx := p.Query()
+	if tok := p.GetTokenStream().LT(1); tok != nil && tok.GetTokenType() != antlr.TokenEOF {
+		// The query rule doesn't require EOF, so the parser stops at the
+		// first token that can't continue the query, without reporting
+		// an error. Thus input such as "@sakila.actor:a | .first_name"
+		// would silently be truncated to "@sakila.actor".
+		parseErrs.SyntaxError(p, tok, tok.GetLine(), tok.GetColumn(),
+			fmt.Sprintf("unexpected input '%s'", tok.GetText()), nil)
+	}
+
 	if err := lexErrs.error(); err != nil {
 		return nil, errz.Err(err)
 	}
@@ -44,9 +53,9 @@ var _ antlr.ErrorListener = (*antlrErrorListener)(nil)
 type antlrErrorListener struct {
 	log      *slog.Logger
 	name     string
-	errs     []string
+	input    string
+	errs     []*QueryError
 	warnings []string
-	err      error
 }
 
 // SyntaxError implements antlr.ErrorListener.
@@ -55,8 +64,8 @@ type antlrErrorListener struct {
 func (el *antlrErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{},
 	line, column int, msg string, e antlr.RecognitionException,
 ) {
-	text := fmt.Sprintf("%s: syntax error: [%d:%d] %s", el.name, line, column, msg)
-	el.errs = append(el.errs, text)
+	tok, _ := offendingSymbol.(antlr.Token)
+	el.errs = append(el.errs, newSyntaxError(el.input, tok, line, column, msg))
 }
 
 // ReportAmbiguity implements antlr.ErrorListener.
@@ -91,12 +100,18 @@ func (el *antlrErrorListener) ReportContextSensitivity(recognizer antlr.Parser,
 	el.warnings = append(el.warnings, text)
 }
 
+// error returns the first syntax error, or nil. Any subsequent
+// errors are typically a consequence of the first, and so are
+// only logged.
 func (el *antlrErrorListener) error() error {
-	if el.err == nil && len(el.errs) > 0 {
-		msg := strings.Join(el.errs, "\n")
-		el.err = &parseError{msg: msg}
+	if len(el.errs) == 0 {
+		return nil
+	}
+
+	for _, err := range el.errs[1:] {
+		el.log.Debug("Subsequent syntax error", lga.Err, err)
 	}
-	return el.err
+	return el.errs[0]
 }
 
 func (el *antlrErrorListener) String() string {
@@ -105,24 +120,14 @@ func (el *antlrErrorListener) String() string {
 	}
 
 	strs := make([]string, 0, len(el.errs)+len(el.warnings))
-	strs = append(strs, el.errs...)
+	for _, err := range el.errs {
+		strs = append(strs, fmt.Sprintf("%s: %v", el.name, err))
+	}
 	strs = append(strs, el.warnings...)
 
 	return strings.Join(strs, "\n")
 }
 
-// parseError represents an error in lexing/parsing input.
-type parseError struct {
-	msg string
-	// TODO: parse error should include more detail, such as
-	// the offending token, position, etc.
-}
-
-// Error implements error.
-func (p *parseError) Error() string {
-	return p.msg
-}
-
 var _ slq.SLQVisitor = (*parseTreeVisitor)(nil)
 
 // parseTreeVisitor implements slq.SLQVisitor to
@@ -176,10 +181,14 @@ func (v *parseTreeVisitor) Visit(ctx antlr.ParseTree) any {
 		return v.VisitJoin(ctx)
 	case *slq.AliasContext:
 		return v.VisitAlias(ctx)
+	case *slq.AliasKeywordContext:
+		return v.VisitAliasKeyword(ctx)
 	case *slq.JoinTableContext:
 		return v.VisitJoinTable(ctx)
 	case *slq.RowRangeContext:
 		return v.VisitRowRange(ctx)
+	case *slq.RowRangeIndexContext:
+		return v.VisitRowRangeIndex(ctx)
 	case *slq.ExprElementContext:
 		return v.VisitExprElement(ctx)
 	case *slq.ExprContext:
@@ -188,6 +197,12 @@ func (v *parseTreeVisitor) Visit(ctx antlr.ParseTree) any {
 		return v.VisitGroupBy(ctx)
 	case *slq.GroupByTermContext:
 		return v.VisitGroupByTerm(ctx)
+	case *slq.GroupingContext:
+		return v.VisitGrouping(ctx)
+	case *slq.GroupingTermContext:
+		return v.VisitGroupingTerm(ctx)
+	case *slq.GroupingSetContext:
+		return v.VisitGroupingSet(ctx)
 	case *slq.OrderByContext:
 		return v.VisitOrderBy(ctx)
 	case *slq.OrderByTermContext:
@@ -200,12 +215,28 @@ func (v *parseTreeVisitor) Visit(ctx antlr.ParseTree) any {
 		return v.VisitSelectorElement(ctx)
 	case *slq.UniqueFuncContext:
 		return v.VisitUniqueFunc(ctx)
+	case *slq.TopFuncContext:
+		return v.VisitTopFunc(ctx)
 	case *slq.CountFuncContext:
 		return v.VisitCountFunc(ctx)
 	case *slq.WhereContext:
 		return v.VisitWhere(ctx)
 	case *slq.ArgContext:
 		return v.VisitArg(ctx)
+	case *slq.WindowContext:
+		return v.VisitWindow(ctx)
+	case *slq.PartitionByContext:
+		return v.VisitPartitionBy(ctx)
+	case *slq.SetOpContext:
+		return v.VisitSetOp(ctx)
+	case *slq.SubqueryContext:
+		return v.VisitSubquery(ctx)
+	case *slq.CteContext:
+		return v.VisitCte(ctx)
+	case *slq.ConditionalContext:
+		return v.VisitConditional(ctx)
+	case *slq.ListContext:
+		return v.VisitList(ctx)
 	}
 
 	// should never be reached
@@ -97,6 +97,39 @@ func TestParseBuild(t *testing.T) {
 	}
 }
 
+// TestParseSLQ_TrailingInput verifies that input that can't continue
+// the query is reported as an error, rather than the query silently
+// being truncated before that input.
+func TestParseSLQ_TrailingInput(t *testing.T) {
+	testCases := []struct {
+		in      string
+		wantErr string
+	}{
+		{in: `@mydb1 | .user | .uid, .username`},
+		{in: "@mydb1 | .user | .uid, .username  \n\t"},
+		{in: `@mydb1.user:u | .uid`, wantErr: "unexpected input ':'"},
+		{in: `@mydb1 | .user | .uid, .username )`, wantErr: "unexpected input ')'"},
+		{in: `@mydb1 | .user | .uid ]`, wantErr: "unexpected input ']'"},
+		{in: `@mydb1 | .user | .uid;`, wantErr: "unexpected input ';'"},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(tutil.Name(i, tc.in), func(t *testing.T) {
+			ptree, err := parseSLQ(slogt.New(t), tc.in)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				require.NotNil(t, ptree)
+				return
+			}
+
+			require.Error(t, err)
+			require.Nil(t, ptree)
+			require.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
 func TestInspector_FindWhereClauses(t *testing.T) {
 	log := slogt.New(t)
 
@@ -115,3 +148,38 @@ func TestInspector_FindWhereClauses(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, whereNodes, 1)
 }
+
+func TestInspector_FindHavingNode(t *testing.T) {
+	testCases := []struct {
+		in         string
+		wantWhere  int
+		wantHaving bool
+	}{
+		{"@my1 | .payment | where(.amount > 4) | .customer_id | group_by(.customer_id)", 1, false},
+		{"@my1 | .payment | .customer_id | group_by(.customer_id) | where(sum(.amount) > 4)", 0, true},
+		{"@my1 | .payment | where(.amount > 4) | group_by(.customer_id) | where(count() > 4)", 1, true},
+		{"@my1 | .payment | where(.amount > 4)", 1, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(tutil.Name(i, tc.in), func(t *testing.T) {
+			insp := NewInspector(mustParse(t, tc.in))
+
+			whereNodes, err := insp.FindWhereClauses()
+			require.NoError(t, err)
+			require.Len(t, whereNodes, tc.wantWhere)
+
+			having, err := insp.FindHavingNode()
+			require.NoError(t, err)
+			if !tc.wantHaving {
+				require.Nil(t, having)
+				return
+			}
+
+			require.NotNil(t, having)
+			require.NotNil(t, having.Expr())
+			require.Equal(t, having, having.Expr().Parent())
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package ast
+
+import (
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+var _ Node = (*InNode)(nil)
+
+// InNode models the SQL "IN" predicate. The right-hand side is either
+// a list of values, or a subquery.
+//
+//	.actor_id in [1, 2, 3]
+//	.first_name not in ["PENELOPE", "NICK"]
+//	.customer_id in (.payment | where(.amount > 10) | .customer_id)
+type InNode struct {
+	baseNode
+	negated bool
+}
+
+// Negated returns true if this is a "NOT IN" predicate.
+func (n *InNode) Negated() bool {
+	return n.negated
+}
+
+// Expr returns the left-hand side expression.
+func (n *InNode) Expr() *ExprNode {
+	return exprChildAt(n, 0)
+}
+
+// Subquery returns the right-hand side subquery, or nil
+// if the right-hand side is a list.
+func (n *InNode) Subquery() *SubqueryNode {
+	if len(n.children) != 2 {
+		return nil
+	}
+	sq, _ := n.children[1].(*SubqueryNode)
+	return sq
+}
+
+// List returns the right-hand side list of values. It is
+// empty if the right-hand side is a subquery.
+func (n *InNode) List() []*ExprNode {
+	if len(n.children) < 2 || n.Subquery() != nil {
+		return nil
+	}
+
+	list := make([]*ExprNode, 0, len(n.children)-1)
+	for i := 1; i < len(n.children); i++ {
+		list = append(list, exprChildAt(n, i))
+	}
+	return list
+}
+
+// AddChild implements Node.AddChild. It returns an error
+// if child is not of type *ExprNode or *SubqueryNode.
+func (n *InNode) AddChild(child Node) error {
+	switch child.(type) {
+	case *ExprNode, *SubqueryNode:
+	default:
+		return errorf("illegal %T child type %T: %s", n, child, child)
+	}
+
+	n.addChild(child)
+	return child.SetParent(n)
+}
+
+// SetChildren implements ast.Node.
+func (n *InNode) SetChildren(children []Node) error {
+	n.children = nil
+	for _, child := range children {
+		if err := n.AddChild(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *InNode) String() string {
+	return nodeString(n)
+}
+
+var _ Node = (*BetweenNode)(nil)
+
+// BetweenNode models the SQL "BETWEEN" predicate.
+//
+//	.amount between 1 and 5
+//	.amount not between 1 and 5
+type BetweenNode struct {
+	baseNode
+	negated bool
+}
+
+// Negated returns true if this is a "NOT BETWEEN" predicate.
+func (n *BetweenNode) Negated() bool {
+	return n.negated
+}
+
+// Expr returns the expression being tested.
+func (n *BetweenNode) Expr() *ExprNode {
+	return exprChildAt(n, 0)
+}
+
+// Low returns the lower bound expression.
+func (n *BetweenNode) Low() *ExprNode {
+	return exprChildAt(n, 1)
+}
+
+// High returns the upper bound expression.
+func (n *BetweenNode) High() *ExprNode {
+	return exprChildAt(n, 2)
+}
+
+// AddChild implements Node.AddChild.
+func (n *BetweenNode) AddChild(child Node) error {
+	return addExprChild(n, child, 3)
+}
+
+// SetChildren implements ast.Node.
+func (n *BetweenNode) SetChildren(children []Node) error {
+	n.children = nil
+	for _, child := range children {
+		if err := n.AddChild(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *BetweenNode) String() string {
+	return nodeString(n)
+}
+
+var _ Node = (*LikeNode)(nil)
+
+// LikeNode models the SQL "LIKE" predicate. In the pattern, "%" matches
+// any sequence of characters, "_" matches any single character, and
+// a backslash escapes the following character.
+//
+//	.first_name like "PEN%"
+//	.first_name not ilike "pen%"
+//
+// The "ilike" form is case-insensitive on all databases, whereas the
+// case-sensitivity of "like" is that of the database.
+type LikeNode struct {
+	baseNode
+	negated         bool
+	caseInsensitive bool
+}
+
+// Negated returns true if this is a "NOT LIKE" predicate.
+func (n *LikeNode) Negated() bool {
+	return n.negated
+}
+
+// CaseInsensitive returns true if this is an "ilike" predicate.
+func (n *LikeNode) CaseInsensitive() bool {
+	return n.caseInsensitive
+}
+
+// Expr returns the expression being matched.
+func (n *LikeNode) Expr() *ExprNode {
+	return exprChildAt(n, 0)
+}
+
+// Pattern returns the pattern expression.
+func (n *LikeNode) Pattern() *ExprNode {
+	return exprChildAt(n, 1)
+}
+
+// AddChild implements Node.AddChild.
+func (n *LikeNode) AddChild(child Node) error {
+	return addExprChild(n, child, 2)
+}
+
+// SetChildren implements ast.Node.
+func (n *LikeNode) SetChildren(children []Node) error {
+	n.children = nil
+	for _, child := range children {
+		if err := n.AddChild(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *LikeNode) String() string {
+	return nodeString(n)
+}
+
+var _ Node = (*IsNode)(nil)
+
+// IsNode models a null-safe comparison. Unlike "==", two null values
+// are considered equal. It's equivalent to SQL's "IS NOT DISTINCT FROM".
+//
+//	.address2 is null
+//	.address2 is not .address
+type IsNode struct {
+	baseNode
+	negated bool
+}
+
+// Negated returns true if this is an "is not" comparison.
+func (n *IsNode) Negated() bool {
+	return n.negated
+}
+
+// Left returns the left-hand side expression.
+func (n *IsNode) Left() *ExprNode {
+	return exprChildAt(n, 0)
+}
+
+// Right returns the right-hand side expression.
+func (n *IsNode) Right() *ExprNode {
+	return exprChildAt(n, 1)
+}
+
+// AddChild implements Node.AddChild.
+func (n *IsNode) AddChild(child Node) error {
+	return addExprChild(n, child, 2)
+}
+
+// SetChildren implements ast.Node.
+func (n *IsNode) SetChildren(children []Node) error {
+	n.children = nil
+	for _, child := range children {
+		if err := n.AddChild(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *IsNode) String() string {
+	return nodeString(n)
+}
+
+// exprChildAt returns the child of n at index i,
+// or nil if there's no such *ExprNode child.
+func exprChildAt(n Node, i int) *ExprNode {
+	children := n.Children()
+	if i < 0 || i >= len(children) {
+		return nil
+	}
+	expr, _ := children[i].(*ExprNode)
+	return expr
+}
+
+// addExprChild adds child to parent, returning an error if child
+// is not of type *ExprNode, or if parent would have more than max
+// children.
+func addExprChild(parent interface {
+	Node
+	addChild(child Node)
+}, child Node, max int,
+) error {
+	if _, ok := child.(*ExprNode); !ok {
+		return errorf("illegal %T child type %T: %s", parent, child, child)
+	}
+
+	if len(parent.Children()) >= max {
+		return errorf("%T can have only %d children: failed to add %T", parent, max, child)
+	}
+
+	parent.addChild(child)
+	return child.SetParent(parent)
+}
+
+// newPredicateNode returns a new predicate node for ctx, or nil if
+// ctx is not a predicate expression (e.g. ".actor_id in [1, 2, 3]").
+func newPredicateNode(ctx *slq.ExprContext) Node {
+	var (
+		bn      = baseNode{ctx: ctx, text: ctx.GetText()}
+		negated = ctx.NOT() != nil
+	)
+
+	switch {
+	case ctx.IN() != nil:
+		return &InNode{baseNode: bn, negated: negated}
+	case ctx.BETWEEN() != nil:
+		return &BetweenNode{baseNode: bn, negated: negated}
+	case ctx.LIKE() != nil:
+		return &LikeNode{baseNode: bn, negated: negated, caseInsensitive: ctx.LIKE().GetText() == "ilike"}
+	case ctx.IS() != nil:
+		return &IsNode{baseNode: bn, negated: negated}
+	default:
+		return nil
+	}
+}
+
+// VisitList implements slq.SLQVisitor. The list's values are
+// added directly to the current node, i.e. the InNode.
+func (v *parseTreeVisitor) VisitList(ctx *slq.ListContext) any {
+	if _, ok := v.cur.(*InNode); !ok {
+		return errorf("list must be the right-hand side of 'in': %s", ctx.GetText())
+	}
+
+	return v.VisitChildren(ctx)
+}
@@ -0,0 +1,59 @@
+package ast
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredicate(t *testing.T) {
+	testCases := []struct {
+		in                  string
+		wantType            reflect.Type
+		wantNegated         bool
+		wantCaseInsensitive bool
+		wantListLen         int
+	}{
+		{in: `.actor | where(.actor_id in [1, 2, 3])`, wantType: typeInNode, wantListLen: 3},
+		{in: `.actor | where(.first_name not in ["NICK"])`, wantType: typeInNode, wantNegated: true, wantListLen: 1},
+		{in: `.payment | where(.amount between 1 and 5)`, wantType: typeBetweenNode},
+		{in: `.payment | where(.amount not between 1 and 5)`, wantType: typeBetweenNode, wantNegated: true},
+		{in: `.actor | where(.first_name like "PEN%")`, wantType: typeLikeNode},
+		{in: `.actor | where(.first_name not like "PEN%")`, wantType: typeLikeNode, wantNegated: true},
+		{in: `.actor | where(.first_name ilike "pen%")`, wantType: typeLikeNode, wantCaseInsensitive: true},
+		{in: `.actor | where(.first_name not ilike "pen%")`, wantType: typeLikeNode, wantNegated: true, wantCaseInsensitive: true},
+		{in: `.address | where(.address2 is null)`, wantType: typeIsNode},
+		{in: `.address | where(.address2 is not .address)`, wantType: typeIsNode, wantNegated: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.in, func(t *testing.T) {
+			a := mustParse(t, tc.in)
+			nodes := NewInspector(a).FindNodes(tc.wantType)
+			require.Len(t, nodes, 1)
+
+			switch node := nodes[0].(type) {
+			case *InNode:
+				require.Equal(t, tc.wantNegated, node.Negated())
+				require.NotNil(t, node.Expr())
+				require.Nil(t, node.Subquery())
+				require.Len(t, node.List(), tc.wantListLen)
+			case *BetweenNode:
+				require.Equal(t, tc.wantNegated, node.Negated())
+				require.NotNil(t, node.Expr())
+				require.NotNil(t, node.Low())
+				require.NotNil(t, node.High())
+			case *LikeNode:
+				require.Equal(t, tc.wantNegated, node.Negated())
+				require.Equal(t, tc.wantCaseInsensitive, node.CaseInsensitive())
+				require.NotNil(t, node.Pattern())
+			case *IsNode:
+				require.Equal(t, tc.wantNegated, node.Negated())
+				require.NotNil(t, node.Left())
+				require.NotNil(t, node.Right())
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package ast
 
+import "reflect"
+
 // narrowTblSel takes a generic selector, and if appropriate, converts it
 // to an *ast.TblSelectorNode.
 func narrowTblSel(_ *Walker, node Node) error {
@@ -19,7 +21,25 @@ func narrowTblSel(_ *Walker, node Node) error {
 		err    error
 	)
 
-	if seg.SegIndex() == 0 {
+	// CTE segments don't affect the interpretation of the
+	// selector, so skip back over them. E.g.
+	//
+	//  $ sq '@sakila | with(.big, .payment | where(.amount > 10)) | .big'
+	//
+	// ".big" must be a table.
+	prev := seg.Prev()
+	for prev != nil {
+		var prevType reflect.Type
+		if prevType, err = prev.ChildType(); err != nil {
+			return err
+		}
+		if prevType != typeCTENode {
+			break
+		}
+		prev = prev.Prev()
+	}
+
+	if prev == nil {
 		// If this is the first segment, then the selector MUST refer
 		// to a table. E.g.
 		//
@@ -33,15 +53,15 @@ func narrowTblSel(_ *Walker, node Node) error {
 		return nodeReplace(sel, tblSel)
 	}
 
-	prevType, err := seg.Prev().ChildType()
+	prevType, err := prev.ChildType()
 	if err != nil {
 		return err
 	}
 
 	if prevType == typeHandleNode {
 		var handleNode *HandleNode
-		if handleNode, ok = seg.Prev().Children()[0].(*HandleNode); !ok {
-			return errorf("syntax error: expected %T, but got %T", handleNode, seg.Prev().Children()[0])
+		if handleNode, ok = prev.Children()[0].(*HandleNode); !ok {
+			return errorf("syntax error: expected %T, but got %T", handleNode, prev.Children()[0])
 		}
 
 		// this means that this selector must be a table selector
@@ -66,7 +86,7 @@ func narrowTblColSel(w *Walker, node Node) error {
 
 	parent := sel.Parent()
 	switch parent := parent.(type) {
-	case *FuncNode, *OrderByTermNode, *GroupByNode, *ExprNode:
+	case *FuncNode, *OrderByTermNode, *GroupByNode, *GroupingNode, *GroupingSetNode, *PartitionByNode, *UniqueNode, *TopNode, *ExprNode, *ConditionalNode:
 		if sel.name1 == "" {
 			return nil
 		}
@@ -119,7 +139,7 @@ func narrowColSel(w *Walker, node Node) error {
 	parent := sel.Parent()
 
 	switch parent := parent.(type) {
-	case *FuncNode, *OrderByTermNode, *GroupByNode, *ExprNode:
+	case *FuncNode, *OrderByTermNode, *GroupByNode, *GroupingNode, *GroupingSetNode, *PartitionByNode, *UniqueNode, *TopNode, *ExprNode, *ConditionalNode:
 		colSel, err := newColSelectorNode(sel)
 		if err != nil {
 			return err
@@ -7,10 +7,22 @@ import (
 )
 
 // RowRangeNode models a range, effectively {OFFSET,LIMIT}.
+//
+// A range with a negative index, e.g. ".[-10:]", counts back from
+// the last row. Such a range can't be expressed as a fixed OFFSET
+// and LIMIT, as it depends upon the number of rows: for such a range,
+// Offset and Limit are both -1, and RowRangeNode.Bounds returns
+// the range's indices.
 type RowRangeNode struct {
 	baseNode
 	Offset int
 	Limit  int
+
+	// start and end are the indices of a range with a negative
+	// index. If the range has no end, e.g. ".[-10:]", then open
+	// is true and end is meaningless.
+	start, end int
+	open       bool
 }
 
 func newRowRangeNode(ctx *slq.RowRangeContext, offset, limit int) *RowRangeNode {
@@ -22,6 +34,17 @@ func newRowRangeNode(ctx *slq.RowRangeContext, offset, limit int) *RowRangeNode
 	return rr
 }
 
+// newNegativeRowRangeNode returns a RowRangeNode for a range with a
+// negative index. If open is true, the range has no end, and end
+// is ignored.
+func newNegativeRowRangeNode(ctx *slq.RowRangeContext, start, end int, open bool) *RowRangeNode {
+	rr := newRowRangeNode(ctx, -1, -1)
+	rr.start = start
+	rr.end = end
+	rr.open = open
+	return rr
+}
+
 // String implements ast.Node.
 func (rr *RowRangeNode) String() string {
 	return rr.Text()
@@ -34,6 +57,20 @@ func (rr *RowRangeNode) Range() (offset, limit int) {
 	return offset, limit
 }
 
+// Negative returns true if the range has a negative index, e.g.
+// ".[-10:]". If so, use RowRangeNode.Bounds instead of Offset and Limit.
+func (rr *RowRangeNode) Negative() bool {
+	return rr.start < 0 || (!rr.open && rr.end < 0)
+}
+
+// Bounds returns the start and end indices of a range with a negative
+// index. A negative index counts back from the last row: the indices
+// for ".[-10:-2]" are -10 and -2. If the range has no end, e.g.
+// ".[-10:]", then open is true, and end should be ignored.
+func (rr *RowRangeNode) Bounds() (start, end int, open bool) {
+	return rr.start, rr.end, rr.open
+}
+
 // SetParent implements ast.Node.
 func (rr *RowRangeNode) SetParent(parent Node) error {
 	seg, ok := parent.(*SegmentNode)
@@ -52,34 +89,53 @@ func (v *parseTreeVisitor) VisitRowRange(ctx *slq.RowRangeContext) any {
 	// [0:15]  select rows 0 thru 15
 	// [:15]   same as above (0 thru 15)
 	// [10:]   select all rows from 10 onwards
+	// [-1]    select the last row
+	// [-10:]  select the last 10 rows
+	// [:-2]   select all rows except the last 2
 
-	if ctx.COLON() == nil && len(ctx.AllNN()) == 0 {
+	if ctx.COLON() == nil && len(ctx.AllRowRangeIndex()) == 0 {
 		// [] select all rows, aka no range
 		return nil
 	}
 
+	indices := make([]int, len(ctx.AllRowRangeIndex()))
+	for i, idx := range ctx.AllRowRangeIndex() {
+		var err error
+		if indices[i], err = strconv.Atoi(idx.GetText()); err != nil {
+			return errorf("row range: index must be an integer, but got: %s", idx.GetText())
+		}
+	}
+
 	if ctx.COLON() == nil {
 		// [1] -- select row[1]
-		if len(ctx.AllNN()) != 1 {
-			return errorf("row range: expected one integer but got %d", len(ctx.AllNN()))
+		if len(indices) != 1 {
+			return errorf("row range: expected one integer but got %d", len(indices))
 		}
 
-		i, _ := strconv.Atoi(ctx.AllNN()[0].GetText())
-		rr := newRowRangeNode(ctx, i, 1)
-		return v.cur.AddChild(rr)
+		i := indices[0]
+		if i >= 0 {
+			return v.cur.AddChild(newRowRangeNode(ctx, i, 1))
+		}
+
+		// [-3] is effectively [-3:-2], except for [-1], which
+		// is [-1:], as there's no "-0" end index.
+		return v.cur.AddChild(newNegativeRowRangeNode(ctx, i, i+1, i == -1))
 	}
 
 	// there's a colon... can only be one or two ints
-	if len(ctx.AllNN()) > 2 {
-		return errorf("row range: expected one or two integers but got %d", len(ctx.AllNN()))
+	if len(indices) > 2 {
+		return errorf("row range: expected one or two integers but got %d", len(indices))
 	}
 
-	if len(ctx.AllNN()) == 2 {
+	if len(indices) == 2 {
 		// [10:15] -- select rows 10 thru 15
-		offset, _ := strconv.Atoi(ctx.AllNN()[0].GetText())
-		finish, _ := strconv.Atoi(ctx.AllNN()[1].GetText())
-		limit := finish - offset
-		rr := newRowRangeNode(ctx, offset, limit)
+		start, finish := indices[0], indices[1]
+		if start < 0 || finish < 0 {
+			return v.cur.AddChild(newNegativeRowRangeNode(ctx, start, finish, false))
+		}
+
+		limit := finish - start
+		rr := newRowRangeNode(ctx, start, limit)
 		return v.cur.AddChild(rr)
 	}
 
@@ -90,19 +146,32 @@ func (v *parseTreeVisitor) VisitRowRange(ctx *slq.RowRangeContext) any {
 	var offset int
 	limit := -1
 
-	if ctx.COLON().GetSymbol().GetTokenIndex() < ctx.AllNN()[0].GetSymbol().GetTokenIndex() {
+	idx := ctx.AllRowRangeIndex()[0]
+	if ctx.COLON().GetSymbol().GetTokenIndex() < idx.GetStart().GetTokenIndex() {
 		// [:15]   (0 thru 15)
 		offset = 0
-		limit, _ = strconv.Atoi(ctx.AllNN()[0].GetText())
+		limit = indices[0]
+		if limit < 0 {
+			return v.cur.AddChild(newNegativeRowRangeNode(ctx, 0, limit, false))
+		}
 	} else {
 		// [10:]   select all rows from 10 onwards
-		offset, _ = strconv.Atoi(ctx.AllNN()[0].GetText())
+		offset = indices[0]
+		if offset < 0 {
+			return v.cur.AddChild(newNegativeRowRangeNode(ctx, offset, 0, true))
+		}
 	}
 
 	rr := newRowRangeNode(ctx, offset, limit)
 	return v.cur.AddChild(rr)
 }
 
+// VisitRowRangeIndex implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitRowRangeIndex(_ *slq.RowRangeIndexContext) any {
+	// no-op: handled by VisitRowRange
+	return nil
+}
+
 // verifyRowRange validates the RowRangeNode element.
 func verifyRowRange(w *Walker, node Node) error {
 	rr, ok := node.(*RowRangeNode)
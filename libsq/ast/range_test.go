@@ -3,6 +3,7 @@ package ast
 import (
 	"testing"
 
+	"github.com/neilotoole/slogt"
 	"github.com/neilotoole/sq/testh/tutil"
 	"github.com/stretchr/testify/require"
 )
@@ -28,6 +29,7 @@ func TestRowRange(t *testing.T) {
 		{".actor | .[0:3]", true, 0, 3},
 		{".actor | .[:3]", true, 0, 3},
 		{".actor | .[2:]", true, 2, -1},
+		{".actor | .[-0:]", true, 0, -1},
 	}
 
 	for i, tc := range testCases {
@@ -51,3 +53,52 @@ func TestRowRange(t *testing.T) {
 		})
 	}
 }
+
+// TestRowRange_negative tests row ranges with a negative index.
+//
+//	[-1]     select the last row
+//	[-3]     select the third-last row
+//	[-10:]   select the last 10 rows
+//	[:-2]    select all rows except the last 2
+//	[-5:-2]  select the fifth-last thru third-last rows
+func TestRowRange_negative(t *testing.T) {
+	testCases := []struct {
+		in        string
+		wantStart int
+		wantEnd   int
+		wantOpen  bool
+	}{
+		{".actor | .[-1]", -1, 0, true},
+		{".actor | .[-3]", -3, -2, false},
+		{".actor | .[-10:]", -10, 0, true},
+		{".actor | .[:-2]", 0, -2, false},
+		{".actor | .[-5:-2]", -5, -2, false},
+		{".actor | .[2:-2]", 2, -2, false},
+		{".actor | .[-5:2]", -5, 2, false},
+		{".actor | .[ -5 : -2 ]", -5, -2, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(tutil.Name(i, tc.in), func(t *testing.T) {
+			ast := mustParse(t, tc.in)
+			rr, err := NewInspector(ast).FindRowRangeNode()
+			require.NoError(t, err)
+			require.NotNil(t, rr)
+
+			require.True(t, rr.Negative())
+			require.Equal(t, -1, rr.Offset)
+			require.Equal(t, -1, rr.Limit)
+
+			start, end, open := rr.Bounds()
+			require.Equal(t, tc.wantStart, start)
+			require.Equal(t, tc.wantOpen, open)
+			if !tc.wantOpen {
+				require.Equal(t, tc.wantEnd, end)
+			}
+		})
+	}
+
+	_, err := Parse(slogt.New(t), ".actor | .[1.5]")
+	require.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+func doConditional(rc *Context, n *ast.ConditionalNode) (string, error) {
+	conds, results := n.Conditions(), n.Results()
+	if len(conds) == 0 || len(conds) != len(results) {
+		return "", errz.Errorf("invalid conditional expression: %s", n.Text())
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString("CASE")
+	for i := range conds {
+		cond, err := renderConditionalTerm(rc, conds[i])
+		if err != nil {
+			return "", err
+		}
+
+		result, err := renderConditionalTerm(rc, results[i])
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(" WHEN ")
+		sb.WriteString(cond)
+		sb.WriteString(" THEN ")
+		sb.WriteString(result)
+	}
+
+	if elseNode := n.Else(); elseNode != nil {
+		val, err := renderConditionalTerm(rc, elseNode)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(" ELSE ")
+		sb.WriteString(val)
+	}
+
+	sb.WriteString(" END")
+	return sb.String(), nil
+}
+
+// renderConditionalTerm renders a condition or result
+// expression of an ast.ConditionalNode.
+func renderConditionalTerm(rc *Context, node ast.Node) (string, error) {
+	switch node := node.(type) {
+	case *ast.ColSelectorNode, *ast.TblColSelectorNode:
+		return renderSelectorNode(rc.Dialect, node)
+	case *ast.ExprNode:
+		return rc.Renderer.Expr(rc, node)
+	default:
+		return "", errz.Errorf("unexpected conditional expression child %T: %s", node, node.Text())
+	}
+}
@@ -1,6 +1,11 @@
 package render
 
-import "github.com/neilotoole/sq/libsq/ast"
+import (
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
 
 func doDistinct(_ *Context, n *ast.UniqueNode) (string, error) {
 	if n == nil {
@@ -8,3 +13,31 @@ func doDistinct(_ *Context, n *ast.UniqueNode) (string, error) {
 	}
 	return "DISTINCT", nil
 }
+
+// doUniqueBy implements Renderer.UniqueBy for dialects that don't
+// have DISTINCT ON. The query is rewritten to select the first row of
+// each distinct value of the unique columns: see renderRowNumberFilter.
+func doUniqueBy(rc *Context, n *ast.UniqueNode, ob *ast.OrderByNode, f *Fragments) error {
+	if f.GroupBy != "" {
+		return errz.Errorf("unique: column args can't be used with group_by for %s: %s", rc.Dialect, n.Text())
+	}
+
+	return renderRowNumberFilter(rc, n, n.Columns(), ob, 1, f)
+}
+
+// UniqueByDistinctOn is a Renderer.UniqueBy implementation for dialects
+// that support DISTINCT ON, such as Postgres. For example:
+//
+//	SELECT DISTINCT ON ("customer_id") * FROM "payment"
+//	  ORDER BY "customer_id", "payment_date" DESC
+func UniqueByDistinctOn(rc *Context, n *ast.UniqueNode, ob *ast.OrderByNode, f *Fragments) error {
+	renderSel := selectorRenderer(rc)
+	keys, err := renderSelectors(n, n.Columns(), renderSel)
+	if err != nil {
+		return err
+	}
+
+	f.Distinct = "DISTINCT ON (" + strings.Join(keys, ", ") + ")"
+	f.OrderBy, err = partitionOrderBy(rc, keys, ob, renderSel)
+	return err
+}
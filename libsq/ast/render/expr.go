@@ -5,62 +5,47 @@ import (
 
 	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/core/errz"
-	"github.com/neilotoole/sq/libsq/core/stringz"
 )
 
 func doExpr(rc *Context, expr *ast.ExprNode) (string, error) {
 	if expr == nil {
 		return "", nil
 	}
-	r := rc.Renderer
 
 	var sb strings.Builder
 	if expr.HasParens() {
 		sb.WriteRune('(')
 	}
 
-	for _, child := range expr.Children() {
-		switch child := child.(type) {
-		case *ast.TblColSelectorNode, *ast.ColSelectorNode:
-			val, err := renderSelectorNode(rc.Dialect, child)
-			if err != nil {
-				return "", err
-			}
-			sb.WriteString(val)
-		case *ast.OperatorNode:
-			val, err := r.Operator(rc, child)
+	children := expr.Children()
+	if len(children) == 3 && IsNullLiteral(children[0]) {
+		// The operands of a comparison against null are swapped, so that
+		// "null == .x" is rendered as "x IS NULL", just like ".x == null".
+		if op, ok := children[1].(*ast.OperatorNode); ok && (op.Text() == "==" || op.Text() == "!=") {
+			val, err := renderExprChild(rc, children[2])
 			if err != nil {
 				return "", err
 			}
+			children = nil
+			sb.WriteString(renderIsNull(val, op.Text() == "!="))
+		}
+	}
 
-			sb.WriteString(val)
-		case *ast.ArgNode:
-			if rc.Args != nil {
-				val, ok := rc.Args[child.Key()]
-				if ok {
-					sb.WriteString(stringz.SingleQuote(val))
-					break
-				}
-			}
+	if isCoalesceExpr(expr) {
+		val, err := renderCoalesce(rc, expr)
+		if err != nil {
+			return "", err
+		}
+		children = nil
+		sb.WriteString(val)
+	}
 
-			// It's an error if the arg is not supplied.
-			return "", errz.Errorf("no --arg value found for query variable %s", child.Text())
-		case *ast.ExprNode:
-			val, err := r.Expr(rc, child)
-			if err != nil {
-				return "", err
-			}
-			sb.WriteString(val)
-		case *ast.LiteralNode:
-			val, err := r.Literal(rc, child)
-			if err != nil {
-				return "", err
-			}
-			sb.WriteString(val)
-		default:
-			// Shouldn't happen? Need to investigate.
-			sb.WriteString(child.Text())
+	for _, child := range children {
+		val, err := renderExprChild(rc, child)
+		if err != nil {
+			return "", err
 		}
+		sb.WriteString(val)
 	}
 
 	if expr.HasParens() {
@@ -69,3 +54,89 @@ func doExpr(rc *Context, expr *ast.ExprNode) (string, error) {
 
 	return sb.String(), nil
 }
+
+// renderExprChild renders child, which is a child node of an ast.ExprNode.
+func renderExprChild(rc *Context, child ast.Node) (string, error) {
+	r := rc.Renderer
+	switch child := child.(type) {
+	case *ast.TblColSelectorNode, *ast.ColSelectorNode:
+		return renderSelectorNode(rc.Dialect, child)
+	case *ast.OperatorNode:
+		return r.Operator(rc, child)
+	case *ast.ArgNode:
+		if rc.Args != nil {
+			val, ok := rc.Args[child.Key()]
+			if ok {
+				return renderString(rc, val), nil
+			}
+		}
+
+		// It's an error if the arg is not supplied.
+		return "", errz.Errorf("no --arg value found for query variable %s", child.Text())
+	case *ast.ExprNode:
+		return r.Expr(rc, child)
+	case *ast.LiteralNode:
+		return r.Literal(rc, child)
+	case *ast.FuncNode:
+		return r.Function(rc, child)
+	case *ast.ConditionalNode:
+		return r.Conditional(rc, child)
+	case *ast.SubqueryNode:
+		return r.Subquery(rc, child)
+	case *ast.InNode:
+		return r.In(rc, child)
+	case *ast.BetweenNode:
+		return r.Between(rc, child)
+	case *ast.LikeNode:
+		return r.Like(rc, child)
+	case *ast.IsNode:
+		return r.Is(rc, child)
+	default:
+		// Shouldn't happen? Need to investigate.
+		return child.Text(), nil
+	}
+}
+
+// isCoalesceExpr returns true if expr is a null-coalescing
+// expression, e.g. ".a // .b".
+func isCoalesceExpr(expr *ast.ExprNode) bool {
+	children := expr.Children()
+	if len(children) != 3 {
+		return false
+	}
+
+	op, ok := children[1].(*ast.OperatorNode)
+	return ok && op.Text() == "//"
+}
+
+// renderCoalesce renders the null-coalescing expression expr, e.g.
+// ".a // .b // .c", as "coalesce(a, b, c)". A chain of "//" operators
+// is rendered as a single coalesce call. The parens of expr itself,
+// if any, are rendered by the caller.
+func renderCoalesce(rc *Context, expr *ast.ExprNode) (string, error) {
+	operands := coalesceOperands(expr, nil)
+	vals := make([]string, len(operands))
+	for i, operand := range operands {
+		var err error
+		if vals[i], err = renderExprChild(rc, operand); err != nil {
+			return "", err
+		}
+	}
+
+	return "coalesce(" + strings.Join(vals, ", ") + ")", nil
+}
+
+// coalesceOperands appends the operands of the null-coalescing
+// expression expr to operands, flattening any nested (unparenthesized)
+// null-coalescing expression.
+func coalesceOperands(expr *ast.ExprNode, operands []ast.Node) []ast.Node {
+	children := expr.Children()
+	for _, child := range []ast.Node{children[0], children[2]} {
+		if nested, ok := child.(*ast.ExprNode); ok && !nested.HasParens() && isCoalesceExpr(nested) {
+			operands = coalesceOperands(nested, operands)
+			continue
+		}
+		operands = append(operands, child)
+	}
+	return operands
+}
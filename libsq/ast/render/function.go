@@ -1,18 +1,41 @@
 package render
 
 import (
+	"encoding/json"
+	"strconv"
 	"strings"
 
 	"github.com/neilotoole/sq/libsq/core/stringz"
 
 	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
 )
 
 func doFunction(rc *Context, fn *ast.FuncNode) (string, error) {
-	sb := strings.Builder{}
 	fnName := strings.ToLower(fn.FuncName())
-	children := fn.Children()
+	r := rc.Renderer
+
+	if !fn.IsProprietary() {
+		if err := checkFuncArgs(fn); err != nil {
+			return "", err
+		}
+
+		if override, ok := r.FunctionOverrides[fnName]; ok {
+			sql, err := override(rc, fn)
+			if err != nil {
+				return "", err
+			}
+			return appendWindow(rc, fn, sql)
+		}
+
+		if name, ok := r.FunctionNames[fnName]; ok {
+			fnName = name
+		}
+	}
+
+	sb := strings.Builder{}
+	children := fn.Args()
 
 	if len(children) == 0 {
 		sb.WriteString(fnName)
@@ -25,7 +48,7 @@ func doFunction(rc *Context, fn *ast.FuncNode) (string, error) {
 		}
 
 		sb.WriteRune(')')
-		return sb.String(), nil
+		return appendWindow(rc, fn, sb.String())
 	}
 
 	// Special handling for "count_unique(.col)" function. We translate
@@ -37,47 +60,331 @@ func doFunction(rc *Context, fn *ast.FuncNode) (string, error) {
 		sb.WriteRune('(')
 	}
 
-	for i, child := range children {
-		if i > 0 {
-			sb.WriteString(", ")
-		}
+	args, err := FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+	sb.WriteString(strings.Join(args, ", "))
+
+	sb.WriteRune(')')
+	return appendWindow(rc, fn, sb.String())
+}
 
+// FuncArgs returns the rendered arguments of fn. It is
+// intended for use by Renderer.FunctionOverrides funcs.
+func FuncArgs(rc *Context, fn *ast.FuncNode) ([]string, error) {
+	children := fn.Args()
+	args := make([]string, len(children))
+	for i, child := range children {
 		switch node := child.(type) {
 		case *ast.ColSelectorNode, *ast.TblColSelectorNode, *ast.TblSelectorNode:
 			s, err := renderSelectorNode(rc.Dialect, node)
 			if err != nil {
-				return "", err
+				return nil, err
 			}
-			sb.WriteString(s)
+			args[i] = s
 		case *ast.OperatorNode:
-			sb.WriteString(node.Text())
+			args[i] = node.Text()
 		case *ast.LiteralNode:
-			// TODO: This is all a bit of a mess. We probably need to
-			// move to using bound parameters instead of inlining
-			// literal values.
-			val, wasQuoted, err := unquoteLiteral(node.Text())
+			s, err := rc.Renderer.Literal(rc, node)
 			if err != nil {
-				return "", err
-			}
-
-			if wasQuoted {
-				// The literal had quotes, so it's a regular string.
-				sb.WriteString(stringz.SingleQuote(val))
-			} else {
-				sb.WriteString(val)
+				return nil, err
 			}
+			args[i] = s
 		case *ast.ExprNode:
 			s, err := rc.Renderer.Expr(rc, node)
 			if err != nil {
-				return "", err
+				return nil, err
 			}
-			sb.WriteString(s)
+			args[i] = s
 		default:
-			return "", errz.Errorf("unknown AST child node %T: %s", node, node)
+			return nil, errz.Errorf("unknown AST child node %T: %s", node, node)
 		}
 	}
 
-	sb.WriteRune(')')
-	sql := sb.String()
-	return sql, nil
+	return args, nil
+}
+
+// Date units, as used by the date_trunc, extract and date_add functions.
+const (
+	UnitYear   = "year"
+	UnitMonth  = "month"
+	UnitDay    = "day"
+	UnitHour   = "hour"
+	UnitMinute = "minute"
+	UnitSecond = "second"
+)
+
+// FuncUnitArg returns the date unit (e.g. UnitMonth) that is the
+// argument of fn at index i. The argument must be a string literal.
+func FuncUnitArg(fn *ast.FuncNode, i int) (string, error) {
+	args := fn.Args()
+	if i >= len(args) {
+		return "", errz.Errorf("function %s: missing unit argument", fn.FuncName())
+	}
+
+	lit, ok := ast.NodeUnwrap[*ast.LiteralNode](args[i])
+	if ok && lit.LiteralType() == ast.LiteralString {
+		unit := strings.ToLower(stringz.StripDoubleQuote(lit.Text()))
+		switch unit {
+		case UnitYear, UnitMonth, UnitDay, UnitHour, UnitMinute, UnitSecond:
+			return unit, nil
+		}
+	}
+
+	return "", errz.Errorf("function %s: unit must be one of %q, %q, %q, %q, %q or %q, but got: %s",
+		fn.FuncName(), UnitYear, UnitMonth, UnitDay, UnitHour, UnitMinute, UnitSecond, args[i].Text())
+}
+
+// FuncKindArg returns the kind that is the argument of fn at index i,
+// as used by the cast function. The argument must be a string literal
+// naming a kind, e.g. "int". The unknown and null kinds are rejected.
+func FuncKindArg(fn *ast.FuncNode, i int) (kind.Kind, error) {
+	args := fn.Args()
+	if i >= len(args) {
+		return kind.Unknown, errz.Errorf("function %s: missing kind argument", fn.FuncName())
+	}
+
+	lit, ok := ast.NodeUnwrap[*ast.LiteralNode](args[i])
+	if ok && lit.LiteralType() == ast.LiteralString {
+		var knd kind.Kind
+		err := knd.UnmarshalText([]byte(stringz.StripDoubleQuote(lit.Text())))
+		if err == nil && knd != kind.Unknown && knd != kind.Null {
+			return knd, nil
+		}
+	}
+
+	return kind.Unknown, errz.Errorf("function %s: kind must be one of %q, %q, %q, %q, %q, %q, %q, %q or %q, but got: %s",
+		fn.FuncName(), kind.Text, kind.Int, kind.Float, kind.Decimal, kind.Bool,
+		kind.Datetime, kind.Date, kind.Time, kind.Bytes, args[i].Text())
+}
+
+// FuncStringArg returns the value of the string literal that is the
+// argument of fn at index i, with its escape sequences interpreted (as
+// for a LIKE pattern), so that "\t" is a tab. If fn has no argument at
+// index i, dflt is returned.
+func FuncStringArg(fn *ast.FuncNode, i int, dflt string) (string, error) {
+	args := fn.Args()
+	if i >= len(args) {
+		return dflt, nil
+	}
+
+	lit, ok := ast.NodeUnwrap[*ast.LiteralNode](args[i])
+	if !ok || lit.LiteralType() != ast.LiteralString {
+		return "", errz.Errorf("function %s: argument %d must be a string literal, but got: %s",
+			fn.FuncName(), i+1, args[i].Text())
+	}
+
+	var val string
+	if err := json.Unmarshal([]byte(lit.Text()), &val); err != nil {
+		return "", errz.Wrapf(err, "function %s: invalid string argument %d: %s", fn.FuncName(), i+1, lit.Text())
+	}
+	return val, nil
+}
+
+// FuncFractionArg returns the number between 0 and 1 that is the
+// argument of fn at index i, as used by the percentile_cont function.
+func FuncFractionArg(fn *ast.FuncNode, i int) (string, error) {
+	args := fn.Args()
+	if i >= len(args) {
+		return "", errz.Errorf("function %s: missing fraction argument", fn.FuncName())
+	}
+
+	lit, ok := ast.NodeUnwrap[*ast.LiteralNode](args[i])
+	if ok && (lit.LiteralType() == ast.LiteralAnyNumber || lit.LiteralType() == ast.LiteralNaturalNumber) {
+		if f, err := strconv.ParseFloat(lit.Text(), 64); err == nil && f >= 0 && f <= 1 {
+			return lit.Text(), nil
+		}
+	}
+
+	return "", errz.Errorf("function %s: fraction must be a number between 0 and 1, but got: %s",
+		fn.FuncName(), args[i].Text())
+}
+
+// funcArity is the minimum and maximum argument count of a portable
+// function. A max of -1 indicates no upper bound.
+type funcArity struct {
+	min, max int
+}
+
+// portableFuncs is the set of portable functions (beyond the aggregate
+// and window functions) whose arguments are checked by checkFuncArgs.
+var portableFuncs = map[string]funcArity{
+	"upper":      {1, 1},
+	"lower":      {1, 1},
+	"trim":       {1, 1},
+	"substr":     {2, 3},
+	"length":     {1, 1},
+	"replace":    {3, 3},
+	"concat":     {2, -1},
+	"round":      {1, 2},
+	"abs":        {1, 1},
+	"ceil":       {1, 1},
+	"floor":      {1, 1},
+	"now":        {0, 0},
+	"date_trunc": {2, 2},
+	"extract":    {2, 2},
+	"date_add":   {3, 3},
+	"coalesce":   {1, -1},
+	"nullif":     {2, 2},
+	"cast":       {2, 2},
+
+	"median":          {1, 1},
+	"percentile_cont": {2, 2},
+	"stddev":          {1, 1},
+	"variance":        {1, 1},
+	"string_agg":      {1, 2},
+}
+
+// checkFuncArgs returns an error if fn is a portable function
+// and has the wrong number of arguments.
+func checkFuncArgs(fn *ast.FuncNode) error {
+	name := strings.ToLower(fn.FuncName())
+	arity, ok := portableFuncs[name]
+	if !ok {
+		return nil
+	}
+
+	n := len(fn.Args())
+	switch {
+	case n < arity.min:
+		return errz.Errorf("function %s: expected at least %d argument(s) but got %d", name, arity.min, n)
+	case arity.max != -1 && n > arity.max:
+		return errz.Errorf("function %s: expected at most %d argument(s) but got %d", name, arity.max, n)
+	default:
+		return nil
+	}
+}
+
+// doFuncDateTrunc renders date_trunc(unit, expr) as the standard
+// "date_trunc('unit', expr)".
+func doFuncDateTrunc(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, err := FuncUnitArg(fn, 0)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "date_trunc(" + stringz.SingleQuote(unit) + ", " + args[1] + ")", nil
+}
+
+// doFuncExtract renders extract(unit, expr) as the
+// standard "EXTRACT(UNIT FROM expr)".
+func doFuncExtract(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, err := FuncUnitArg(fn, 0)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "EXTRACT(" + strings.ToUpper(unit) + " FROM " + args[1] + ")", nil
+}
+
+// doFuncDateAdd renders date_add(expr, n, unit) as the
+// standard "(expr + n * INTERVAL '1 unit')".
+func doFuncDateAdd(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, err := FuncUnitArg(fn, 2)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "(" + args[0] + " + " + args[1] + " * INTERVAL '1 " + unit + "')", nil
+}
+
+// doFuncCast renders cast(expr, kind) as the standard
+// "CAST(expr AS TYPE)", where TYPE is given by Renderer.TypeName.
+func doFuncCast(rc *Context, fn *ast.FuncNode) (string, error) {
+	knd, err := FuncKindArg(fn, 1)
+	if err != nil {
+		return "", err
+	}
+
+	if rc.Renderer.TypeName == nil {
+		return "", errz.Errorf("function %s: not supported by SQL dialect %s", fn.FuncName(), rc.Dialect.Type)
+	}
+
+	args, err := FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "CAST(" + args[0] + " AS " + rc.Renderer.TypeName(knd) + ")", nil
+}
+
+// doFuncMedian renders median(expr) as the standard
+// "percentile_cont(0.5) WITHIN GROUP (ORDER BY expr)".
+func doFuncMedian(rc *Context, fn *ast.FuncNode) (string, error) {
+	args, err := FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "percentile_cont(0.5) WITHIN GROUP (ORDER BY " + args[0] + ")", nil
+}
+
+// doFuncPercentileCont renders percentile_cont(expr, fraction) as the
+// standard "percentile_cont(fraction) WITHIN GROUP (ORDER BY expr)".
+func doFuncPercentileCont(rc *Context, fn *ast.FuncNode) (string, error) {
+	fraction, err := FuncFractionArg(fn, 1)
+	if err != nil {
+		return "", err
+	}
+
+	args, err := FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	return "percentile_cont(" + fraction + ") WITHIN GROUP (ORDER BY " + args[0] + ")", nil
+}
+
+// doFuncStringAgg renders string_agg(expr, sep) as the standard
+// "string_agg(expr, sep)". If the dialect has a TypeName, expr is
+// cast to text, as string_agg typically only accepts text.
+func doFuncStringAgg(rc *Context, fn *ast.FuncNode) (string, error) {
+	sep, err := FuncStringArg(fn, 1, ",")
+	if err != nil {
+		return "", err
+	}
+
+	args, err := FuncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	expr := args[0]
+	if rc.Renderer.TypeName != nil {
+		expr = "CAST(" + expr + " AS " + rc.Renderer.TypeName(kind.Text) + ")"
+	}
+
+	return "string_agg(" + expr + ", " + renderString(rc, sep) + ")", nil
+}
+
+// appendWindow appends the rendered OVER clause to sql, if fn
+// has a window. If not, sql is returned unchanged.
+func appendWindow(rc *Context, fn *ast.FuncNode, sql string) (string, error) {
+	w := fn.Window()
+	if w == nil {
+		return sql, nil
+	}
+
+	over, err := rc.Renderer.Window(rc, fn, w)
+	if err != nil {
+		return "", err
+	}
+
+	return sqlAppend(sql, over), nil
 }
@@ -1,6 +1,7 @@
 package render
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/neilotoole/sq/libsq/ast"
@@ -12,34 +13,304 @@ func doGroupBy(rc *Context, gb *ast.GroupByNode) (string, error) {
 		return "", nil
 	}
 
-	var (
-		term string
-		err  error
-		sb   strings.Builder
-	)
+	terms, err := renderGroupByTerms(rc, gb.Children())
+	if err != nil {
+		return "", err
+	}
 
-	sb.WriteString("GROUP BY ")
-	for i, child := range gb.Children() {
-		if i > 0 {
-			sb.WriteString(", ")
-		}
+	return "GROUP BY " + strings.Join(terms, ", "), nil
+}
 
+// renderGroupByTerms renders the terms of a GROUP BY, or of a grouping.
+// A GroupingNode is rendered natively, e.g. "ROLLUP("a", "b")".
+func renderGroupByTerms(rc *Context, nodes []ast.Node) ([]string, error) {
+	var err error
+	terms := make([]string, len(nodes))
+	for i, child := range nodes {
 		switch child := child.(type) {
 		case *ast.FuncNode:
-			if term, err = rc.Renderer.Function(rc, child); err != nil {
-				return "", err
+			if terms[i], err = rc.Renderer.Function(rc, child); err != nil {
+				return nil, err
+			}
+		case *ast.ConditionalNode:
+			if terms[i], err = rc.Renderer.Conditional(rc, child); err != nil {
+				return nil, err
 			}
 		case ast.Selector:
-			if term, err = renderSelectorNode(rc.Dialect, child); err != nil {
-				return "", err
+			if terms[i], err = renderSelectorNode(rc.Dialect, child); err != nil {
+				return nil, err
+			}
+		case *ast.GroupingNode:
+			if terms[i], err = renderGrouping(rc, child); err != nil {
+				return nil, err
+			}
+		case *ast.GroupingSetNode:
+			var set []string
+			if set, err = renderGroupByTerms(rc, child.Children()); err != nil {
+				return nil, err
 			}
+			terms[i] = "(" + strings.Join(set, ", ") + ")"
 		default:
 			// Should never happen
-			return "", errz.Errorf("invalid child type: %T: %s", child, child)
+			return nil, errz.Errorf("invalid child type: %T: %s", child, child)
+		}
+	}
+
+	return terms, nil
+}
+
+// renderGrouping renders g as ROLLUP, CUBE or GROUPING SETS.
+func renderGrouping(rc *Context, g *ast.GroupingNode) (string, error) {
+	var keyword string
+	switch g.Type() {
+	case ast.GroupingRollup:
+		keyword = "ROLLUP"
+	case ast.GroupingCube:
+		keyword = "CUBE"
+	case ast.GroupingSets:
+		keyword = "GROUPING SETS"
+	default:
+		return "", errz.Errorf("unknown grouping type: %s", g.Type())
+	}
+
+	terms, err := renderGroupByTerms(rc, g.Children())
+	if err != nil {
+		return "", err
+	}
+
+	return keyword + "(" + strings.Join(terms, ", ") + ")", nil
+}
+
+// GroupingsUnionAll is a Renderer.Groupings implementation for dialects,
+// such as SQLite, that don't have ROLLUP, CUBE or GROUPING SETS. The query
+// is rewritten as the UNION ALL of a query for each of the grouping sets
+// (see ast.GroupByNode.GroupingSets), in which the selected columns that
+// the set isn't grouped by are NULL. For example, "group_by(rollup(.a, .b))":
+//
+//	SELECT "a", "b", count(*) FROM "t" GROUP BY "a", "b"
+//	  UNION ALL SELECT "a", NULL AS "b", count(*) FROM "t" GROUP BY "a"
+//	  UNION ALL SELECT NULL AS "a", NULL AS "b", count(*) FROM "t"
+//
+// A selected column is matched to a grouping term by its rendered SQL,
+// so it must be selected in the same form as it is grouped by. If the
+// query has an ORDER BY, the UNION ALL is wrapped in a subquery, so that
+// the ORDER BY can reference expressions of the selected columns.
+func GroupingsUnionAll(rc *Context, gb *ast.GroupByNode, cols []ast.ResultColumn, f *Fragments) error {
+	if len(cols) == 0 {
+		return errz.Errorf("%s: the columns must be selected explicitly for %s", gb.Text(), rc.Dialect)
+	}
+
+	// The selected columns, rendered with and without their alias.
+	exprs := make([]string, len(cols))
+	vals := make([]string, len(cols))
+	for i, col := range cols {
+		var err error
+		if exprs[i], err = renderResultColumn(rc, col); err != nil {
+			return err
+		}
+
+		vals[i] = exprs[i]
+		if col.Alias() != "" {
+			vals[i] += " AS " + rc.Dialect.Enquote(col.Alias())
+		}
+	}
+
+	groupingSets := gb.GroupingSets()
+	sets := make([][]string, len(groupingSets))
+	for i := range groupingSets {
+		var err error
+		if sets[i], err = renderGroupByTerms(rc, groupingSets[i]); err != nil {
+			return err
+		}
+	}
+
+	// The partial terms are those that some of the sets aren't grouped by.
+	var partial []string
+	for _, set := range sets {
+		for _, term := range set {
+			if !slices.Contains(partial, term) && slices.ContainsFunc(sets, func(set []string) bool {
+				return !slices.Contains(set, term)
+			}) {
+				partial = append(partial, term)
+			}
+		}
+	}
+
+	if err := checkHavingTerms(rc, gb, partial); err != nil {
+		return err
+	}
+
+	queries := make([]string, len(sets))
+	for i, set := range sets {
+		setVals := slices.Clone(vals)
+		for j, expr := range exprs {
+			if !slices.Contains(partial, expr) || slices.Contains(set, expr) {
+				continue
+			}
+
+			name := cols[j].Alias()
+			if name == "" {
+				switch col := cols[j].(type) {
+				case *ast.ColSelectorNode:
+					name = col.ColName()
+				case *ast.TblColSelectorNode:
+					name = col.ColName()
+				default:
+					return errz.Errorf("%s: column %s must have an alias", gb.Text(), col.Text())
+				}
+			}
+
+			setVals[j] = "NULL AS " + rc.Dialect.Enquote(name)
+		}
+
+		var groupBy string
+		if len(set) > 0 {
+			groupBy = "GROUP BY " + strings.Join(set, ", ")
+		}
+
+		if i == 0 {
+			// The first query is the query of f itself.
+			f.Columns = strings.Join(setVals, ", ")
+			f.GroupBy = groupBy
+			continue
 		}
 
-		sb.WriteString(term)
+		var err error
+		queries[i], err = rc.Renderer.Render(rc, &Fragments{
+			Distinct: f.Distinct,
+			Columns:  strings.Join(setVals, ", "),
+			From:     f.From,
+			Where:    f.Where,
+			GroupBy:  groupBy,
+			Having:   f.Having,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	op, err := rc.Renderer.SetOp(rc, ast.SetOpUnionAll)
+	if err != nil {
+		return err
+	}
+
+	for i := range queries[1:] {
+		queries[i+1] = op + " " + queries[i+1]
+	}
+	f.SetOps = strings.Join(queries[1:], " ")
+
+	if f.OrderBy == "" {
+		return nil
+	}
+
+	// The ORDER BY of a compound query can only reference its result
+	// columns, and not, say, an expression of them. Thus, the compound
+	// query is wrapped in a subquery, to which the ORDER BY is applied.
+	// A top() or unique() of columns wraps the query itself.
+	insp := ast.NewInspector(nodeAST(gb))
+	if top, _ := insp.FindTopNode(); top != nil {
+		return nil
+	}
+	if u, _ := insp.FindUniqueNode(); u != nil && len(u.Columns()) > 0 {
+		return nil
+	}
+
+	ob, err := insp.FindOrderByNode()
+	if err != nil {
+		return err
+	}
+
+	var terms []string
+	if terms, err = orderByTerms(rc, ob, colNameRenderer(rc)); err != nil {
+		return err
+	}
+
+	var query string
+	if query, err = rc.Renderer.Render(rc, &Fragments{
+		Distinct: f.Distinct,
+		Columns:  f.Columns,
+		From:     f.From,
+		Where:    f.Where,
+		GroupBy:  f.GroupBy,
+		Having:   f.Having,
+		SetOps:   f.SetOps,
+	}); err != nil {
+		return err
+	}
+
+	alias := rc.Dialect.Enquote("sq_groupings")
+	f.Distinct, f.Where, f.GroupBy, f.Having, f.SetOps = "", "", "", "", ""
+	f.Columns = "*"
+	f.From = "FROM (" + query + ") AS " + alias
+	f.OrderBy = "ORDER BY " + strings.Join(terms, ", ")
+	return nil
+}
+
+// aggregateFuncs are the names of the SLQ aggregate functions.
+var aggregateFuncs = []string{
+	"avg", "count", "max", "median", "min", "percentile_cont", "stddev", "string_agg", "sum", "variance",
+}
+
+// checkHavingTerms returns an error if the HAVING clause of the query that
+// gb is part of references any of terms, other than in an aggregate function.
+// In GroupingsUnionAll's query for a grouping set that isn't grouped by such a
+// term, the term would be evaluated for an arbitrary row of each group, instead
+// of being NULL, as it is for native groupings.
+func checkHavingTerms(rc *Context, gb *ast.GroupByNode, terms []string) error {
+	a := nodeAST(gb)
+	if a == nil {
+		return errz.Errorf("%s: node is not part of an AST", gb.Text())
+	}
+
+	having, err := ast.NewInspector(a).FindHavingNode()
+	if err != nil || having == nil {
+		return err
+	}
+
+	var walk func(node ast.Node) error
+	walk = func(node ast.Node) error {
+		switch node := node.(type) {
+		case *ast.FuncNode:
+			if slices.Contains(aggregateFuncs, node.FuncName()) {
+				return nil
+			}
+		case ast.Selector:
+			if term, err := renderSelectorNode(rc.Dialect, node); err == nil && slices.Contains(terms, term) {
+				return errz.Errorf("%s: a where() after group_by can't reference column %s for %s",
+					gb.Text(), node.Text(), rc.Dialect)
+			}
+		}
+
+		for _, child := range node.Children() {
+			if err := walk(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return walk(having)
+}
+
+// GroupingsWithRollup is a Renderer.Groupings implementation for dialects,
+// such as MySQL, that support ROLLUP only via the "WITH ROLLUP" modifier,
+// which applies to all of the GROUP BY terms. Thus, if the GROUP BY has a
+// single term, which is a rollup, it is rendered as:
+//
+//	GROUP BY "a", "b" WITH ROLLUP
+//
+// Otherwise, the query is rewritten using GroupingsUnionAll.
+func GroupingsWithRollup(rc *Context, gb *ast.GroupByNode, cols []ast.ResultColumn, f *Fragments) error {
+	children := gb.Children()
+	if g, ok := children[0].(*ast.GroupingNode); !ok || len(children) > 1 || g.Type() != ast.GroupingRollup {
+		return GroupingsUnionAll(rc, gb, cols, f)
+	}
+
+	terms, err := renderGroupByTerms(rc, children[0].Children())
+	if err != nil {
+		return err
 	}
 
-	return sb.String(), nil
+	f.GroupBy = "GROUP BY " + strings.Join(terms, ", ") + " WITH ROLLUP"
+	return nil
 }
@@ -1 +1,33 @@
 package render
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/libsq/driver/dialect"
+)
+
+// TestParams_Bind verifies that, with numbered placeholders, a marker
+// that is rendered more than once is bound once, but distinct markers
+// are bound separately, even if their values are equal.
+func TestParams_Bind(t *testing.T) {
+	numbered := dialect.Dialect{
+		Placeholders: func(numCols, numRows int) string {
+			rows := make([]string, numRows)
+			for i := range rows {
+				rows[i] = fmt.Sprintf("($%d)", i+1)
+			}
+			return strings.Join(rows, ", ")
+		},
+	}
+
+	p := &Params{}
+	a, b := p.Add("X"), p.Add("X")
+	gotSQL, gotArgs, err := p.Bind(numbered, "a = "+a+" OR b = "+b+" OR c = "+a)
+	require.NoError(t, err)
+	require.Equal(t, "a = $1 OR b = $2 OR c = $1", gotSQL)
+	require.Equal(t, []any{"X", "X"}, gotArgs)
+}
@@ -1,6 +1,8 @@
 package render
 
 import (
+	"strings"
+
 	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/core/errz"
 )
@@ -10,31 +12,99 @@ func doOrderBy(rc *Context, ob *ast.OrderByNode) (string, error) {
 		return "", nil
 	}
 
+	terms, err := orderByTerms(rc, ob, selectorRenderer(rc))
+	if err != nil {
+		return "", err
+	}
+
+	return "ORDER BY " + strings.Join(terms, ", "), nil
+}
+
+// orderByTerms returns the rendered terms of ob, e.g. `"payment_date" DESC`,
+// using renderSel to render the terms that are selectors.
+func orderByTerms(rc *Context, ob *ast.OrderByNode, renderSel func(ast.Node) (string, error)) ([]string, error) {
 	terms := ob.Terms()
 	if len(terms) == 0 {
-		return "", errz.Errorf("%T has no ordering terms: %s", ob, ob)
+		return nil, errz.Errorf("%T has no ordering terms: %s", ob, ob)
 	}
 
-	clause := "ORDER BY "
+	vals := make([]string, len(terms))
 	for i := 0; i < len(terms); i++ {
-		if i > 0 {
-			clause += ", "
-		}
+		var (
+			expr string
+			err  error
+		)
 
-		sel, err := renderSelectorNode(rc.Dialect, terms[i].Selector())
+		switch node := terms[i].Expr().(type) {
+		case *ast.ExprNode:
+			expr, err = rc.Renderer.Expr(rc, node)
+		default:
+			expr, err = renderSel(node)
+		}
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
-		clause += sel
-		switch terms[i].Direction() { //nolint:exhaustive
-		case ast.OrderByDirectionAsc:
-			clause += " ASC"
-		case ast.OrderByDirectionDesc:
-			clause += " DESC"
-		default:
+		if vals[i], err = rc.Renderer.OrderByTerm(rc, terms[i], expr); err != nil {
+			return nil, err
 		}
 	}
 
-	return clause, nil
+	return vals, nil
+}
+
+// doOrderByTerm implements Renderer.OrderByTerm.
+func doOrderByTerm(_ *Context, term *ast.OrderByTermNode, expr string) (string, error) {
+	switch term.Direction() { //nolint:exhaustive
+	case ast.OrderByDirectionAsc:
+		expr += " ASC"
+	case ast.OrderByDirectionDesc:
+		expr += " DESC"
+	default:
+	}
+
+	if term.Nulls() != ast.OrderByNullsDefault {
+		expr += " " + string(term.Nulls())
+	}
+
+	return expr, nil
+}
+
+// OrderByTermNullsEmulated is a Renderer.OrderByTerm implementation for
+// dialects, such as MySQL and SQL Server, that don't have NULLS FIRST or
+// NULLS LAST, and in which nulls are ordered as lower than any other value.
+// If the term's ordering of nulls differs from that, the nulls are ordered
+// by a preceding CASE term. For example, ".address2 nulls_last":
+//
+//	CASE WHEN "address2" IS NULL THEN 1 ELSE 0 END, "address2"
+func OrderByTermNullsEmulated(rc *Context, term *ast.OrderByTermNode, expr string) (string, error) {
+	var nullsFirst bool
+	switch term.Nulls() {
+	case ast.OrderByNullsDefault:
+		return doOrderByTerm(rc, term, expr)
+	case ast.OrderByNullsFirst:
+		nullsFirst = true
+	case ast.OrderByNullsLast:
+	default:
+		return "", errz.Errorf("unknown nulls ordering: %s", term.Nulls())
+	}
+
+	val := expr
+	switch term.Direction() { //nolint:exhaustive
+	case ast.OrderByDirectionAsc:
+		val += " ASC"
+	case ast.OrderByDirectionDesc:
+		val += " DESC"
+	default:
+	}
+
+	if nullsFirst == (term.Direction() != ast.OrderByDirectionDesc) {
+		// The nulls are already ordered as required.
+		return val, nil
+	}
+
+	if nullsFirst {
+		return "CASE WHEN " + expr + " IS NULL THEN 0 ELSE 1 END, " + val, nil
+	}
+	return "CASE WHEN " + expr + " IS NULL THEN 1 ELSE 0 END, " + val, nil
 }
@@ -0,0 +1,96 @@
+package render
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/driver/dialect"
+)
+
+// paramMarker brackets the index of a bound parameter in rendered SQL.
+// The fragments of a statement aren't necessarily rendered in the order
+// that they appear in the statement, so the markers are replaced with the
+// dialect's placeholders by Params.Bind, once the statement is complete.
+const paramMarker = "\x00"
+
+// Params collects the values of a statement's bound parameters, such
+// as string literals and --arg values. A statement and its nested
+// queries (subqueries and CTEs) share the same Params.
+type Params struct {
+	vals []any
+}
+
+// Add adds a parameter value, returning the marker
+// to be rendered in its place.
+func (p *Params) Add(val any) string {
+	p.vals = append(p.vals, val)
+	return paramMarker + strconv.Itoa(len(p.vals)-1) + paramMarker
+}
+
+// Bind returns sql with each parameter marker replaced by one of the
+// dialect's placeholders (as returned by dialect.Placeholders), in order
+// of appearance, and the corresponding args to be passed with sql to the
+// DB. If the dialect's placeholders are numbered (e.g. "$1"), a marker that
+// occurs more than once, such as when a fragment is repeated in a rewritten
+// query, is passed only once. Distinct markers are never merged, even if
+// their values are equal, as the DB may deduce a different type for each.
+func (p *Params) Bind(d dialect.Dialect, sql string) (string, []any, error) {
+	if p == nil || len(p.vals) == 0 {
+		return sql, nil, nil
+	}
+
+	// The parts alternate between SQL text and parameter index.
+	parts := strings.Split(sql, paramMarker)
+	if len(parts)%2 == 0 {
+		return "", nil, errz.Errorf("render: malformed parameter marker in SQL: %q", sql)
+	}
+
+	count := len(parts) / 2
+	phs := placeholders(d, max(count, 2))
+	numbered := phs[0] != phs[1]
+
+	var (
+		sb     strings.Builder
+		args   = make([]any, 0, count)
+		argPos = make(map[int]int, count)
+	)
+
+	for i, part := range parts {
+		if i%2 == 0 {
+			sb.WriteString(part)
+			continue
+		}
+
+		idx, err := strconv.Atoi(part)
+		if err != nil || idx < 0 || idx >= len(p.vals) {
+			return "", nil, errz.Errorf("render: invalid parameter marker %q in SQL: %q", part, sql)
+		}
+
+		if numbered {
+			if pos, ok := argPos[idx]; ok {
+				sb.WriteString(phs[pos])
+				continue
+			}
+			argPos[idx] = len(args)
+		}
+
+		sb.WriteString(phs[len(args)])
+		args = append(args, p.vals[idx])
+	}
+
+	return sb.String(), args, nil
+}
+
+// placeholders returns the first n individual placeholders of dialect d,
+// e.g. ["$1", "$2", "$3"] or ["?", "?", "?"].
+func placeholders(d dialect.Dialect, n int) []string {
+	// Each placeholder is returned as a row of one column,
+	// i.e. "($1), ($2), ($3)".
+	rows := strings.Split(d.Placeholders(1, n), ",")
+	phs := make([]string, len(rows))
+	for i := range rows {
+		phs[i] = strings.Trim(strings.TrimSpace(rows[i]), "()")
+	}
+	return phs
+}
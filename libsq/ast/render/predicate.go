@@ -0,0 +1,158 @@
+package render
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+func doIn(rc *Context, n *ast.InNode) (string, error) {
+	r := rc.Renderer
+	lhs, err := r.Expr(rc, n.Expr())
+	if err != nil {
+		return "", err
+	}
+
+	op := " IN "
+	if n.Negated() {
+		op = " NOT IN "
+	}
+
+	if sq := n.Subquery(); sq != nil {
+		var rhs string
+		if rhs, err = r.Subquery(rc, sq); err != nil {
+			return "", err
+		}
+		return lhs + op + rhs, nil
+	}
+
+	list := n.List()
+	if len(list) == 0 {
+		return "", errz.Errorf("'in' list must not be empty: %s", n.Text())
+	}
+
+	vals := make([]string, len(list))
+	for i := range list {
+		if vals[i], err = r.Expr(rc, list[i]); err != nil {
+			return "", err
+		}
+	}
+
+	return lhs + op + "(" + strings.Join(vals, ", ") + ")", nil
+}
+
+func doBetween(rc *Context, n *ast.BetweenNode) (string, error) {
+	r := rc.Renderer
+	terms := make([]string, 3)
+	for i, expr := range []*ast.ExprNode{n.Expr(), n.Low(), n.High()} {
+		var err error
+		if terms[i], err = r.Expr(rc, expr); err != nil {
+			return "", err
+		}
+	}
+
+	op := " BETWEEN "
+	if n.Negated() {
+		op = " NOT BETWEEN "
+	}
+
+	return terms[0] + op + terms[1] + " AND " + terms[2], nil
+}
+
+// likeEscape is the ESCAPE clause appended to a LIKE predicate
+// when its pattern contains a backslash.
+const likeEscape = ` ESCAPE '\'`
+
+func doLike(rc *Context, n *ast.LikeNode) (string, error) {
+	return renderLike(rc, n, likeEscape)
+}
+
+// LikeDefaultEscape is a Renderer.Like implementation for dialects, such
+// as MySQL, in which backslash is already the default LIKE escape character.
+// No ESCAPE clause is rendered: in a MySQL string literal, '\' would be
+// unterminated.
+func LikeDefaultEscape(rc *Context, n *ast.LikeNode) (string, error) {
+	return renderLike(rc, n, "")
+}
+
+// renderLike renders n, appending the escape clause, which may be empty,
+// if the pattern contains a backslash.
+func renderLike(rc *Context, n *ast.LikeNode, escapeClause string) (string, error) {
+	r := rc.Renderer
+	lhs, err := r.Expr(rc, n.Expr())
+	if err != nil {
+		return "", err
+	}
+
+	var pattern string
+	var escape bool
+	if lit, ok := ast.NodeUnwrap[*ast.LiteralNode](n.Pattern()); ok && lit.LiteralType() == ast.LiteralString {
+		// Unlike other string literals, the pattern's escape sequences
+		// are interpreted, so that "100\\%" matches the literal "100%".
+		var val string
+		if err = json.Unmarshal([]byte(lit.Text()), &val); err != nil {
+			return "", errz.Wrapf(err, "invalid 'like' pattern: %s", lit.Text())
+		}
+		pattern = renderString(rc, val)
+		escape = strings.ContainsRune(val, '\\')
+	} else if pattern, err = r.Expr(rc, n.Pattern()); err != nil {
+		return "", err
+	}
+
+	if n.CaseInsensitive() {
+		lhs = "lower(" + lhs + ")"
+		pattern = "lower(" + pattern + ")"
+	}
+
+	op := " LIKE "
+	if n.Negated() {
+		op = " NOT LIKE "
+	}
+
+	sql := lhs + op + pattern
+	if escape {
+		sql += escapeClause
+	}
+	return sql, nil
+}
+
+func doIs(rc *Context, n *ast.IsNode) (string, error) {
+	r := rc.Renderer
+	lhs, err := r.Expr(rc, n.Left())
+	if err != nil {
+		return "", err
+	}
+
+	rhs, err := r.Expr(rc, n.Right())
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case IsNullLiteral(n.Right()):
+		return renderIsNull(lhs, n.Negated()), nil
+	case IsNullLiteral(n.Left()):
+		return renderIsNull(rhs, n.Negated()), nil
+	}
+
+	if n.Negated() {
+		return lhs + " IS DISTINCT FROM " + rhs, nil
+	}
+	return lhs + " IS NOT DISTINCT FROM " + rhs, nil
+}
+
+// IsNullLiteral returns true if node is, or wraps, the null literal.
+func IsNullLiteral(node ast.Node) bool {
+	lit, ok := ast.NodeUnwrap[*ast.LiteralNode](node)
+	return ok && lit.LiteralType() == ast.LiteralNull
+}
+
+// renderIsNull returns "sql IS NULL", or "sql IS NOT NULL" if negated is true.
+func renderIsNull(sql string, negated bool) string {
+	if negated {
+		return sql + " IS NOT NULL"
+	}
+	return sql + " IS NULL"
+}
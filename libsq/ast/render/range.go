@@ -3,15 +3,22 @@ package render
 import (
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 
 	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
 )
 
-func doRange(_ *Context, rr *ast.RowRangeNode) (string, error) {
+func doRange(rc *Context, rr *ast.RowRangeNode) (string, error) {
 	if rr == nil {
 		return "", nil
 	}
 
+	if rr.Negative() {
+		return doRangeNegative(rc, rr)
+	}
+
 	if rr.Limit < 0 && rr.Offset < 0 {
 		return "", nil
 	}
@@ -34,3 +41,182 @@ func doRange(_ *Context, rr *ast.RowRangeNode) (string, error) {
 	sql := sqlAppend(limit, offset)
 	return sql, nil
 }
+
+// doRangeNegative renders a row range with a negative index, e.g.
+// ".[-10:]". The LIMIT and OFFSET are scalar subqueries against the
+// row count of the query, unless the start index is non-negative, e.g.
+// ".[2:-1]", in which case the OFFSET is literal.
+//
+//	LIMIT 9223372036854775807 OFFSET (SELECT CASE WHEN COUNT(*) > 10
+//	  THEN COUNT(*) - 10 ELSE 0 END FROM (SELECT * FROM "actor") AS "sq_range")
+func doRangeNegative(rc *Context, rr *ast.RowRangeNode) (string, error) {
+	start, end := RangeBounds(rr)
+
+	var (
+		offset = start
+		err    error
+	)
+	if i, _, _ := rr.Bounds(); i < 0 {
+		if offset, err = RangeSubquery(rc, start); err != nil {
+			return "", err
+		}
+	}
+
+	limit := fmt.Sprintf("%d", math.MaxInt64)
+	if _, _, open := rr.Bounds(); !open {
+		expr := fmt.Sprintf("CASE WHEN %s > %s THEN %s - %s ELSE 0 END", end, start, end, start)
+		if limit, err = RangeSubquery(rc, expr); err != nil {
+			return "", err
+		}
+	}
+
+	return "LIMIT " + limit + " OFFSET " + offset, nil
+}
+
+// RangeBounds returns SQL expressions for the start and end row indices
+// of rr, which must have a negative index (see ast.RowRangeNode.Negative).
+// A negative index is resolved relative to COUNT(*), and clamped at zero,
+// so the expressions must be evaluated against the rows of the query that
+// the range applies to, via RangeSubquery. If the range is open, e.g.
+// ".[-10:]", end is "COUNT(*)".
+func RangeBounds(rr *ast.RowRangeNode) (start, end string) {
+	i, j, open := rr.Bounds()
+	start = rangeIndexExpr(i)
+	if open {
+		return start, "COUNT(*)"
+	}
+	return start, rangeIndexExpr(j)
+}
+
+// rangeIndexExpr returns a SQL expression for the row range index i.
+// A negative index counts back from COUNT(*), but not beyond zero.
+func rangeIndexExpr(i int) string {
+	if i >= 0 {
+		return fmt.Sprintf("%d", i)
+	}
+	return fmt.Sprintf("CASE WHEN COUNT(*) > %d THEN COUNT(*) - %d ELSE 0 END", -i, -i)
+}
+
+// RangeSubquery returns a scalar subquery that evaluates expr, which
+// typically references COUNT(*), against the rows of the query that a
+// row range applies to, as rendered by Context.RangeQuery. For example:
+//
+//	(SELECT COUNT(*) - 10 FROM (SELECT * FROM "actor") AS "sq_range")
+func RangeSubquery(rc *Context, expr string) (string, error) {
+	if rc.RangeQuery == nil {
+		return "", errz.New("render context can't render row range with negative index")
+	}
+
+	sql, err := rc.RangeQuery(rc)
+	if err != nil {
+		return "", err
+	}
+
+	return "(SELECT " + expr + " FROM (" + sql + ") AS " + rc.Dialect.Enquote("sq_range") + ")", nil
+}
+
+// RangeRowNumber is a Renderer.RangeFilter implementation for dialects,
+// such as MySQL, that don't permit a subquery in LIMIT. The query's rows
+// are numbered using ROW_NUMBER(), per the query's ORDER BY, and counted
+// using COUNT(*) OVER (), in a derived table. The rows of the range are
+// then selected by their number. For example, for ".[-3:]":
+//
+//	SELECT "actor_id" FROM (SELECT "actor_id", ROW_NUMBER() OVER (ORDER BY
+//	  "actor_id") AS "sq_rn", COUNT(*) OVER () AS "sq_count" FROM "actor")
+//	  AS "sq_range" WHERE "sq_rn" > "sq_count" - 3 ORDER BY "sq_rn"
+//
+// The derived table's result columns are selected by name (see
+// resultColNames), so that "sq_rn" and "sq_count" are not selected. The
+// rows of a DISTINCT or compound (e.g. UNION) query can only be numbered
+// once they've been selected, so such a query is first made a derived table
+// itself, aliased to the query's table, so that the ORDER BY still resolves.
+func RangeRowNumber(rc *Context, rr *ast.RowRangeNode, f *Fragments) error {
+	a := nodeAST(rr)
+	if a == nil {
+		return errz.Errorf("%s: node is not part of an AST", rr.Text())
+	}
+
+	names, err := resultColNames(rc, rr)
+	if err != nil {
+		return err
+	}
+
+	if f.Distinct != "" || f.SetOps != "" {
+		alias := rc.Dialect.Enquote("sq_range")
+		insp := ast.NewInspector(a)
+		if tblSel := insp.FindFirstTableSelector(); tblSel != nil {
+			var joins []*ast.JoinNode
+			if joins, err = insp.FindJoins(); err != nil {
+				return err
+			}
+			if len(joins) == 0 {
+				alias = rc.Dialect.Enquote(tblSel.TblAliasOrName())
+			}
+		}
+
+		var query string
+		query, err = rc.Renderer.Render(rc, &Fragments{
+			Distinct: f.Distinct,
+			Columns:  f.Columns,
+			From:     f.From,
+			Where:    f.Where,
+			GroupBy:  f.GroupBy,
+			Having:   f.Having,
+			SetOps:   f.SetOps,
+		})
+		if err != nil {
+			return err
+		}
+
+		f.Distinct, f.Where, f.GroupBy, f.Having, f.SetOps = "", "", "", "", ""
+		f.Columns = alias + ".*"
+		f.From = "FROM (" + query + ") AS " + alias
+	} else if f.Columns == "*" {
+		f.Columns = strings.Join(names, ", ")
+	}
+
+	rn, count := rc.Dialect.Enquote("sq_rn"), rc.Dialect.Enquote("sq_count")
+	window := "ROW_NUMBER() OVER (" + f.OrderBy + ") AS " + rn +
+		", COUNT(*) OVER () AS " + count
+
+	numbered, err := rc.Renderer.Render(rc, &Fragments{
+		Columns: f.Columns + ", " + window,
+		From:    f.From,
+		Where:   f.Where,
+		GroupBy: f.GroupBy,
+		Having:  f.Having,
+	})
+	if err != nil {
+		return err
+	}
+
+	var conds []string
+	start, end, open := rr.Bounds()
+	if start != 0 {
+		conds = append(conds, rn+" > "+rangeRowNumberExpr(start, count))
+	}
+	if !open {
+		conds = append(conds, rn+" <= "+rangeRowNumberExpr(end, count))
+	}
+
+	alias := rc.Dialect.Enquote("sq_range")
+	f.Distinct, f.GroupBy, f.Having, f.SetOps, f.Range = "", "", "", "", ""
+	f.Columns = strings.Join(names, ", ")
+	f.From = "FROM (" + numbered + ") AS " + alias
+	f.Where = ""
+	if len(conds) > 0 {
+		f.Where = "WHERE " + strings.Join(conds, " AND ")
+	}
+	f.OrderBy = "ORDER BY " + rn
+	return nil
+}
+
+// rangeRowNumberExpr returns a SQL expression for the row number that
+// row range index i corresponds to, given the row count column count.
+// A negative index counts back from the row count.
+func rangeRowNumberExpr(i int, count string) string {
+	if i >= 0 {
+		return strconv.Itoa(i)
+	}
+	return count + " - " + strconv.Itoa(-i)
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/neilotoole/sq/libsq/driver/dialect"
 
 	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/stringz"
 
 	"github.com/neilotoole/sq/libsq/ast"
 )
@@ -22,6 +24,29 @@ type Context struct {
 	// The args map contains predefined variables that are
 	// substituted into the query. It may be empty or nil.
 	Args map[string]string
+
+	// Params collects the values of the query's bound parameters. If
+	// nil, literal values and args are inlined into the SQL instead.
+	Params *Params
+
+	// Nested renders a nested query, i.e. a subquery or the query of a
+	// CTE, as a complete SELECT statement. It is set by the query
+	// pipeline, which holds the model of each nested query. It may be
+	// nil, in which case queries with subqueries or CTEs can't be rendered.
+	Nested func(rc *Context, a *ast.AST) (string, error)
+
+	// RangeQuery renders the query that a row range applies to, i.e. the
+	// query sans its ORDER BY and row range, as a complete SELECT statement.
+	// A row range with a negative index, e.g. ".[-10:]", is resolved
+	// against the row count of that query: see RangeSubquery. It is set
+	// by the query pipeline when rendering a row range, and may be nil.
+	RangeQuery func(rc *Context) (string, error)
+
+	// TableCols returns the names of the columns of table tblName, for
+	// when all of a table's columns (i.e. "*") must be rendered explicitly,
+	// such as by Renderer.UniqueBy. It is set by the query pipeline, and
+	// may be nil.
+	TableCols func(tblName string) ([]string, error)
 }
 
 // Renderer is a set of functions for rendering ast elements into SQL.
@@ -40,34 +65,126 @@ type Renderer struct {
 	// Range renders a row range fragment.
 	Range func(rc *Context, rr *ast.RowRangeNode) (string, error)
 
+	// RangeFilter renders a row range with a negative index, e.g. ".[-10:]",
+	// for dialects that don't permit a subquery in LIMIT. Like UniqueBy, it
+	// is invoked after the other fragments of the query are rendered, and it
+	// modifies f. It is nil by default, in which case Range renders the row
+	// range. See RangeRowNumber.
+	RangeFilter func(rc *Context, rr *ast.RowRangeNode, f *Fragments) error
+
 	// OrderBy renders the ORDER BY fragment.
 	OrderBy func(rc *Context, ob *ast.OrderByNode) (string, error)
 
-	// GroupBy renders the GROUP BY fragment.
+	// OrderByTerm renders an ordering term, given its rendered selector
+	// or expression, e.g. `"payment_date"`. The default renders the term's
+	// direction, and NULLS FIRST or NULLS LAST; see OrderByTermNullsEmulated
+	// for dialects that don't support those.
+	OrderByTerm func(rc *Context, term *ast.OrderByTermNode, expr string) (string, error)
+
+	// GroupBy renders the GROUP BY fragment. Any subtotal groupings
+	// (rollup, cube, grouping_sets) are rendered natively, unless
+	// Groupings is set.
 	GroupBy func(rc *Context, gb *ast.GroupByNode) (string, error)
 
+	// Groupings renders a GROUP BY that has subtotal groupings, i.e.
+	// rollup, cube or grouping_sets, for dialects that don't support all of
+	// them natively. Like UniqueBy, it is invoked after the other fragments
+	// of the query are rendered, and it modifies f; cols are the query's
+	// selected columns. It is nil by default, in which case GroupBy renders
+	// the groupings. See GroupingsUnionAll and GroupingsWithRollup.
+	Groupings func(rc *Context, gb *ast.GroupByNode, cols []ast.ResultColumn, f *Fragments) error
+
 	// Join renders a join fragment.
 	Join func(rc *Context, leftTbl *ast.TblSelectorNode, joins []*ast.JoinNode) (string, error)
 
 	// Function renders a function fragment.
 	Function func(rc *Context, fn *ast.FuncNode) (string, error)
 
+	// FunctionNames is a map of SLQ function name (e.g. "length") to the
+	// SQL function name (e.g. "LEN") for the dialect. A function that is
+	// not in the map is rendered using its SLQ name.
+	FunctionNames map[string]string
+
+	// FunctionOverrides is a map of SLQ function name to a custom func
+	// that renders that function, for when the dialect's SQL differs by more
+	// than the function name. It takes precedence over FunctionNames.
+	FunctionOverrides map[string]func(rc *Context, fn *ast.FuncNode) (string, error)
+
+	// TypeName returns the dialect's native type (e.g. "BIGINT") for
+	// a kind. It is used to render the cast function, and should be
+	// the same mapping that the driver uses for CREATE TABLE. It is
+	// nil by default, in which case cast can't be rendered.
+	TypeName func(knd kind.Kind) string
+
+	// Window renders a window function's OVER clause, e.g.
+	// "OVER (PARTITION BY "customer_id" ORDER BY "payment_date" DESC)".
+	// It is invoked by Function when the FuncNode has a window.
+	Window func(rc *Context, fn *ast.FuncNode, w *ast.WindowNode) (string, error)
+
 	// Literal renders a literal fragment.
 	Literal func(rc *Context, lit *ast.LiteralNode) (string, error)
 
 	// Where renders a WHERE fragment.
 	Where func(rc *Context, where *ast.WhereNode) (string, error)
 
+	// Having renders a HAVING fragment.
+	Having func(rc *Context, having *ast.HavingNode) (string, error)
+
 	// Expr renders an expression fragment.
 	Expr func(rc *Context, expr *ast.ExprNode) (string, error)
 
 	// Operator renders an operator fragment.
 	Operator func(rc *Context, op *ast.OperatorNode) (string, error)
 
+	// SetOp renders a compound SELECT operator keyword, such
+	// as "UNION ALL" or "INTERSECT".
+	SetOp func(rc *Context, op ast.SetOpType) (string, error)
+
+	// Conditional renders a conditional (if-then-else) expression
+	// as a SQL CASE expression.
+	Conditional func(rc *Context, n *ast.ConditionalNode) (string, error)
+
+	// Subquery renders a subquery expression, e.g. "(SELECT ...)".
+	Subquery func(rc *Context, n *ast.SubqueryNode) (string, error)
+
+	// In renders an "IN" predicate, e.g. "actor_id IN (1, 2, 3)".
+	In func(rc *Context, n *ast.InNode) (string, error)
+
+	// Between renders a "BETWEEN" predicate.
+	Between func(rc *Context, n *ast.BetweenNode) (string, error)
+
+	// Like renders a "LIKE" predicate. The default renders the
+	// case-insensitive form (ilike) using lower().
+	Like func(rc *Context, n *ast.LikeNode) (string, error)
+
+	// Is renders a null-safe comparison. The default renders
+	// "x IS NULL" for comparison against null, and otherwise
+	// the standard "x IS NOT DISTINCT FROM y".
+	Is func(rc *Context, n *ast.IsNode) (string, error)
+
+	// With renders the "WITH" clause for the CTEs. Returns an
+	// empty string if ctes is empty.
+	With func(rc *Context, ctes []*ast.CTENode) (string, error)
+
 	// Distinct renders the DISTINCT fragment. Returns an
 	// empty string if n is nil.
 	Distinct func(rc *Context, n *ast.UniqueNode) (string, error)
 
+	// UniqueBy renders unique() with column args, e.g. "unique(.customer_id)",
+	// which selects one row for each distinct value of the columns. Unlike
+	// the other funcs, it is invoked after the other fragments of the query
+	// are rendered, and it modifies f. The rows are ordered by ob (which
+	// may be nil), and the first row of each value is selected. The default
+	// rewrites the query using ROW_NUMBER(); see also UniqueByDistinctOn.
+	UniqueBy func(rc *Context, n *ast.UniqueNode, ob *ast.OrderByNode, f *Fragments) error
+
+	// Top renders "top(n, .col)", which selects the first n rows, per ob
+	// (which may be nil), for each distinct value of the columns. Like
+	// UniqueBy, it is invoked after the other fragments of the query are
+	// rendered, and it modifies f. The default rewrites the query using
+	// ROW_NUMBER().
+	Top func(rc *Context, n *ast.TopNode, ob *ast.OrderByNode, f *Fragments) error
+
 	// PreRender is a hook that is called before Render. It is a final
 	// opportunity to customize f before rendering. It is nil by default.
 	PreRender func(rc *Context, f *Fragments) error
@@ -81,38 +198,87 @@ type Renderer struct {
 // as needed.
 func NewDefaultRenderer() *Renderer {
 	return &Renderer{
-		FromTable:  doFromTable,
-		SelectCols: doSelectCols,
-		Range:      doRange,
-		OrderBy:    doOrderBy,
-		GroupBy:    doGroupBy,
-		Join:       doJoin,
-		Function:   doFunction,
-		Literal:    doLiteral,
-		Where:      doWhere,
-		Expr:       doExpr,
-		Operator:   doOperator,
-		Distinct:   doDistinct,
-		Render:     doRender,
+		FromTable:   doFromTable,
+		SelectCols:  doSelectCols,
+		Range:       doRange,
+		OrderBy:     doOrderBy,
+		OrderByTerm: doOrderByTerm,
+		GroupBy:     doGroupBy,
+		Join:        doJoin,
+		Function:    doFunction,
+		FunctionNames: map[string]string{
+			"stddev":   "stddev_samp",
+			"variance": "var_samp",
+		},
+		FunctionOverrides: map[string]func(rc *Context, fn *ast.FuncNode) (string, error){
+			"date_trunc":      doFuncDateTrunc,
+			"extract":         doFuncExtract,
+			"date_add":        doFuncDateAdd,
+			"cast":            doFuncCast,
+			"median":          doFuncMedian,
+			"percentile_cont": doFuncPercentileCont,
+			"string_agg":      doFuncStringAgg,
+		},
+		Window:      doWindow,
+		Literal:     doLiteral,
+		Where:       doWhere,
+		Having:      doHaving,
+		Expr:        doExpr,
+		Operator:    doOperator,
+		Distinct:    doDistinct,
+		UniqueBy:    doUniqueBy,
+		Top:         doTop,
+		SetOp:       doSetOp,
+		Subquery:    doSubquery,
+		Conditional: doConditional,
+		In:          doIn,
+		Between:     doBetween,
+		Like:        doLike,
+		Is:          doIs,
+		With:        doWith,
+		Render:      doRender,
 	}
 }
 
+// renderString renders the string value s: as a bound
+// parameter if rc has Params, and otherwise inlined.
+func renderString(rc *Context, s string) string {
+	if rc.Params == nil {
+		return stringz.SingleQuote(s)
+	}
+	return rc.Params.Add(s)
+}
+
 // Fragments holds the fragments of a SQL query.
 // It is passed to Renderer.PreRender and Renderer.Render.
 type Fragments struct {
+	// With holds the "WITH" clause, which is rendered
+	// before the SELECT keyword.
+	With     string
 	Distinct string
 	Columns  string
 	From     string
 	Where    string
 	GroupBy  string
+	Having   string
 	OrderBy  string
 	Range    string
+
+	// SetOps holds any compound SELECT operations, e.g.
+	// "UNION SELECT ... INTERSECT SELECT ...". It is rendered
+	// after Having, and before OrderBy.
+	SetOps string
 }
 
 // Render implements QueryBuilder.
 func doRender(_ *Context, f *Fragments) (string, error) {
 	sb := strings.Builder{}
 
+	if f.With != "" {
+		sb.WriteString(f.With)
+		sb.WriteRune(sp)
+	}
+
 	sb.WriteString("SELECT")
 
 	if f.Distinct != "" {
@@ -133,14 +299,24 @@ func doRender(_ *Context, f *Fragments) (string, error) {
 		sb.WriteString(f.Where)
 	}
 
-	if f.OrderBy != "" {
+	if f.GroupBy != "" {
 		sb.WriteRune(sp)
-		sb.WriteString(f.OrderBy)
+		sb.WriteString(f.GroupBy)
 	}
 
-	if f.GroupBy != "" {
+	if f.Having != "" {
 		sb.WriteRune(sp)
-		sb.WriteString(f.GroupBy)
+		sb.WriteString(f.Having)
+	}
+
+	if f.SetOps != "" {
+		sb.WriteRune(sp)
+		sb.WriteString(f.SetOps)
+	}
+
+	if f.OrderBy != "" {
+		sb.WriteRune(sp)
+		sb.WriteString(f.OrderBy)
 	}
 
 	if f.Range != "" {
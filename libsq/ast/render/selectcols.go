@@ -23,27 +23,8 @@ func doSelectCols(rc *Context, cols []ast.ResultColumn) (string, error) {
 			aliasFrag = " AS " + rc.Dialect.Enquote(col.Alias())
 		}
 
-		switch col := col.(type) {
-		case *ast.ColSelectorNode:
-			if vals[i], err = renderSelectorNode(rc.Dialect, col); err != nil {
-				return "", err
-			}
-		case *ast.TblColSelectorNode:
-			if vals[i], err = renderSelectorNode(rc.Dialect, col); err != nil {
-				return "", err
-			}
-		case *ast.FuncNode:
-			if vals[i], err = rc.Renderer.Function(rc, col); err != nil {
-				return "", err
-			}
-		case *ast.ExprElementNode:
-			if vals[i], err = rc.Renderer.Expr(rc, col.ExprNode()); err != nil {
-				return "", err
-			}
-		default:
-			// FIXME: We should be exhaustively checking the cases.
-			// Actually, this should probably be an error?
-			vals[i] = col.Text() // for now, we just return the raw text
+		if vals[i], err = renderResultColumn(rc, col); err != nil {
+			return "", err
 		}
 
 		vals[i] += aliasFrag
@@ -52,3 +33,21 @@ func doSelectCols(rc *Context, cols []ast.ResultColumn) (string, error) {
 	text := strings.Join(vals, ", ")
 	return text, nil
 }
+
+// renderResultColumn renders col, sans any alias.
+func renderResultColumn(rc *Context, col ast.ResultColumn) (string, error) {
+	switch col := col.(type) {
+	case *ast.ColSelectorNode:
+		return renderSelectorNode(rc.Dialect, col)
+	case *ast.TblColSelectorNode:
+		return renderSelectorNode(rc.Dialect, col)
+	case *ast.FuncNode:
+		return rc.Renderer.Function(rc, col)
+	case *ast.ExprElementNode:
+		return rc.Renderer.Expr(rc, col.ExprNode())
+	default:
+		// FIXME: We should be exhaustively checking the cases.
+		// Actually, this should probably be an error?
+		return col.Text(), nil // for now, we just return the raw text
+	}
+}
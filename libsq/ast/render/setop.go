@@ -0,0 +1,21 @@
+package render
+
+import (
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+func doSetOp(_ *Context, op ast.SetOpType) (string, error) {
+	switch op {
+	case ast.SetOpUnion:
+		return "UNION", nil
+	case ast.SetOpUnionAll:
+		return "UNION ALL", nil
+	case ast.SetOpIntersect:
+		return "INTERSECT", nil
+	case ast.SetOpExcept:
+		return "EXCEPT", nil
+	default:
+		return "", errz.Errorf("unknown set operator: %s", op)
+	}
+}
@@ -0,0 +1,43 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+func doSubquery(rc *Context, n *ast.SubqueryNode) (string, error) {
+	if rc.Nested == nil {
+		return "", errz.Errorf("render context can't render nested query: %s", n.Text())
+	}
+
+	sql, err := rc.Nested(rc, n.Query())
+	if err != nil {
+		return "", err
+	}
+
+	return "(" + sql + ")", nil
+}
+
+func doWith(rc *Context, ctes []*ast.CTENode) (string, error) {
+	if len(ctes) == 0 {
+		return "", nil
+	}
+
+	if rc.Nested == nil {
+		return "", errz.Errorf("render context can't render nested query: %s", ctes[0].Text())
+	}
+
+	terms := make([]string, len(ctes))
+	for i, cte := range ctes {
+		sql, err := rc.Nested(rc, cte.Query())
+		if err != nil {
+			return "", err
+		}
+
+		terms[i] = rc.Dialect.Enquote(cte.Name()) + " AS (" + sql + ")"
+	}
+
+	return "WITH " + strings.Join(terms, ", "), nil
+}
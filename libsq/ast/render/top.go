@@ -0,0 +1,341 @@
+package render
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+// doTop implements Renderer.Top. If the query has a GROUP BY or a
+// join, the query's result rows are filtered: see renderResultRowNumberFilter.
+// Otherwise, the table's rows are filtered: see renderRowNumberFilter.
+func doTop(rc *Context, n *ast.TopNode, ob *ast.OrderByNode, f *Fragments) error {
+	a := nodeAST(n)
+	if a == nil {
+		return errz.Errorf("%s: node is not part of an AST", n.Text())
+	}
+
+	joins, err := ast.NewInspector(a).FindJoins()
+	if err != nil {
+		return err
+	}
+
+	if f.GroupBy != "" || len(joins) > 0 {
+		return renderResultRowNumberFilter(rc, n, n.Columns(), ob, n.N(), f)
+	}
+
+	return renderRowNumberFilter(rc, n, n.Columns(), ob, n.N(), f)
+}
+
+// renderRowNumberFilter rewrites the query whose fragments are f to select
+// the first limit rows, per ob (which may be nil), of each distinct value
+// of the partition columns cols. The rows are numbered using ROW_NUMBER()
+// in a derived table, which is aliased to the query's table, so that the
+// selected columns (which are rendered against the table) can still be
+// resolved. The query must not have a GROUP BY or a join. For example:
+//
+//	SELECT "payment_id", "customer_id", ... FROM (SELECT "payment".*,
+//	  ROW_NUMBER() OVER (PARTITION BY "customer_id" ORDER BY "payment_date"
+//	  DESC) AS "sq_rn" FROM "payment") AS "payment" WHERE "sq_rn" <= 3
+//	  ORDER BY "customer_id", "payment_date" DESC
+//
+// If the query selects all columns, they are listed explicitly (via
+// Context.TableCols), so that the "sq_rn" column is not selected. Arg
+// node is the node (e.g. ast.UniqueNode) that the filter is rendered for.
+func renderRowNumberFilter(rc *Context, node ast.Node, cols []ast.Node, ob *ast.OrderByNode,
+	limit int, f *Fragments,
+) error {
+	a := nodeAST(node)
+	if a == nil {
+		return errz.Errorf("%s: node is not part of an AST", node.Text())
+	}
+
+	insp := ast.NewInspector(a)
+	tblSel := insp.FindFirstTableSelector()
+	if tblSel == nil {
+		return errz.Errorf("%s: query has no table: %s", node.Text(), a.Text())
+	}
+
+	if joins, err := insp.FindJoins(); err != nil {
+		return err
+	} else if len(joins) > 0 {
+		return errz.Errorf("%s: can't be used with a join for %s", node.Text(), rc.Dialect)
+	}
+
+	renderSel := selectorRenderer(rc)
+	keys, err := renderSelectors(node, cols, renderSel)
+	if err != nil {
+		return err
+	}
+
+	if f.Columns == "*" {
+		var names []string
+		if names, err = renderTableCols(rc, node, tblSel); err != nil {
+			return err
+		}
+		f.Columns = strings.Join(names, ", ")
+	}
+
+	var window string
+	if window, err = rowNumberWindow(rc, keys, ob, renderSel); err != nil {
+		return err
+	}
+
+	tbl := rc.Dialect.Enquote(tblSel.TblAliasOrName())
+	inner := sqlAppend("SELECT "+tbl+".*, "+window, sqlAppend(f.From, f.Where))
+
+	f.From = "FROM (" + inner + ") AS " + tbl
+	f.Where = rowNumberWhere(rc, limit)
+	f.OrderBy, err = partitionOrderBy(rc, keys, ob, renderSel)
+	return err
+}
+
+// renderResultRowNumberFilter is like renderRowNumberFilter, but the
+// query's result rows are numbered, rather than the table's rows, and
+// thus cols and the terms of ob refer to the query's result columns, by
+// name or alias. It is used for a query with GROUP BY or a join. For
+// example:
+//
+//	SELECT "category_id", "film_id", "rentals" FROM (SELECT "sq_top".*,
+//	  ROW_NUMBER() OVER (PARTITION BY "category_id" ORDER BY "rentals" DESC)
+//	  AS "sq_rn" FROM (SELECT ... GROUP BY ...) AS "sq_top") AS "sq_top"
+//	  WHERE "sq_rn" <= 3 ORDER BY "category_id", "rentals" DESC
+func renderResultRowNumberFilter(rc *Context, node ast.Node, cols []ast.Node, ob *ast.OrderByNode,
+	limit int, f *Fragments,
+) error {
+	renderSel := colNameRenderer(rc)
+	keys, err := renderSelectors(node, cols, renderSel)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	if names, err = resultColNames(rc, node); err != nil {
+		return err
+	}
+
+	var window string
+	if window, err = rowNumberWindow(rc, keys, ob, renderSel); err != nil {
+		return err
+	}
+
+	var query string
+	query, err = rc.Renderer.Render(rc, &Fragments{
+		Distinct: f.Distinct,
+		Columns:  f.Columns,
+		From:     f.From,
+		Where:    f.Where,
+		GroupBy:  f.GroupBy,
+		Having:   f.Having,
+		SetOps:   f.SetOps,
+	})
+	if err != nil {
+		return err
+	}
+
+	alias := rc.Dialect.Enquote("sq_top")
+	numbered := "SELECT " + alias + ".*, " + window + " FROM (" + query + ") AS " + alias
+
+	f.Distinct, f.GroupBy, f.Having, f.SetOps = "", "", "", ""
+	f.Columns = strings.Join(names, ", ")
+	f.From = "FROM (" + numbered + ") AS " + alias
+	f.Where = rowNumberWhere(rc, limit)
+	f.OrderBy, err = partitionOrderBy(rc, keys, ob, renderSel)
+	return err
+}
+
+// resultColNames returns the names of the result columns of the query
+// that node is part of, enquoted. A result column that is an expression
+// must have an alias, as its name is otherwise determined by the DB.
+func resultColNames(rc *Context, node ast.Node) ([]string, error) {
+	a := nodeAST(node)
+	if a == nil {
+		return nil, errz.Errorf("%s: node is not part of an AST", node.Text())
+	}
+
+	insp := ast.NewInspector(a)
+	seg, err := insp.FindColExprSegment()
+	if err != nil {
+		return nil, err
+	}
+
+	if seg == nil {
+		tblSel := insp.FindFirstTableSelector()
+		if tblSel == nil {
+			return nil, errz.Errorf("%s: query has no table: %s", node.Text(), a.Text())
+		}
+
+		var joins []*ast.JoinNode
+		if joins, err = insp.FindJoins(); err != nil {
+			return nil, err
+		} else if len(joins) > 0 {
+			return nil, errz.Errorf("%s: the columns of a join must be selected explicitly", node.Text())
+		}
+
+		return renderTableCols(rc, node, tblSel)
+	}
+
+	names := make([]string, 0, len(seg.Children()))
+	for _, child := range seg.Children() {
+		col, ok := child.(ast.ResultColumn)
+		if !ok {
+			return nil, errz.Errorf("%s: expected result column, but got %T: %s", node.Text(), child, child.Text())
+		}
+
+		name := col.Alias()
+		if name == "" {
+			switch col := col.(type) {
+			case *ast.ColSelectorNode:
+				name = col.ColName()
+			case *ast.TblColSelectorNode:
+				name = col.ColName()
+			default:
+				return nil, errz.Errorf("%s: column %s must have an alias", node.Text(), col.Text())
+			}
+		}
+
+		names = append(names, rc.Dialect.Enquote(name))
+	}
+
+	return names, nil
+}
+
+// renderTableCols returns the enquoted column names
+// of tblSel, as returned by Context.TableCols.
+func renderTableCols(rc *Context, node ast.Node, tblSel *ast.TblSelectorNode) ([]string, error) {
+	if rc.TableCols == nil {
+		return nil, errz.Errorf("%s: the columns of table %s must be selected explicitly for %s",
+			node.Text(), tblSel.TblName(), rc.Dialect)
+	}
+
+	cols, err := rc.TableCols(tblSel.TblName())
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range cols {
+		cols[i] = rc.Dialect.Enquote(cols[i])
+	}
+	return cols, nil
+}
+
+// rowNumberWindow returns the ROW_NUMBER() column that numbers the rows
+// of each distinct value of keys, per ob. If ob is nil, the rows are
+// ordered by keys, i.e. the numbering within each value is arbitrary.
+func rowNumberWindow(rc *Context, keys []string, ob *ast.OrderByNode, renderSel func(ast.Node) (string, error),
+) (string, error) {
+	order := keys
+	if ob != nil {
+		var err error
+		if order, err = orderByTerms(rc, ob, renderSel); err != nil {
+			return "", err
+		}
+	}
+
+	return "ROW_NUMBER() OVER (PARTITION BY " + strings.Join(keys, ", ") +
+		" ORDER BY " + strings.Join(order, ", ") + ") AS " + rc.Dialect.Enquote("sq_rn"), nil
+}
+
+// rowNumberWhere returns the WHERE clause that selects the
+// first limit rows, as numbered by rowNumberWindow.
+func rowNumberWhere(rc *Context, limit int) string {
+	rn := rc.Dialect.Enquote("sq_rn")
+	if limit == 1 {
+		return "WHERE " + rn + " = 1"
+	}
+	return "WHERE " + rn + " <= " + strconv.Itoa(limit)
+}
+
+// partitionOrderBy returns the ORDER BY clause of a query whose rows
+// are partitioned by keys, and ordered by ob (which may be nil). The rows
+// are ordered by keys, and then by ob. But if the leading terms of ob are
+// keys (in any order), ob is used as is, which allows the partition
+// columns to be ordered descending.
+func partitionOrderBy(rc *Context, keys []string, ob *ast.OrderByNode, renderSel func(ast.Node) (string, error),
+) (string, error) {
+	if ob == nil {
+		return "ORDER BY " + strings.Join(keys, ", "), nil
+	}
+
+	terms, err := orderByTerms(rc, ob, renderSel)
+	if err != nil {
+		return "", err
+	}
+
+	if len(terms) >= len(keys) {
+		leading := make([]string, len(keys))
+		for i, term := range ob.Terms()[:len(keys)] {
+			if _, ok := term.Expr().(ast.Selector); !ok {
+				// An expression term can't be a key.
+				continue
+			}
+
+			if leading[i], err = renderSel(term.Expr()); err != nil {
+				return "", err
+			}
+		}
+
+		if lo.Every(leading, keys) {
+			return "ORDER BY " + strings.Join(terms, ", "), nil
+		}
+	}
+
+	return "ORDER BY " + strings.Join(keys, ", ") + ", " + strings.Join(terms, ", "), nil
+}
+
+// renderSelectors renders cols, the column args of node, using renderSel.
+func renderSelectors(node ast.Node, cols []ast.Node, renderSel func(ast.Node) (string, error),
+) ([]string, error) {
+	if len(cols) == 0 {
+		return nil, errz.Errorf("%s: no column args", node.Text())
+	}
+
+	vals := make([]string, len(cols))
+	for i, col := range cols {
+		var err error
+		if vals[i], err = renderSel(col); err != nil {
+			return nil, err
+		}
+	}
+
+	return vals, nil
+}
+
+// selectorRenderer returns a func that renders a selector node
+// for the dialect of rc.
+func selectorRenderer(rc *Context) func(ast.Node) (string, error) {
+	return func(node ast.Node) (string, error) {
+		return renderSelectorNode(rc.Dialect, node)
+	}
+}
+
+// colNameRenderer returns a func that renders a selector node for the
+// dialect of rc, but without any table qualifier, as is required to
+// reference the columns of a subquery.
+func colNameRenderer(rc *Context) func(ast.Node) (string, error) {
+	return func(node ast.Node) (string, error) {
+		switch node := node.(type) {
+		case *ast.ColSelectorNode:
+			return rc.Dialect.Enquote(node.ColName()), nil
+		case *ast.TblColSelectorNode:
+			return rc.Dialect.Enquote(node.ColName()), nil
+		default:
+			return renderSelectorNode(rc.Dialect, node)
+		}
+	}
+}
+
+// nodeAST returns the AST that node is part of, or nil.
+func nodeAST(node ast.Node) *ast.AST {
+	for node != nil {
+		if a, ok := node.(*ast.AST); ok {
+			return a
+		}
+		node = node.Parent()
+	}
+	return nil
+}
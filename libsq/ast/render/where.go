@@ -14,3 +14,16 @@ func doWhere(rc *Context, where *ast.WhereNode) (string, error) {
 	sql = "WHERE " + sql
 	return sql, nil
 }
+
+func doHaving(rc *Context, having *ast.HavingNode) (string, error) {
+	if having == nil {
+		return "", nil
+	}
+	sql, err := rc.Renderer.Expr(rc, having.Expr())
+	if err != nil {
+		return "", err
+	}
+
+	sql = "HAVING " + sql
+	return sql, nil
+}
@@ -0,0 +1,59 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/ast"
+)
+
+func doWindow(rc *Context, _ *ast.FuncNode, w *ast.WindowNode) (string, error) {
+	return OverClause(rc, w, "")
+}
+
+// OverClause renders the OVER clause of window w. If w has no ORDER BY,
+// defaultOrderBy, if non-empty, is rendered in its place. This is for use
+// by Renderer.Window implementations of dialects, such as SQL Server, that
+// require some window functions to have an ORDER BY.
+func OverClause(rc *Context, w *ast.WindowNode, defaultOrderBy string) (string, error) {
+	if w == nil {
+		return "", nil
+	}
+
+	var (
+		sb   strings.Builder
+		term string
+		err  error
+	)
+
+	sb.WriteString("OVER (")
+	if pb := w.PartitionBy(); pb != nil {
+		sb.WriteString("PARTITION BY ")
+		for i, child := range pb.Children() {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+
+			if term, err = renderSelectorNode(rc.Dialect, child); err != nil {
+				return "", err
+			}
+			sb.WriteString(term)
+		}
+	}
+
+	term = defaultOrderBy
+	if ob := w.OrderBy(); ob != nil {
+		if term, err = rc.Renderer.OrderBy(rc, ob); err != nil {
+			return "", err
+		}
+	}
+
+	if term != "" {
+		if w.PartitionBy() != nil {
+			sb.WriteRune(sp)
+		}
+		sb.WriteString(term)
+	}
+
+	sb.WriteRune(')')
+	return sb.String(), nil
+}
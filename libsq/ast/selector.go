@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/neilotoole/sq/libsq/core/stringz"
-
 	"github.com/antlr4-go/antlr/v4"
 
 	"github.com/neilotoole/sq/libsq/ast/internal/slq"
@@ -19,12 +17,7 @@ func (v *parseTreeVisitor) VisitSelectorElement(ctx *slq.SelectorElementContext)
 	}
 
 	if aliasCtx := ctx.Alias(); aliasCtx != nil {
-		if aliasCtx.ID() != nil {
-			node.alias = aliasCtx.ID().GetText()
-		}
-		if aliasCtx.STRING() != nil {
-			node.alias = stringz.StripDoubleQuote(aliasCtx.STRING().GetText())
-		}
+		node.alias = aliasText(aliasCtx)
 	}
 
 	if err := v.cur.AddChild(node); err != nil {
@@ -156,6 +149,14 @@ func (n *TblSelectorNode) SyncTblNameAlias() {
 	}
 }
 
+// ReplaceTblName sets the table name to tblName, and the alias to
+// the previous alias or table name (per TblAliasOrName). Thus references
+// to the table in the query are unaffected.
+func (n *TblSelectorNode) ReplaceTblName(tblName string) {
+	n.alias = n.TblAliasOrName()
+	n.tblName = tblName
+}
+
 // TblAliasOrName returns the table alias if set; if not, it
 // returns the table name.
 func (n *TblSelectorNode) TblAliasOrName() string {
@@ -24,6 +24,106 @@ func TestColumnAlias(t *testing.T) {
 			wantColName: "first_name",
 			wantAlias:   "given_name",
 		},
+		{
+			in:          `@sakila | .actor | .first_name:upper`,
+			wantColName: "first_name",
+			wantAlias:   "upper",
+		},
+		{
+			in:          `@sakila | .payment | .payment_date:extract`,
+			wantColName: "payment_date",
+			wantAlias:   "extract",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:count`,
+			wantColName: "first_name",
+			wantAlias:   "count",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:is`,
+			wantColName: "first_name",
+			wantAlias:   "is",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:in`,
+			wantColName: "first_name",
+			wantAlias:   "in",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:ilike`,
+			wantColName: "first_name",
+			wantAlias:   "ilike",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:between`,
+			wantColName: "first_name",
+			wantAlias:   "between",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:end`,
+			wantColName: "first_name",
+			wantAlias:   "end",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:if`,
+			wantColName: "first_name",
+			wantAlias:   "if",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:else`,
+			wantColName: "first_name",
+			wantAlias:   "else",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:nulls_first`,
+			wantColName: "first_name",
+			wantAlias:   "nulls_first",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:nulls_last`,
+			wantColName: "first_name",
+			wantAlias:   "nulls_last",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:isbn`,
+			wantColName: "first_name",
+			wantAlias:   "isbn",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:union`,
+			wantColName: "first_name",
+			wantAlias:   "union",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:union_all`,
+			wantColName: "first_name",
+			wantAlias:   "union_all",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:intersect`,
+			wantColName: "first_name",
+			wantAlias:   "intersect",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:except`,
+			wantColName: "first_name",
+			wantAlias:   "except",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:with`,
+			wantColName: "first_name",
+			wantAlias:   "with",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:over`,
+			wantColName: "first_name",
+			wantAlias:   "over",
+		},
+		{
+			in:          `@sakila | .actor | .first_name:partition_by`,
+			wantColName: "first_name",
+			wantAlias:   "partition_by",
+		},
 	}
 
 	for _, tc := range testCases {
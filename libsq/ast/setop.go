@@ -0,0 +1,82 @@
+package ast
+
+import (
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+// SetOpType is an enum of SQL compound SELECT operator types.
+type SetOpType string
+
+const (
+	// SetOpUnion is the SQL "UNION" operator.
+	SetOpUnion SetOpType = "union"
+
+	// SetOpUnionAll is the SQL "UNION ALL" operator.
+	SetOpUnionAll SetOpType = "union_all"
+
+	// SetOpIntersect is the SQL "INTERSECT" operator.
+	SetOpIntersect SetOpType = "intersect"
+
+	// SetOpExcept is the SQL "EXCEPT" operator.
+	SetOpExcept SetOpType = "except"
+)
+
+var _ Node = (*SetOpNode)(nil)
+
+// SetOpNode models a SQL compound SELECT operator (UNION, UNION ALL,
+// INTERSECT, EXCEPT). The node's argument is a complete query, whose
+// result is combined with the result of the enclosing query.
+//
+//	@prod.actor | .first_name | union(@staging.actor | .first_name)
+//
+// Note that the argument query is not a child of SetOpNode: it is
+// a distinct AST, accessed via SetOpNode.Query. Thus, walking the
+// enclosing AST does not descend into the argument query.
+type SetOpNode struct {
+	baseNode
+	op    SetOpType
+	query *AST
+}
+
+// Op returns the set operator type.
+func (n *SetOpNode) Op() SetOpType {
+	return n.op
+}
+
+// Query returns the AST of the argument query.
+func (n *SetOpNode) Query() *AST {
+	return n.query
+}
+
+// SetParent implements ast.Node.
+func (n *SetOpNode) SetParent(parent Node) error {
+	seg, ok := parent.(*SegmentNode)
+	if !ok {
+		return errorf("%T requires parent of type %s", n, typeSegmentNode)
+	}
+	n.parent = seg
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *SetOpNode) String() string {
+	return nodeString(n)
+}
+
+// VisitSetOp implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitSetOp(ctx *slq.SetOpContext) any {
+	node := &SetOpNode{op: SetOpType(ctx.SET_OP().GetText())}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+
+	var err error
+	if node.query, err = buildNestedAST(v.log, ctx.Query()); err != nil {
+		return err
+	}
+
+	if err = node.SetParent(v.cur); err != nil {
+		return err
+	}
+
+	return v.cur.AddChild(node)
+}
@@ -0,0 +1,33 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetOp(t *testing.T) {
+	const in = `@sakila | .actor | .first_name | union_all(@other.customer | .first_name | where(.store_id == 1)) | except(.staff | .first_name)`
+
+	a := mustParse(t, in)
+	insp := NewInspector(a)
+
+	setOps := insp.FindSetOpNodes()
+	require.Len(t, setOps, 2)
+
+	require.Equal(t, SetOpUnionAll, setOps[0].Op())
+	require.Equal(t, SetOpExcept, setOps[1].Op())
+
+	// The argument query is a distinct AST, and is not walked
+	// as part of the enclosing AST.
+	q := setOps[0].Query()
+	require.NotNil(t, q)
+	require.Equal(t, "@other", NewInspector(q).FindFirstHandle())
+	where, err := NewInspector(q).FindWhereClauses()
+	require.NoError(t, err)
+	require.Len(t, where, 1)
+
+	where, err = insp.FindWhereClauses()
+	require.NoError(t, err)
+	require.Empty(t, where)
+}
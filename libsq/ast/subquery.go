@@ -0,0 +1,73 @@
+package ast
+
+import (
+	"github.com/antlr4-go/antlr/v4"
+
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+var _ Node = (*SubqueryNode)(nil)
+
+// SubqueryNode models a subquery: a complete query, enclosed in parentheses,
+// that is part of an expression. For example:
+//
+//	.customer | where(.customer_id in (.payment | where(.amount > 10) | .customer_id))
+//
+// Like SetOpNode, the subquery is a distinct AST, accessed via
+// SubqueryNode.Query, and it is not walked as part of the enclosing AST.
+type SubqueryNode struct {
+	baseNode
+	query *AST
+}
+
+// Query returns the AST of the subquery.
+func (n *SubqueryNode) Query() *AST {
+	return n.query
+}
+
+// String returns a log/debug-friendly representation.
+func (n *SubqueryNode) String() string {
+	return nodeString(n)
+}
+
+// VisitSubquery implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitSubquery(ctx *slq.SubqueryContext) any {
+	if isJuxtaposedExpr(ctx.GetParent()) {
+		// The grammar permits two expressions to be juxtaposed without
+		// an operator, which would result in input such as
+		// "count(.a, .b)" being interpreted as "count (subquery)".
+		return errorf("invalid expression: subquery must be preceded by an operator: %s",
+			ctx.GetParent().GetParent().(antlr.ParseTree).GetText())
+	}
+
+	node := &SubqueryNode{}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+
+	var err error
+	if node.query, err = buildNestedAST(v.log, ctx.Query()); err != nil {
+		return err
+	}
+
+	if err = node.SetParent(v.cur); err != nil {
+		return err
+	}
+
+	return v.cur.AddChild(node)
+}
+
+// isJuxtaposedExpr returns true if tree is an expression that is the
+// right-hand side of two expressions juxtaposed without an operator.
+func isJuxtaposedExpr(tree antlr.Tree) bool {
+	if _, ok := tree.(*slq.ExprContext); !ok {
+		return false
+	}
+
+	parent, ok := tree.GetParent().(*slq.ExprContext)
+	if !ok || parent.GetChildCount() != 2 {
+		return false
+	}
+
+	_, ok = parent.GetChild(0).(*slq.ExprContext)
+	return ok && parent.GetChild(1) == tree
+}
@@ -0,0 +1,78 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/neilotoole/slogt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubquery(t *testing.T) {
+	const in = `@sakila | .customer | where(.customer_id in (@other.payment | where(.amount > (.payment | avg(.amount))) | .customer_id))`
+
+	a := mustParse(t, in)
+	insp := NewInspector(a)
+
+	subqueries := insp.FindSubqueryNodes()
+	require.Len(t, subqueries, 1)
+	sq := subqueries[0]
+
+	inNode, ok := sq.Parent().(*InNode)
+	require.True(t, ok)
+	require.False(t, inNode.Negated())
+	require.Equal(t, sq, inNode.Subquery())
+	require.Empty(t, inNode.List())
+	_, ok = NodeUnwrap[*ColSelectorNode](inNode.Expr())
+	require.True(t, ok)
+
+	q := sq.Query()
+	require.NotNil(t, q)
+	nestedInsp := NewInspector(q)
+	require.Equal(t, "@other", nestedInsp.FindFirstHandle())
+
+	// The scalar subquery is nested within the subquery's AST,
+	// and thus wasn't returned by the outer inspector.
+	require.Len(t, nestedInsp.FindSubqueryNodes(), 1)
+}
+
+func TestSubquery_errors(t *testing.T) {
+	testCases := []string{
+		`@sakila | .actor | count(.first_name, .last_name)`,
+		`@sakila | .actor | where(.actor_id (.actor | .actor_id))`,
+		`@sakila | .actor | where(.actor_id in ())`,
+	}
+
+	for _, in := range testCases {
+		in := in
+		t.Run(in, func(t *testing.T) {
+			_, err := Parse(slogt.New(t), in)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCTE(t *testing.T) {
+	const in = `@sakila | with(.big, .payment | where(.amount > 10)), with(.big_cust, .big | .customer_id) | .big_cust | .customer_id`
+
+	a := mustParse(t, in)
+	insp := NewInspector(a)
+
+	ctes := insp.FindCTENodes()
+	require.Len(t, ctes, 2)
+	require.Equal(t, "big", ctes[0].Name())
+	require.Equal(t, "big_cust", ctes[1].Name())
+	require.NotNil(t, ctes[0].Query())
+	require.NotNil(t, ctes[1].Query())
+
+	// The selector following the CTE segment is a table selector, and
+	// it takes the handle preceding the CTE segment.
+	tblSel := insp.FindFirstTableSelector()
+	require.NotNil(t, tblSel)
+	require.Equal(t, "big_cust", tblSel.TblName())
+	require.Equal(t, "@sakila", tblSel.Handle())
+
+	seg, err := insp.FindColExprSegment()
+	require.NoError(t, err)
+	require.Len(t, seg.Children(), 1)
+	require.IsType(t, (*ColSelectorNode)(nil), seg.Children()[0])
+}
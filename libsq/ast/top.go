@@ -0,0 +1,83 @@
+package ast
+
+import (
+	"strconv"
+
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+// TopNode implements "top(n, .col)", which selects the top n rows for
+// each distinct value of its columns, per the query's order_by. For
+// example:
+//
+//	.payment | top(3, .customer_id) | order_by(.payment_date-)
+//
+// The node's children are the selectors of the columns.
+type TopNode struct {
+	baseNode
+	n int
+}
+
+// N returns the number of rows to select for each distinct value.
+func (n *TopNode) N() int {
+	return n.n
+}
+
+// Columns returns the selectors of the columns that the rows are
+// partitioned by.
+func (n *TopNode) Columns() []Node {
+	return n.Children()
+}
+
+// AddChild implements Node.AddChild. It returns an error
+// if child is not a selector.
+func (n *TopNode) AddChild(child Node) error {
+	if _, ok := child.(Selector); !ok {
+		return errorf("illegal %T child type %T: %s", n, child, child)
+	}
+
+	n.addChild(child)
+	return child.SetParent(n)
+}
+
+// SetChildren implements ast.Node.
+func (n *TopNode) SetChildren(children []Node) error {
+	for i := range children {
+		if _, ok := children[i].(Selector); !ok {
+			return errorf("illegal child type %T {%s} for %T", children[i], children[i], n)
+		}
+	}
+
+	n.doSetChildren(children)
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *TopNode) String() string {
+	return nodeString(n)
+}
+
+// VisitTopFunc implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitTopFunc(ctx *slq.TopFuncContext) any {
+	node := &TopNode{}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+
+	var err error
+	if node.n, err = strconv.Atoi(ctx.NN().GetText()); err != nil {
+		return errorf("top: invalid row count: %s", ctx.GetText())
+	}
+
+	if node.n < 1 {
+		return errorf("top: row count must be at least 1: %s", ctx.GetText())
+	}
+
+	if e := v.using(node, func() any {
+		// This will result in VisitSelector being called on the children.
+		return v.VisitChildren(ctx)
+	}); e != nil {
+		return e
+	}
+
+	return v.cur.AddChild(node)
+}
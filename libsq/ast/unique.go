@@ -2,11 +2,43 @@ package ast
 
 import "github.com/neilotoole/sq/libsq/ast/internal/slq"
 
-// UniqueNode implements the SQL "DISTINCT" clause.
+// UniqueNode implements the SQL "DISTINCT" clause. If the node has
+// children, they are the selectors of the columns that the rows must
+// be unique by, e.g. "unique(.customer_id)": see UniqueNode.Columns.
 type UniqueNode struct {
 	baseNode
 }
 
+// Columns returns the selectors of the columns that the rows must
+// be unique by. If empty, the rows must be unique across all of the
+// selected columns, i.e. plain "DISTINCT".
+func (n *UniqueNode) Columns() []Node {
+	return n.Children()
+}
+
+// AddChild implements Node.AddChild. It returns an error
+// if child is not a selector.
+func (n *UniqueNode) AddChild(child Node) error {
+	if _, ok := child.(Selector); !ok {
+		return errorf("illegal %T child type %T: %s", n, child, child)
+	}
+
+	n.addChild(child)
+	return child.SetParent(n)
+}
+
+// SetChildren implements ast.Node.
+func (n *UniqueNode) SetChildren(children []Node) error {
+	for i := range children {
+		if _, ok := children[i].(Selector); !ok {
+			return errorf("illegal child type %T {%s} for %T", children[i], children[i], n)
+		}
+	}
+
+	n.doSetChildren(children)
+	return nil
+}
+
 // String returns a log/debug-friendly representation.
 func (n *UniqueNode) String() string {
 	return nodeString(n)
@@ -17,5 +49,13 @@ func (v *parseTreeVisitor) VisitUniqueFunc(ctx *slq.UniqueFuncContext) interface
 	node := &UniqueNode{}
 	node.ctx = ctx
 	node.text = ctx.GetText()
+
+	if e := v.using(node, func() any {
+		// This will result in VisitSelector being called on the children.
+		return v.VisitChildren(ctx)
+	}); e != nil {
+		return e
+	}
+
 	return v.cur.AddChild(node)
 }
@@ -0,0 +1,138 @@
+package ast
+
+import (
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+var _ Node = (*WindowNode)(nil)
+
+// WindowNode models the SQL window function "OVER" clause. A WindowNode
+// is always a child of a FuncNode. For example:
+//
+//	row_number() over(partition_by(.customer_id), order_by(.payment_date-))
+//
+// Both the partition and ordering parts are optional.
+type WindowNode struct {
+	baseNode
+	partitionBy *PartitionByNode
+	orderBy     *OrderByNode
+}
+
+// PartitionBy returns the window's PARTITION BY node, or nil.
+func (n *WindowNode) PartitionBy() *PartitionByNode {
+	return n.partitionBy
+}
+
+// OrderBy returns the window's ORDER BY node, or nil.
+func (n *WindowNode) OrderBy() *OrderByNode {
+	return n.orderBy
+}
+
+// AddChild implements Node.AddChild. It returns an error if child
+// is not of type *PartitionByNode or *OrderByNode.
+func (n *WindowNode) AddChild(child Node) error {
+	switch child := child.(type) {
+	case *PartitionByNode:
+		if n.partitionBy != nil {
+			return errorf("%T can have only one %T", n, child)
+		}
+		n.partitionBy = child
+	case *OrderByNode:
+		if n.orderBy != nil {
+			return errorf("%T can have only one %T", n, child)
+		}
+		n.orderBy = child
+	default:
+		return errorf("illegal %T child type %T: %s", n, child, child)
+	}
+
+	n.addChild(child)
+	return child.SetParent(n)
+}
+
+// SetChildren implements ast.Node.
+func (n *WindowNode) SetChildren(children []Node) error {
+	n.partitionBy = nil
+	n.orderBy = nil
+	n.children = nil
+
+	for _, child := range children {
+		if err := n.AddChild(child); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *WindowNode) String() string {
+	return nodeString(n)
+}
+
+// VisitWindow implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitWindow(ctx *slq.WindowContext) any {
+	node := &WindowNode{}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+
+	if e := v.using(node, func() any {
+		return v.VisitChildren(ctx)
+	}); e != nil {
+		return e
+	}
+
+	return v.cur.AddChild(node)
+}
+
+var _ Node = (*PartitionByNode)(nil)
+
+// PartitionByNode models the "PARTITION BY" part of a window
+// function's "OVER" clause. Its children are selectors.
+type PartitionByNode struct {
+	baseNode
+}
+
+// AddChild implements Node.AddChild. It returns an error
+// if child is not a selector.
+func (n *PartitionByNode) AddChild(child Node) error {
+	if _, ok := child.(Selector); !ok {
+		return errorf("illegal %T child type %T: %s", n, child, child)
+	}
+
+	n.addChild(child)
+	return child.SetParent(n)
+}
+
+// SetChildren implements ast.Node.
+func (n *PartitionByNode) SetChildren(children []Node) error {
+	for i := range children {
+		if _, ok := children[i].(Selector); !ok {
+			return errorf("illegal child type %T {%s} for %T", children[i], children[i], n)
+		}
+	}
+
+	n.doSetChildren(children)
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *PartitionByNode) String() string {
+	return nodeString(n)
+}
+
+// VisitPartitionBy implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitPartitionBy(ctx *slq.PartitionByContext) any {
+	node := &PartitionByNode{}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+
+	if e := v.using(node, func() any {
+		// This will result in VisitSelector being called on the children.
+		return v.VisitChildren(ctx)
+	}); e != nil {
+		return e
+	}
+
+	return v.cur.AddChild(node)
+}
@@ -0,0 +1,43 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindow(t *testing.T) {
+	const in = `@sakila | .payment | .payment_id, row_number() over(partition_by(.customer_id, .payment.staff_id), order_by(.payment_date-)):rn`
+
+	a := mustParse(t, in)
+	insp := NewInspector(a)
+
+	nodes := insp.FindNodes(typeWindowNode)
+	require.Len(t, nodes, 1)
+	w, ok := nodes[0].(*WindowNode)
+	require.True(t, ok)
+
+	fn, ok := w.Parent().(*FuncNode)
+	require.True(t, ok)
+	require.Equal(t, "row_number", fn.FuncName())
+	require.Equal(t, "rn", fn.Alias())
+	require.Equal(t, w, fn.Window())
+	require.Empty(t, fn.Args())
+
+	pb := w.PartitionBy()
+	require.NotNil(t, pb)
+	require.Len(t, pb.Children(), 2)
+	require.IsType(t, (*ColSelectorNode)(nil), pb.Children()[0])
+	require.IsType(t, (*TblColSelectorNode)(nil), pb.Children()[1])
+
+	ob := w.OrderBy()
+	require.NotNil(t, ob)
+	terms := ob.Terms()
+	require.Len(t, terms, 1)
+	require.Equal(t, OrderByDirectionDesc, terms[0].Direction())
+
+	// The window's order_by must not be mistaken for the query's order_by.
+	obNode, err := insp.FindOrderByNode()
+	require.NoError(t, err)
+	require.Nil(t, obNode)
+}
@@ -63,3 +63,30 @@ func IsErrNoData(err error) bool {
 	var e *NoDataError
 	return errors.As(err, &e)
 }
+
+// NoPermissionError indicates that an operation, such as creating a
+// table, is not permitted, e.g. because the DB is read-only.
+type NoPermissionError struct {
+	error
+}
+
+// Unwrap satisfies the stdlib errors.Unwrap function.
+func (e *NoPermissionError) Unwrap() error { return e.error }
+
+// NoPermission returns a NoPermissionError, or nil.
+func NoPermission(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &NoPermissionError{error: Err(err)}
+}
+
+// IsErrNoPermission returns true if err is non-nil and
+// err is or contains NoPermissionError.
+func IsErrNoPermission(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *NoPermissionError
+	return errors.As(err, &e)
+}
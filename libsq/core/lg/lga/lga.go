@@ -4,6 +4,7 @@ package lga
 
 const (
 	Alt       = "alt"
+	Args      = "args"
 	Cmd       = "cmd"
 	Col       = "column"
 	Count     = "count"
@@ -24,6 +25,7 @@ const (
 	Key       = "key"
 	Kind      = "kind"
 	Loc       = "loc"
+	Method    = "method"
 	Opts      = "opts"
 	Path      = "path"
 	Pid       = "pid"
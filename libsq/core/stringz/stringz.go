@@ -5,12 +5,14 @@ package stringz
 import (
 	"bufio"
 	"bytes"
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"io"
 	"math/rand"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
@@ -676,3 +678,78 @@ func ExecuteTemplate(name, tpl string, data any) (string, error) {
 func ShellEscape(s string) string {
 	return shellescape.Quote(s)
 }
+
+// EditDistance returns the Levenshtein distance between a and b, that
+// is, the minimum number of single-rune insertions, deletions or
+// substitutions required to change a into b.
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// SuggestionsFor returns the elements of candidates that are near
+// matches for s, nearest first. An element is a near match if it has
+// prefix s, or if its EditDistance from s is at most two (or at most one,
+// if s has four or fewer runes). The comparison is case-insensitive, and at most
+// three suggestions are returned. If s is itself an element of
+// candidates, nil is returned.
+func SuggestionsFor(s string, candidates []string) []string {
+	const maxSuggestions = 3
+	if s == "" {
+		return nil
+	}
+
+	type match struct {
+		val  string
+		dist int
+	}
+
+	maxDist := 2
+	if len([]rune(s)) <= 4 {
+		maxDist = 1
+	}
+
+	lower := strings.ToLower(s)
+	var matches []match
+	for _, c := range lo.Uniq(candidates) {
+		lc := strings.ToLower(c)
+		if lc == lower {
+			return nil
+		}
+
+		d := EditDistance(lower, lc)
+		if d <= maxDist {
+			matches = append(matches, match{val: c, dist: d})
+		} else if len(s) > 1 && strings.HasPrefix(lc, lower) {
+			matches = append(matches, match{val: c, dist: maxDist + 1})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b match) int {
+		return cmp.Compare(a.dist, b.dist)
+	})
+
+	suggestions := make([]string, 0, min(len(matches), maxSuggestions))
+	for i := 0; i < len(matches) && i < maxSuggestions; i++ {
+		suggestions = append(suggestions, matches[i].val)
+	}
+	return suggestions
+}
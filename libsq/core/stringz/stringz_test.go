@@ -557,3 +557,52 @@ func TestShellEscape(t *testing.T) {
 		})
 	}
 }
+
+func TestEditDistance(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "abc", 0},
+		{"grup_by", "group_by", 1},
+		{"kitten", "sitting", 3},
+		{"wher", "where", 1},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(tutil.Name(i, tc.a, tc.b), func(t *testing.T) {
+			require.Equal(t, tc.want, stringz.EditDistance(tc.a, tc.b))
+			require.Equal(t, tc.want, stringz.EditDistance(tc.b, tc.a))
+		})
+	}
+}
+
+func TestSuggestionsFor(t *testing.T) {
+	candidates := []string{"group_by", "order_by", "where", "count", "concat", "unique", "avg"}
+	testCases := []struct {
+		in   string
+		want []string
+	}{
+		{"grup_by", []string{"group_by"}},
+		{"GRUP_BY", []string{"group_by"}},
+		{"wher", []string{"where"}},
+		{"cont", []string{"count"}},
+		{"concot", []string{"concat"}},
+		{"orde", []string{"order_by"}},
+		{"uniq", []string{"unique"}},
+		{"where", nil},
+		{"xyz", []string{}},
+		{"", nil},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(tutil.Name(i, tc.in), func(t *testing.T) {
+			got := stringz.SuggestionsFor(tc.in, candidates)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package libsq
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/samber/lo"
+
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+	"github.com/neilotoole/sq/libsq/source"
+)
+
+// checkHandles returns an *ast.QueryError if the query, or any query
+// nested within it, references a source that isn't in coll. The error
+// suggests the handles of coll that are near matches.
+func checkHandles(coll *source.Collection, query string, a *ast.AST) error {
+	check := func(handle string, node ast.Node) error {
+		if handle == "" || handle == source.ActiveHandle || coll.IsExistingSource(handle) {
+			return nil
+		}
+
+		return errz.Err(ast.NewNodeError(query, node, "unknown source",
+			stringz.SuggestionsFor(handle, coll.Handles()), "%s", handle))
+	}
+
+	for _, a := range nestedASTs(a) {
+		var err error
+		insp := ast.NewInspector(a)
+		for _, node := range insp.FindNodes(reflect.TypeOf((*ast.HandleNode)(nil))) {
+			if err = check(node.(*ast.HandleNode).Handle(), node); err != nil {
+				return err
+			}
+		}
+
+		for _, node := range insp.FindNodes(reflect.TypeOf((*ast.TblSelectorNode)(nil))) {
+			if err = check(node.(*ast.TblSelectorNode).Handle(), node); err != nil {
+				return err
+			}
+		}
+
+		joins, err := insp.FindJoins()
+		if err != nil {
+			return err
+		}
+
+		for _, join := range joins {
+			if err = check(join.Table().Handle(), join); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// nestedASTs returns a new slice containing a, and the ASTs of the
+// subqueries, set operations and CTEs nested within a, recursively.
+func nestedASTs(a *ast.AST) []*ast.AST {
+	asts := []*ast.AST{a}
+	insp := ast.NewInspector(a)
+	for _, node := range insp.FindSubqueryNodes() {
+		asts = append(asts, nestedASTs(node.Query())...)
+	}
+	for _, node := range insp.FindSetOpNodes() {
+		asts = append(asts, nestedASTs(node.Query())...)
+	}
+	for _, node := range insp.FindCTENodes() {
+		asts = append(asts, nestedASTs(node.Query())...)
+	}
+	return asts
+}
+
+// tblRef is a query's reference to a table.
+type tblRef struct {
+	node    *ast.TblSelectorNode
+	handle  string
+	tblName string
+	alias   string
+}
+
+// queryRefs holds the references of a query, and of the queries nested
+// within it, to tables and columns. The references are recorded before
+// the pipeline is prepared, because preparation may modify the AST: for
+// example, a cross-source join replaces the names of the joined tables.
+type queryRefs struct {
+	tbls []tblRef
+
+	// cols holds the query's column selectors, which are
+	// either *ast.ColSelectorNode or *ast.TblColSelectorNode.
+	cols []ast.Node
+
+	// names holds the names that the query itself defines, i.e.
+	// its column and table aliases, and the names of its CTEs.
+	names map[string]struct{}
+
+	// ctes holds the names of the query's CTEs.
+	ctes map[string]struct{}
+}
+
+// newQueryRefs returns the references of qm, and of the
+// queries nested within it, to tables and columns.
+func newQueryRefs(qm *queryModel) *queryRefs {
+	refs := &queryRefs{names: map[string]struct{}{}, ctes: map[string]struct{}{}}
+	refs.add(qm)
+	return refs
+}
+
+func (refs *queryRefs) add(qm *queryModel) {
+	if qm.Table != nil {
+		for _, tbl := range (&joinClause{leftTbl: qm.Table, joins: qm.Joins}).tables() {
+			handle := tbl.Handle()
+			if handle == "" {
+				handle = qm.Table.Handle()
+			}
+
+			refs.tbls = append(refs.tbls, tblRef{
+				node:    tbl,
+				handle:  handle,
+				tblName: tbl.TblName(),
+				alias:   tbl.Alias(),
+			})
+
+			if tbl.Alias() != "" {
+				refs.names[tbl.Alias()] = struct{}{}
+			}
+		}
+	}
+
+	for _, cte := range qm.CTEs {
+		refs.names[cte.Name()] = struct{}{}
+		refs.ctes[cte.Name()] = struct{}{}
+	}
+
+	_ = ast.NewWalker(qm.AST).AddVisitor(reflect.TypeOf((*ast.Node)(nil)).Elem(),
+		func(_ *ast.Walker, node ast.Node) error {
+			switch node := node.(type) {
+			case *ast.ColSelectorNode, *ast.TblColSelectorNode:
+				refs.cols = append(refs.cols, node)
+			}
+
+			if col, ok := node.(ast.ResultColumn); ok && col.Alias() != "" {
+				refs.names[col.Alias()] = struct{}{}
+			}
+			return nil
+		}).Walk()
+
+	for _, so := range qm.SetOps {
+		refs.add(so.Query)
+	}
+
+	for _, nqm := range qm.Nested {
+		refs.add(nqm)
+	}
+}
+
+// diagnose is invoked when the query fails. It looks for a reference
+// by the query to a table or column that doesn't exist, and if found,
+// returns an *ast.QueryError for the reference, suggesting near
+// matches from the source metadata. Otherwise, err is returned as is.
+// Because diagnose fetches metadata, it should only be invoked on
+// the error path.
+func (p *pipeline) diagnose(ctx context.Context, err error) error {
+	if p.refs == nil || ctx.Err() != nil {
+		return err
+	}
+
+	qErr, diagErr := p.refs.diagnose(ctx, p.qc, p.query)
+	if diagErr != nil {
+		lg.FromContext(ctx).Warn("Failed to diagnose query error", lga.Err, diagErr)
+		return err
+	}
+
+	if qErr == nil {
+		return err
+	}
+
+	lg.FromContext(ctx).Debug("Diagnosed query error", lga.Err, qErr)
+	if errz.IsErrNotExist(err) {
+		// Retain the classification of the original error.
+		return errz.NotExist(qErr)
+	}
+	return errz.Err(qErr)
+}
+
+// diagnose implements pipeline.diagnose. It returns a nil *ast.QueryError
+// if no table or column reference is found to be invalid.
+func (refs *queryRefs) diagnose(ctx context.Context, qc *QueryContext, query string) (*ast.QueryError, error) {
+	// tblCols holds the column names of each referenced table,
+	// keyed by the table's alias, and by its name.
+	tblCols := map[string][]string{}
+	var allCols []string
+	colsKnown := true
+
+	for _, ref := range refs.tbls {
+		if _, ok := refs.ctes[ref.tblName]; ok {
+			// A reference to a CTE: its columns aren't known.
+			colsKnown = false
+			continue
+		}
+
+		src, err := qc.Collection.Get(ref.handle)
+		if err != nil {
+			return nil, err
+		}
+
+		db, err := qc.DBOpener.Open(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+
+		tblMeta, err := db.TableMetadata(ctx, ref.tblName)
+		if err != nil {
+			if !errz.IsErrNotExist(err) {
+				return nil, err
+			}
+
+			srcMeta, err := db.SourceMetadata(ctx, false)
+			if err != nil {
+				return nil, err
+			}
+
+			return ast.NewNodeError(query, ref.node, "unknown table",
+				stringz.SuggestionsFor(ref.tblName, srcMeta.TableNames()),
+				"%s.%s", ref.handle, ref.tblName), nil
+		}
+
+		cols := make([]string, len(tblMeta.Columns))
+		for i, col := range tblMeta.Columns {
+			cols[i] = col.Name
+		}
+
+		tblCols[ref.tblName] = cols
+		if ref.alias != "" {
+			tblCols[ref.alias] = cols
+		}
+		allCols = append(allCols, cols...)
+	}
+
+	if !colsKnown {
+		return nil, nil //nolint:nilnil
+	}
+
+	allCols = lo.Uniq(allCols)
+	for _, node := range refs.cols {
+		var colName string
+		candidates := allCols
+		switch node := node.(type) {
+		case *ast.ColSelectorNode:
+			colName = node.ColName()
+		case *ast.TblColSelectorNode:
+			colName = node.ColName()
+			var ok bool
+			if candidates, ok = tblCols[node.TblName()]; !ok {
+				// Could be a reference to a table in a subquery's
+				// enclosing query, which we don't attempt to resolve.
+				continue
+			}
+		}
+
+		if _, ok := refs.names[colName]; ok || lo.Contains(candidates, colName) {
+			continue
+		}
+
+		return ast.NewNodeError(query, node, "unknown column",
+			stringz.SuggestionsFor(colName, candidates), "%s", node.Text()), nil
+	}
+
+	return nil, nil //nolint:nilnil
+}
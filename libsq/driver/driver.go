@@ -1,9 +1,11 @@
 package driver
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	"log/slog"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +28,7 @@ import (
 	"github.com/neilotoole/sq/libsq/ast/render"
 	"github.com/neilotoole/sq/libsq/core/sqlmodel"
 	"github.com/neilotoole/sq/libsq/core/sqlz"
+	"github.com/neilotoole/sq/libsq/core/stringz"
 	"github.com/neilotoole/sq/libsq/source"
 )
 
@@ -124,6 +127,39 @@ repeated retry operations back off, typically using a Fibonacci backoff.`,
 		options.TagSource,
 	)
 
+	// OptJoinStrategy determines where a cross-source join is performed.
+	// See the JoinStrategy constants.
+	OptJoinStrategy = options.NewString(
+		"join.strategy",
+		"",
+		0,
+		JoinStrategyScratch,
+		func(s string) error {
+			switch s {
+			case JoinStrategyAuto, JoinStrategyScratch, JoinStrategyLargest:
+				return nil
+			default:
+				return errz.Errorf("invalid join strategy {%s}: must be one of: %s, %s, %s",
+					s, JoinStrategyAuto, JoinStrategyScratch, JoinStrategyLargest)
+			}
+		},
+		"Where to perform cross-source joins",
+		`Determines the database in which a cross-source join is performed. The
+tables from the other sources are copied into that database.
+
+  scratch: Always use a scratch database.
+  auto:    If exactly one of the join's sources is a SQL database (and the
+           others are document sources such as CSV), and that database is
+           larger than the other sources combined, use that database.
+           Otherwise use a scratch database.
+  largest: Use the largest of the join's SQL databases. If there are
+           none, use a scratch database.
+
+With the auto and largest strategies, sq creates (and drops) temporary
+tables in the chosen database. A database that isn't writable is not
+chosen.`,
+	)
+
 	// OptTuningErrgroupLimit controls the maximum number of goroutines that can be spawned
 	// by an errgroup.
 	OptTuningErrgroupLimit = options.NewInt(
@@ -315,6 +351,15 @@ type SQLDriver interface {
 	// is often a scalar such as an int, string, or bool, but can be a nested
 	// map or array.
 	DBProperties(ctx context.Context, db sqlz.DB) (map[string]any, error)
+
+	// Explain returns the DB's execution plan for query, whose bound
+	// parameter values are args. The query is not executed. The plan is
+	// text, in the DB's native format for the plan: for example, Postgres
+	// returns JSON, while SQLite returns a tree of plan steps.
+	//
+	// Note that db must guarantee a single connection: that is, db
+	// must be a sql.Conn or sql.Tx.
+	Explain(ctx context.Context, db sqlz.DB, query string, args ...any) (string, error)
 }
 
 // Database models a database handle. It is conceptually equivalent to
@@ -399,6 +444,9 @@ type Databases struct {
 	scratchSrcFn ScratchSrcFunc
 	dbases       map[string]Database
 	clnup        *cleanup.Cleanup
+
+	// writable caches the result of isWritable, keyed by source handle.
+	writable map[string]bool
 }
 
 // NewDatabases returns a Databases instances.
@@ -410,6 +458,7 @@ func NewDatabases(log *slog.Logger, drvrs Provider, scratchSrcFn ScratchSrcFunc)
 		scratchSrcFn: scratchSrcFn,
 		dbases:       map[string]Database{},
 		clnup:        cleanup.New(),
+		writable:     map[string]bool{},
 	}
 }
 
@@ -491,15 +540,22 @@ func (d *Databases) OpenScratch(ctx context.Context, name string) (Database, err
 	return backingDB, nil
 }
 
+// Join strategies, as used by OptJoinStrategy.
+const (
+	JoinStrategyAuto    = "auto"
+	JoinStrategyScratch = "scratch"
+	JoinStrategyLargest = "largest"
+)
+
 // OpenJoin opens an appropriate database for use as
 // a work DB for joining across sources.
 //
-// Note: There is much work to be done on this method. At this time, only
-// two sources are supported. Ultimately OpenJoin should be able to
-// inspect the join srcs and use heuristics to determine the best
-// location for the join to occur (to minimize copying of data for
-// the join etc.). Currently the implementation simply delegates
-// to OpenScratch.
+// Any number of sources may participate in the join. The database
+// is chosen per OptJoinStrategy: it may be one of srcs, in which case
+// the tables from the other sources are copied into it, or it may be a
+// scratch database, into which the tables from all of srcs are copied.
+// The caller can determine which by comparing the handle of the
+// returned database's source with those of srcs.
 //
 // OpenJoin implements JoinDatabaseOpener.
 func (d *Databases) OpenJoin(ctx context.Context, srcs ...*source.Source) (Database, error) {
@@ -508,10 +564,158 @@ func (d *Databases) OpenJoin(ctx context.Context, srcs ...*source.Source) (Datab
 		names = append(names, src.Handle[1:])
 	}
 
-	d.log.Debug("OpenJoin", "sources", strings.Join(names, ","))
+	strategy := OptJoinStrategy.Get(options.FromContext(ctx))
+	d.log.Debug("OpenJoin", "sources", strings.Join(names, ","), "strategy", strategy)
+
+	if strategy != JoinStrategyScratch {
+		src, err := d.joinSource(ctx, strategy, srcs)
+		if err != nil {
+			return nil, err
+		}
+
+		if src != nil {
+			d.log.Debug("OpenJoin: joining in source", lga.Src, src.Handle)
+			return d.Open(ctx, src)
+		}
+	}
+
 	return d.OpenScratch(ctx, "joindb__"+strings.Join(names, "_"))
 }
 
+// joinSource returns the source of srcs in which to perform a join,
+// as determined by strategy (which is either JoinStrategyAuto or
+// JoinStrategyLargest). If the join should be performed in a scratch
+// database, joinSource returns nil.
+//
+// The tables from the other sources are copied into the returned source,
+// so it must be a writable SQL source. For JoinStrategyLargest, it's the
+// largest such source. For JoinStrategyAuto, it's the only SQL source of
+// srcs, and only if that source is larger than the other sources combined:
+// otherwise, copying all of srcs to a scratch database is cheap enough
+// that it's not worth writing to the SQL source.
+func (d *Databases) joinSource(ctx context.Context, strategy string, srcs []*source.Source,
+) (*source.Source, error) {
+	var sqlSrcs []*source.Source
+	for _, src := range srcs {
+		drvr, err := d.drvrs.DriverFor(src.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		if drvr.DriverMetadata().IsSQL {
+			sqlSrcs = append(sqlSrcs, src)
+		}
+	}
+
+	if len(sqlSrcs) == 0 {
+		return nil, nil
+	}
+
+	sizes := make(map[string]int64, len(srcs))
+	for _, src := range srcs {
+		dbase, err := d.Open(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+
+		md, err := dbase.SourceMetadata(ctx, true)
+		if err != nil {
+			return nil, err
+		}
+		sizes[src.Handle] = md.Size
+	}
+
+	if strategy == JoinStrategyAuto {
+		if len(sqlSrcs) != 1 || len(srcs) == 1 {
+			return nil, nil
+		}
+
+		var others int64
+		for _, src := range srcs {
+			if src != sqlSrcs[0] {
+				others += sizes[src.Handle]
+			}
+		}
+
+		if sizes[sqlSrcs[0].Handle] <= others {
+			d.log.Debug("OpenJoin: SQL source is not larger than the other sources",
+				lga.Src, sqlSrcs[0].Handle)
+			return nil, nil
+		}
+	}
+
+	// Largest first.
+	slices.SortStableFunc(sqlSrcs, func(a, b *source.Source) int {
+		return cmp.Compare(sizes[b.Handle], sizes[a.Handle])
+	})
+
+	for _, src := range sqlSrcs {
+		ok, err := d.isWritable(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return src, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isWritable returns true if tables can be created in src. This is
+// determined by creating (and then dropping) a probe table. The result
+// is cached per source. Only a permission error from creating the probe
+// table indicates that src is not writable; any other error is returned.
+func (d *Databases) isWritable(ctx context.Context, src *source.Source) (bool, error) {
+	d.mu.Lock()
+	ok, cached := d.writable[src.Handle]
+	d.mu.Unlock()
+	if cached {
+		return ok, nil
+	}
+
+	ok, err := d.probeWritable(ctx, src)
+	if err != nil {
+		return false, err
+	}
+
+	d.mu.Lock()
+	d.writable[src.Handle] = ok
+	d.mu.Unlock()
+	return ok, nil
+}
+
+// probeWritable does the work of isWritable, without caching.
+func (d *Databases) probeWritable(ctx context.Context, src *source.Source) (bool, error) {
+	dbase, err := d.Open(ctx, src)
+	if err != nil {
+		return false, err
+	}
+
+	db, err := dbase.DB(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	drvr := dbase.SQLDriver()
+	tblDef := sqlmodel.NewTableDef(stringz.UniqTableName("sq_join_probe"), []string{"id"}, []kind.Kind{kind.Int})
+	if err = drvr.CreateTable(ctx, db, tblDef); err != nil {
+		if !errz.IsErrNoPermission(err) {
+			return false, err
+		}
+
+		d.log.Debug("OpenJoin: source is not writable", lga.Src, src.Handle, lga.Err, err)
+		return false, nil
+	}
+
+	if err = drvr.DropTable(ctx, db, tblDef.Name, true); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 // Close closes d, invoking Close on any instances opened via d.Open.
 func (d *Databases) Close() error {
 	d.log.Debug("Closing databases(s)...", lga.Count, d.clnup.Len())
@@ -31,6 +31,7 @@ import (
 	"github.com/neilotoole/sq/testh"
 	"github.com/neilotoole/sq/testh/fixt"
 	"github.com/neilotoole/sq/testh/sakila"
+	"github.com/neilotoole/sq/testh/testsrc"
 )
 
 func TestDriver_DropTable(t *testing.T) {
@@ -445,6 +446,113 @@ func TestDatabase_TableMetadata(t *testing.T) { //nolint:tparallel
 	}
 }
 
+// TestDatabases_OpenJoin verifies that Databases.OpenJoin chooses the
+// join DB per driver.OptJoinStrategy.
+func TestDatabases_OpenJoin(t *testing.T) {
+	const scratch = ""
+	// By size: sakila.db > address.csv > blob.db > actor.csv.
+	testCases := []struct {
+		name     string
+		strategy string
+		handles  []string
+		readOnly string
+		want     string
+	}{
+		{
+			name:     "scratch",
+			strategy: driver.JoinStrategyScratch,
+			handles:  []string{sakila.SL3, sakila.CSVActor},
+			want:     scratch,
+		},
+		{
+			name:     "auto",
+			strategy: driver.JoinStrategyAuto,
+			handles:  []string{sakila.CSVActor, sakila.SL3},
+			want:     sakila.SL3,
+		},
+		{
+			name:     "auto/sql-not-largest",
+			strategy: driver.JoinStrategyAuto,
+			handles:  []string{testsrc.BlobDB, sakila.CSVAddress},
+			want:     scratch,
+		},
+		{
+			name:     "auto/multiple-sql",
+			strategy: driver.JoinStrategyAuto,
+			handles:  []string{sakila.SL3, testsrc.BlobDB},
+			want:     scratch,
+		},
+		{
+			name:     "auto/read-only",
+			strategy: driver.JoinStrategyAuto,
+			handles:  []string{sakila.SL3, sakila.CSVActor},
+			readOnly: sakila.SL3,
+			want:     scratch,
+		},
+		{
+			name:     "largest",
+			strategy: driver.JoinStrategyLargest,
+			handles:  []string{testsrc.BlobDB, sakila.SL3, sakila.CSVActor},
+			want:     sakila.SL3,
+		},
+		{
+			name:     "largest/no-sql",
+			strategy: driver.JoinStrategyLargest,
+			handles:  []string{sakila.CSVActor, sakila.CSVAddress},
+			want:     scratch,
+		},
+		{
+			name:     "largest/read-only",
+			strategy: driver.JoinStrategyLargest,
+			handles:  []string{testsrc.BlobDB, sakila.SL3, sakila.CSVActor},
+			readOnly: sakila.SL3,
+			want:     testsrc.BlobDB,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			th := testh.New(t)
+			srcs := make([]*source.Source, len(tc.handles))
+			for i, handle := range tc.handles {
+				srcs[i] = th.Source(handle)
+				if handle == tc.readOnly {
+					src := srcs[i].Clone()
+					src.Handle += "_ro"
+					src.Location += "?_query_only=true"
+					srcs[i] = src
+				}
+			}
+
+			ctx := options.NewContext(th.Context, options.Options{
+				driver.OptJoinStrategy.Key(): tc.strategy,
+			})
+			joinDB, err := th.Databases().OpenJoin(ctx, srcs...)
+			require.NoError(t, err)
+
+			got := joinDB.Source().Handle
+
+			// A second open uses the cached writability of the sources,
+			// and should arrive at the same join DB.
+			joinDB2, err := th.Databases().OpenJoin(ctx, srcs...)
+			require.NoError(t, err)
+			if tc.want != scratch {
+				require.Equal(t, got, joinDB2.Source().Handle)
+			}
+
+			if tc.want == scratch {
+				for _, src := range srcs {
+					require.NotEqual(t, src.Handle, got, "should be a scratch DB")
+				}
+				return
+			}
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestDatabase_SourceMetadata(t *testing.T) {
 	t.Parallel()
 
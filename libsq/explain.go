@@ -0,0 +1,155 @@
+package libsq
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/libsq/driver"
+)
+
+// explainMeta is the record.Meta of the records written by ExplainSLQ
+// and ExplainSQL. Each record is a step of the query's execution: the
+// step number, the handle of the source that the step's SQL is executed
+// against, the SQL, the join DB table that the step's results are copied
+// to (which is null for the final step), and the source DB's plan for the SQL
+// (which is null for the final step of a cross-source query).
+var explainMeta = func() record.Meta {
+	cols := []struct {
+		name string
+		knd  kind.Kind
+		typ  reflect.Type
+	}{
+		{"step", kind.Int, reflect.TypeOf(int64(0))},
+		{"source", kind.Text, reflect.TypeOf("")},
+		{"sql", kind.Text, reflect.TypeOf("")},
+		{"copy_to", kind.Text, reflect.TypeOf("")},
+		{"plan", kind.Text, reflect.TypeOf("")},
+	}
+
+	recMeta := make(record.Meta, len(cols))
+	for i, col := range cols {
+		recMeta[i] = record.NewFieldMeta(&record.ColumnTypeData{
+			Name:        col.name,
+			HasNullable: true,
+			Nullable:    col.name == "copy_to" || col.name == "plan",
+			ScanType:    col.typ,
+			Kind:        col.knd,
+		}, col.name)
+	}
+	return recMeta
+}()
+
+// ExplainSLQ writes the execution plan of the SLQ query to recw,
+// instead of writing the query's results. A record is written for
+// each step of the query's execution. Typically there is just one
+// step, but a cross-source query first has steps that copy data to the
+// join DB. Each record holds the step's SQL, and the plan for that SQL
+// as reported by the DB it's executed against.
+//
+// The copy steps of a cross-source query are described, but not
+// executed: no data is copied. Thus the final step's tables don't
+// exist yet, and its plan can't be determined, so it is null.
+// The caller is responsible for closing qc.
+func ExplainSLQ(ctx context.Context, qc *QueryContext, query string, recw RecordWriter) error {
+	p, err := newPipeline(ctx, qc, query)
+	if err != nil {
+		return err
+	}
+
+	recs := make([]record.Record, 0, len(p.tasks)+1)
+	for _, task := range p.tasks {
+		fromDB, fromSQL, fromArgs, toDB, toTblName := task.copyQuery()
+
+		var plan string
+		if plan, err = explain(ctx, fromDB, fromSQL, fromArgs...); err != nil {
+			return err
+		}
+
+		recs = append(recs, record.Record{
+			int64(len(recs) + 1),
+			fromDB.Source().Handle,
+			fromSQL,
+			toDB.Source().Handle + "." + toTblName,
+			plan,
+		})
+	}
+
+	var plan any
+	if len(p.tasks) == 0 {
+		if plan, err = explain(ctx, p.targetDB, p.targetSQL, p.targetArgs...); err != nil {
+			return p.diagnose(ctx, err)
+		}
+	}
+
+	recs = append(recs, record.Record{
+		int64(len(recs) + 1),
+		p.targetDB.Source().Handle,
+		p.targetSQL,
+		nil,
+		plan,
+	})
+
+	return writeRecords(ctx, recw, explainMeta, recs)
+}
+
+// ExplainSQL writes the execution plan of the SQL query against
+// dbase to recw, instead of writing the query's results. A single
+// record is written, per ExplainSLQ. The caller is responsible for
+// closing dbase.
+func ExplainSQL(ctx context.Context, dbase driver.Database, recw RecordWriter, query string, args ...any) error {
+	plan, err := explain(ctx, dbase, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rec := record.Record{int64(1), dbase.Source().Handle, query, nil, plan}
+	return writeRecords(ctx, recw, explainMeta, []record.Record{rec})
+}
+
+// explain returns dbase's plan for query, via driver.SQLDriver.Explain.
+func explain(ctx context.Context, dbase driver.Database, query string, args ...any) (string, error) {
+	db, err := dbase.DB(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// The explain mechanism may change the state of the connection,
+	// so a dedicated connection is used.
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return "", errz.Err(err)
+	}
+	defer lg.WarnIfCloseError(lg.FromContext(ctx), lgm.CloseDB, conn)
+
+	return dbase.SQLDriver().Explain(ctx, conn, query, args...)
+}
+
+// writeRecords writes recs, described by recMeta, to recw. Note that
+// writeRecords may return before recw has finished writing.
+func writeRecords(ctx context.Context, recw RecordWriter, recMeta record.Meta, recs []record.Record) error {
+	ctx, cancelFn := context.WithCancel(ctx)
+	recCh, errCh, err := recw.Open(ctx, cancelFn, recMeta)
+	if err != nil {
+		cancelFn()
+		return err
+	}
+	defer close(recCh)
+
+	for _, rec := range recs {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err = <-errCh:
+			cancelFn()
+			return err
+		case recCh <- rec:
+		}
+	}
+
+	return nil
+}
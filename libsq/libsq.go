@@ -105,13 +105,17 @@ func ExecuteSLQ(ctx context.Context, qc *QueryContext, query string, recw Record
 
 // SLQ2SQL simulates execution of a SLQ query, but instead of executing
 // the resulting SQL query, that ultimate SQL is returned. Effectively it is
-// equivalent to libsq.ExecuteSLQ, but without the execution.
-func SLQ2SQL(ctx context.Context, qc *QueryContext, query string) (targetSQL string, err error) {
+// equivalent to libsq.ExecuteSLQ, but without the execution. String
+// literals and args may be rendered as the target dialect's bound
+// parameter placeholders (e.g. "$1"), in which case their values are
+// returned in targetArgs, in the order they are to be passed with
+// targetSQL to the DB.
+func SLQ2SQL(ctx context.Context, qc *QueryContext, query string) (targetSQL string, targetArgs []any, err error) {
 	p, err := newPipeline(ctx, qc, query)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
-	return p.targetSQL, nil
+	return p.targetSQL, p.targetArgs, nil
 }
 
 // QuerySQL executes the SQL query against dbase, writing
@@ -22,6 +22,7 @@ import (
 	"github.com/neilotoole/sq/libsq/core/errz"
 	"github.com/neilotoole/sq/libsq/core/sqlmodel"
 	"github.com/neilotoole/sq/libsq/core/sqlz"
+	"github.com/neilotoole/sq/libsq/core/stringz"
 	"github.com/neilotoole/sq/libsq/driver"
 	"golang.org/x/sync/errgroup"
 )
@@ -49,9 +50,21 @@ type pipeline struct {
 	// targetSQL is the ultimate SQL query to be executed against targetDB.
 	targetSQL string
 
+	// targetArgs are the bound parameter values of targetSQL.
+	targetArgs []any
+
 	// targetDB is the destination for the ultimate SQL query to
 	// be executed against.
 	targetDB driver.Database
+
+	// tmpTbls are the names of tables that the tasks create in targetDB,
+	// when targetDB is not a scratch DB. They are dropped after the query
+	// is executed.
+	tmpTbls []string
+
+	// refs holds the query's references to tables and columns. It is
+	// used by pipeline.diagnose if the query fails.
+	refs *queryRefs
 }
 
 // newPipeline parses query, returning a pipeline prepared for
@@ -64,7 +77,11 @@ func newPipeline(ctx context.Context, qc *QueryContext, query string) (*pipeline
 		return nil, err
 	}
 
-	qModel, err := buildQueryModel(qc, a)
+	if err = checkHandles(qc.Collection, query, a); err != nil {
+		return nil, err
+	}
+
+	qModel, err := buildQueryModel(qc, a, qc.Collection.ActiveHandle())
 	if err != nil {
 		return nil, err
 	}
@@ -72,10 +89,11 @@ func newPipeline(ctx context.Context, qc *QueryContext, query string) (*pipeline
 	p := &pipeline{
 		qc:    qc,
 		query: query,
+		refs:  newQueryRefs(qModel),
 	}
 
 	if err = p.prepare(ctx, qModel); err != nil {
-		return nil, err
+		return nil, p.diagnose(ctx, err)
 	}
 
 	return p, nil
@@ -87,13 +105,19 @@ func (p *pipeline) execute(ctx context.Context, recw RecordWriter) error {
 		"Execute SQL query",
 		lga.Src, p.targetDB.Source(),
 		lga.SQL, p.targetSQL,
+		lga.Args, p.targetArgs,
 	)
 
+	defer p.dropTmpTables(ctx)
 	if err := p.executeTasks(ctx); err != nil {
-		return err
+		return p.diagnose(ctx, err)
+	}
+
+	if err := QuerySQL(ctx, p.targetDB, recw, p.targetSQL, p.targetArgs...); err != nil {
+		return p.diagnose(ctx, err)
 	}
 
-	return QuerySQL(ctx, p.targetDB, recw, p.targetSQL)
+	return nil
 }
 
 // executeTasks executes any tasks in pipeline.tasks.
@@ -127,6 +151,31 @@ func (p *pipeline) executeTasks(ctx context.Context) error {
 	return g.Wait()
 }
 
+// dropTmpTables drops the tables in pipeline.tmpTbls, if any. Failures
+// are logged, but not returned.
+func (p *pipeline) dropTmpTables(ctx context.Context) {
+	if len(p.tmpTbls) == 0 {
+		return
+	}
+
+	// The tables should be dropped even if ctx is done.
+	ctx = context.WithoutCancel(ctx)
+	log := lg.FromContext(ctx)
+
+	db, err := p.targetDB.DB(ctx)
+	if err != nil {
+		log.Warn("Failed to drop temporary join tables", lga.Src, p.targetDB.Source(), lga.Err, err)
+		return
+	}
+
+	for _, tbl := range p.tmpTbls {
+		if err = p.targetDB.SQLDriver().DropTable(ctx, db, tbl, true); err != nil {
+			log.Warn("Failed to drop temporary join table",
+				lga.Src, p.targetDB.Source(), lga.Table, tbl, lga.Err, err)
+		}
+	}
+}
+
 // prepareNoTable is invoked when the queryModel doesn't have a table.
 // That is to say, the query doesn't have a "FROM table" clause. It is
 // this function's responsibility to figure out what source to use, and
@@ -149,11 +198,7 @@ func (p *pipeline) prepareNoTable(ctx context.Context, qm *queryModel) error {
 				return err
 			}
 
-			p.rc = &render.Context{
-				Renderer: p.targetDB.SQLDriver().Renderer(),
-				Args:     p.qc.Args,
-				Dialect:  p.targetDB.SQLDriver().Dialect(),
-			}
+			p.rc = p.newRenderContext(ctx, p.targetDB)
 			return nil
 		}
 
@@ -167,13 +212,31 @@ func (p *pipeline) prepareNoTable(ctx context.Context, qm *queryModel) error {
 		return err
 	}
 
-	p.rc = &render.Context{
-		Renderer: p.targetDB.SQLDriver().Renderer(),
+	p.rc = p.newRenderContext(ctx, p.targetDB)
+	return nil
+}
+
+// newRenderContext returns a new render.Context for rendering
+// a statement to be executed against db.
+func (p *pipeline) newRenderContext(ctx context.Context, db driver.Database) *render.Context {
+	return &render.Context{
+		Renderer: db.SQLDriver().Renderer(),
 		Args:     p.qc.Args,
-		Dialect:  p.targetDB.SQLDriver().Dialect(),
-	}
+		Dialect:  db.SQLDriver().Dialect(),
+		Params:   &render.Params{},
+		TableCols: func(tblName string) ([]string, error) {
+			md, err := db.TableMetadata(ctx, tblName)
+			if err != nil {
+				return nil, err
+			}
 
-	return nil
+			cols := make([]string, len(md.Columns))
+			for i := range md.Columns {
+				cols[i] = md.Columns[i].Name
+			}
+			return cols, nil
+		},
+	}
 }
 
 // prepareFromTable builds the "FROM table" fragment.
@@ -200,14 +263,8 @@ func (p *pipeline) prepareFromTable(ctx context.Context, tblSel *ast.TblSelector
 		return "", nil, err
 	}
 
-	rndr := fromConn.SQLDriver().Renderer()
-	p.rc = &render.Context{
-		Renderer: rndr,
-		Args:     p.qc.Args,
-		Dialect:  fromConn.SQLDriver().Dialect(),
-	}
-
-	fromClause, err = rndr.FromTable(p.rc, tblSel)
+	p.rc = p.newRenderContext(ctx, fromConn)
+	fromClause, err = p.rc.Renderer.FromTable(p.rc, tblSel)
 	if err != nil {
 		return "", nil, err
 	}
@@ -219,6 +276,11 @@ func (p *pipeline) prepareFromTable(ctx context.Context, tblSel *ast.TblSelector
 type joinClause struct {
 	leftTbl *ast.TblSelectorNode
 	joins   []*ast.JoinNode
+
+	// qm is the model of the query that the join is part of. It may
+	// be nil. For a cross-source join, it determines what is pushed
+	// down to the copy of each table.
+	qm *queryModel
 }
 
 // tables returns a new slice containing all referenced tables.
@@ -293,14 +355,8 @@ func (p *pipeline) joinSingleSource(ctx context.Context, jc *joinClause) (fromCl
 		return "", nil, err
 	}
 
-	rndr := fromDB.SQLDriver().Renderer()
-	p.rc = &render.Context{
-		Renderer: rndr,
-		Args:     p.qc.Args,
-		Dialect:  fromDB.SQLDriver().Dialect(),
-	}
-
-	fromClause, err = rndr.Join(p.rc, jc.leftTbl, jc.joins)
+	p.rc = p.newRenderContext(ctx, fromDB)
+	fromClause, err = p.rc.Renderer.Join(p.rc, jc.leftTbl, jc.joins)
 	if err != nil {
 		return "", nil, err
 	}
@@ -309,14 +365,16 @@ func (p *pipeline) joinSingleSource(ctx context.Context, jc *joinClause) (fromCl
 }
 
 // joinCrossSource returns a FROM clause that forms part of
-// the SQL SELECT statement against fromDB.
+// the SQL SELECT statement against fromDB. The join may reference any
+// number of sources: each table in the join is copied from its source
+// into the join DB, where the join is performed. If possible, the query's
+// predicates and column projection are pushed down to the copy of each
+// table (see joinClause.pushDowns), instead of copying the entire table.
 //
 // On return, pipeline.rc will be set.
 func (p *pipeline) joinCrossSource(ctx context.Context, jc *joinClause) (fromClause string,
 	fromDB driver.Database, err error,
 ) {
-	// FIXME: finish tidying up
-
 	handles := jc.handles()
 	srcs := make([]*source.Source, 0, len(handles))
 	for _, handle := range handles {
@@ -333,45 +391,84 @@ func (p *pipeline) joinCrossSource(ctx context.Context, jc *joinClause) (fromCla
 		return "", nil, err
 	}
 
-	rndr := joinDB.SQLDriver().Renderer()
-	p.rc = &render.Context{
-		Renderer: rndr,
-		Args:     p.qc.Args,
-		Dialect:  joinDB.SQLDriver().Dialect(),
-	}
+	p.rc = p.newRenderContext(ctx, joinDB)
+
+	// Per driver.OptJoinStrategy, the join DB may be one of the join's
+	// sources. If so, that source's tables are joined in place, and the
+	// other tables are copied into temporary tables in the join DB.
+	joinHandle := joinDB.Source().Handle
+	inPlace := lo.Contains(handles, joinHandle)
 
+	// A table without a handle belongs to the leftmost table's source.
 	leftHandle := jc.leftTbl.Handle()
-	// TODO: verify not empty
+	if leftHandle == "" {
+		return "", nil, errz.Errorf("cross-source join: no source for table %s", jc.leftTbl.TblName())
+	}
+
+	dbs := make(map[string]driver.Database, len(handles))
+	for i, src := range srcs {
+		if dbs[handles[i]], err = p.qc.DBOpener.Open(ctx, src); err != nil {
+			return "", nil, err
+		}
+	}
 
-	tbls := jc.tables()
-	for _, tbl := range tbls {
-		tbl := tbl
+	// Each table is copied to a join DB table named for the table's alias
+	// (or name), so those names must be unique.
+	toTblNames := make(map[string]string, len(jc.joins)+1)
+	pds := jc.pushDowns()
+	for _, tbl := range jc.tables() {
 		handle := tbl.Handle()
 		if handle == "" {
 			handle = leftHandle
 		}
-		var src *source.Source
-		if src, err = p.qc.Collection.Get(handle); err != nil {
-			return "", nil, err
+
+		toTblName := tbl.TblAliasOrName()
+		fromTbl := handle + "." + tbl.TblName()
+		if other, ok := toTblNames[toTblName]; ok {
+			return "", nil, errz.Errorf("cross-source join: tables %s and %s must have distinct aliases",
+				other, fromTbl)
 		}
-		var db driver.Database
-		if db, err = p.qc.DBOpener.Open(ctx, src); err != nil {
-			return "", nil, err
+		toTblNames[toTblName] = fromTbl
+
+		if inPlace && handle == joinHandle {
+			// The table is already in the join DB.
+			continue
 		}
 
-		task := &joinCopyTask{
-			fromDB:      db,
-			fromTblName: tbl.TblName(),
-			toDB:        joinDB,
-			toTblName:   tbl.TblAliasOrName(),
+		pd := pds[toTblName]
+		if inPlace {
+			toTblName = stringz.UniqTableName(toTblName)
+			p.tmpTbls = append(p.tmpTbls, toTblName)
 		}
 
-		tbl.SyncTblNameAlias()
+		var task tasker
+		if pd.isEmpty() {
+			task = &joinCopyTask{
+				fromDB:      dbs[handle],
+				fromTblName: tbl.TblName(),
+				toDB:        joinDB,
+				toTblName:   toTblName,
+			}
+		} else {
+			qt := &queryCopyTask{fromDB: dbs[handle], toDB: joinDB, toTblName: toTblName}
+			if qt.fromSQL, qt.fromArgs, err = p.renderPushDown(ctx, qt.fromDB, tbl, pd); err != nil {
+				return "", nil, err
+			}
+			task = qt
+		}
 
+		if inPlace {
+			tbl.ReplaceTblName(toTblName)
+		} else {
+			tbl.SyncTblNameAlias()
+		}
 		p.tasks = append(p.tasks, task)
 	}
 
-	fromClause, err = rndr.Join(p.rc, jc.leftTbl, jc.joins)
+	lg.FromContext(ctx).Debug("Cross-source join",
+		lga.Handle, handles, lga.Target, joinHandle, lga.Count, len(p.tasks))
+
+	fromClause, err = p.rc.Renderer.Join(p.rc, jc.leftTbl, jc.joins)
 	if err != nil {
 		return "", nil, err
 	}
@@ -383,6 +480,10 @@ func (p *pipeline) joinCrossSource(ctx context.Context, jc *joinClause) (fromCla
 type tasker interface {
 	// executeTask executes a task against the DB.
 	executeTask(ctx context.Context) error
+
+	// copyQuery returns the query (and its args) that the task executes
+	// against fromDB, whose results are copied to table toTblName in toDB.
+	copyQuery() (fromDB driver.Database, query string, args []any, toDB driver.Database, toTblName string)
 }
 
 // joinCopyTask is a specification of a table data copy task to be performed
@@ -400,9 +501,46 @@ func (jt *joinCopyTask) executeTask(ctx context.Context) error {
 	return execCopyTable(ctx, jt.fromDB, jt.fromTblName, jt.toDB, jt.toTblName)
 }
 
+func (jt *joinCopyTask) copyQuery() (driver.Database, string, []any, driver.Database, string) {
+	return jt.fromDB, selectAllQuery(jt.fromDB, jt.fromTblName), nil, jt.toDB, jt.toTblName
+}
+
+// queryCopyTask is a specification of a task to copy the result of
+// executing fromSQL (with args fromArgs) against fromDB into table
+// toTblName in toDB. It is used for cross-source set operations
+// (UNION, etc.).
+type queryCopyTask struct {
+	fromDB    driver.Database
+	fromSQL   string
+	fromArgs  []any
+	toDB      driver.Database
+	toTblName string
+}
+
+func (qt *queryCopyTask) executeTask(ctx context.Context) error {
+	return execCopyQuery(ctx, qt.fromDB, qt.fromSQL, qt.fromArgs, qt.toDB, qt.toTblName)
+}
+
+func (qt *queryCopyTask) copyQuery() (driver.Database, string, []any, driver.Database, string) {
+	return qt.fromDB, qt.fromSQL, qt.fromArgs, qt.toDB, qt.toTblName
+}
+
 // execCopyTable performs the work of copying fromDB.fromTblName to destDB.destTblName.
 func execCopyTable(ctx context.Context, fromDB driver.Database, fromTblName string,
 	destDB driver.Database, destTblName string,
+) error {
+	return execCopyQuery(ctx, fromDB, selectAllQuery(fromDB, fromTblName), nil, destDB, destTblName)
+}
+
+// selectAllQuery returns a query that selects all of tbl in dbase.
+func selectAllQuery(dbase driver.Database, tbl string) string {
+	return "SELECT * FROM " + dbase.SQLDriver().Dialect().Enquote(tbl)
+}
+
+// execCopyQuery performs the work of copying the result of executing
+// query (with args) against fromDB to destDB.destTblName.
+func execCopyQuery(ctx context.Context, fromDB driver.Database, query string, args []any,
+	destDB driver.Database, destTblName string,
 ) error {
 	log := lg.FromContext(ctx)
 
@@ -428,8 +566,7 @@ func execCopyTable(ctx context.Context, fromDB driver.Database, fromTblName stri
 		createTblHook,
 	)
 
-	query := "SELECT * FROM " + fromDB.SQLDriver().Dialect().Enquote(fromTblName)
-	err := QuerySQL(ctx, fromDB, inserter, query)
+	err := QuerySQL(ctx, fromDB, inserter, query, args...)
 	if err != nil {
 		return errz.Wrapf(err, "insert %s.%s failed", destDB.Source().Handle, destTblName)
 	}
@@ -439,7 +576,8 @@ func execCopyTable(ctx context.Context, fromDB driver.Database, fromTblName stri
 		return errz.Wrapf(err, "insert %s.%s failed", destDB.Source().Handle, destTblName)
 	}
 	log.Debug("Copied rows to dest", lga.Count, affected,
-		lga.From, fmt.Sprintf("%s.%s", fromDB.Source().Handle, fromTblName),
+		lga.From, fromDB.Source().Handle,
+		lga.SQL, query,
 		lga.To, fmt.Sprintf("%s.%s", destDB.Source().Handle, destTblName))
 	return nil
 }
@@ -2,8 +2,16 @@ package libsq
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/samber/lo"
+
+	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/ast/render"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+	"github.com/neilotoole/sq/libsq/source"
 )
 
 // prepare prepares the pipeline to execute queryModel.
@@ -12,6 +20,15 @@ import (
 // against targetDB before targetSQL is executed (the pipeline.execute
 // method does this work).
 func (p *pipeline) prepare(ctx context.Context, qm *queryModel) error {
+	if len(qm.SetOps) > 0 && len(qm.allHandles()) > 1 {
+		return p.prepareSetOpsCrossSource(ctx, qm)
+	}
+
+	if len(qm.Nested) > 0 && len(qm.allHandles()) > 1 {
+		return errz.Errorf("subqueries and CTEs must be against the same source as the enclosing query: %s",
+			qm.AST.Text())
+	}
+
 	var (
 		err   error
 		frags = &render.Fragments{}
@@ -24,7 +41,7 @@ func (p *pipeline) prepare(ctx context.Context, qm *queryModel) error {
 			return err
 		}
 	case len(qm.Joins) > 0:
-		jc := &joinClause{leftTbl: qm.Table, joins: qm.Joins}
+		jc := &joinClause{leftTbl: qm.Table, joins: qm.Joins, qm: qm}
 		if frags.From, p.targetDB, err = p.prepareFromJoin(ctx, jc); err != nil {
 			return err
 		}
@@ -34,47 +51,353 @@ func (p *pipeline) prepare(ctx context.Context, qm *queryModel) error {
 		}
 	}
 
-	rndr := p.rc.Renderer
-	if frags.Columns, err = rndr.SelectCols(p.rc, qm.Cols); err != nil {
+	var sql string
+	if sql, err = renderQuery(p.rc, qm, frags); err != nil {
 		return err
 	}
 
-	if qm.Distinct != nil {
-		if frags.Distinct, err = rndr.Distinct(p.rc, qm.Distinct); err != nil {
-			return err
+	p.targetSQL, p.targetArgs, err = p.rc.Params.Bind(p.rc.Dialect, sql)
+	return err
+}
+
+// renderQuery renders qm as a SELECT statement using rc, where the FROM
+// clause of qm has already been rendered into frags. The set operation
+// queries of qm must be against the same source as qm.
+func renderQuery(rc *render.Context, qm *queryModel, frags *render.Fragments) (string, error) {
+	rc = nestedRenderContext(rc, qm)
+	if err := renderFragments(rc, qm, frags); err != nil {
+		return "", err
+	}
+
+	setOps := make([]string, len(qm.SetOps))
+	for i, so := range qm.SetOps {
+		sql, err := renderQueryModel(rc, so.Query)
+		if err != nil {
+			return "", err
+		}
+
+		var op string
+		if op, err = rc.Renderer.SetOp(rc, so.Node.Op()); err != nil {
+			return "", err
+		}
+
+		setOps[i] = op + " " + sql
+	}
+	// The fragments may already have set operations, e.g. if
+	// Renderer.Groupings rewrote the query as a UNION ALL.
+	frags.SetOps = strings.TrimSpace(frags.SetOps + " " + strings.Join(setOps, " "))
+
+	if err := renderRange(rc, qm.Range, frags); err != nil {
+		return "", err
+	}
+
+	rndr := rc.Renderer
+	if rndr.PreRender != nil {
+		if err := rndr.PreRender(rc, frags); err != nil {
+			return "", err
 		}
 	}
 
-	if qm.Range != nil {
-		if frags.Range, err = rndr.Range(p.rc, qm.Range); err != nil {
+	return rndr.Render(rc, frags)
+}
+
+// renderFragments renders the fragments of qm (excepting the FROM clause,
+// set operations and row range) into frags.
+func renderFragments(rc *render.Context, qm *queryModel, frags *render.Fragments) error {
+	var (
+		err  error
+		rndr = rc.Renderer
+	)
+
+	// The select list and GROUP BY are rendered with literal values inlined,
+	// rather than as bound parameters. A bound parameter is a distinct
+	// expression each time it occurs, so the DB couldn't tell that a
+	// selected expression is the grouped expression (e.g. MySQL with
+	// ONLY_FULL_GROUP_BY rejects the query), and Postgres can't determine
+	// the type of a parameter that is merely selected. If the query is
+	// grouped, HAVING and ORDER BY may reference the grouped expressions
+	// too, and so are likewise inlined.
+	irc := *rc
+	irc.Params = nil
+	grc := rc
+	if qm.GroupBy != nil {
+		grc = &irc
+	}
+
+	if len(qm.CTEs) > 0 {
+		if frags.With, err = rndr.With(rc, qm.CTEs); err != nil {
 			return err
 		}
 	}
 
+	if frags.Columns, err = rndr.SelectCols(&irc, qm.Cols); err != nil {
+		return err
+	}
+
 	if qm.Where != nil {
-		if frags.Where, err = rndr.Where(p.rc, qm.Where); err != nil {
+		if frags.Where, err = rndr.Where(rc, qm.Where); err != nil {
 			return err
 		}
 	}
 
 	if qm.OrderBy != nil {
-		if frags.OrderBy, err = rndr.OrderBy(p.rc, qm.OrderBy); err != nil {
+		if frags.OrderBy, err = rndr.OrderBy(grc, qm.OrderBy); err != nil {
 			return err
 		}
 	}
 
-	if qm.GroupBy != nil {
-		if frags.GroupBy, err = rndr.GroupBy(p.rc, qm.GroupBy); err != nil {
+	groupings := qm.GroupBy != nil && qm.GroupBy.HasGroupings() && rndr.Groupings != nil
+	if qm.GroupBy != nil && !groupings {
+		if frags.GroupBy, err = rndr.GroupBy(&irc, qm.GroupBy); err != nil {
+			return err
+		}
+	}
+
+	if qm.Having != nil {
+		if frags.Having, err = rndr.Having(grc, qm.Having); err != nil {
 			return err
 		}
 	}
 
+	// Groupings, UniqueBy and Top may rewrite the other fragments, so
+	// they must be invoked after those are rendered.
+	if groupings {
+		if err = rndr.Groupings(&irc, qm.GroupBy, qm.Cols, frags); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case qm.Top != nil:
+		err = rndr.Top(rc, qm.Top, qm.OrderBy, frags)
+	case qm.Distinct == nil:
+	case len(qm.Distinct.Columns()) == 0:
+		frags.Distinct, err = rndr.Distinct(rc, qm.Distinct)
+	default:
+		err = rndr.UniqueBy(rc, qm.Distinct, qm.OrderBy, frags)
+	}
+
+	return err
+}
+
+// renderRange renders row range rr (which may be nil) into frags.Range. It
+// must be invoked after the other fragments of frags are rendered, because
+// a row range with a negative index, e.g. ".[-10:]", is resolved against
+// the row count of the query that those fragments make up.
+func renderRange(rc *render.Context, rr *ast.RowRangeNode, frags *render.Fragments) error {
+	if rr == nil {
+		return nil
+	}
+
+	if rr.Negative() && rc.Renderer.RangeFilter != nil {
+		return rc.Renderer.RangeFilter(rc, rr, frags)
+	}
+
+	rrc := *rc
+	rrc.RangeQuery = func(rc *render.Context) (string, error) {
+		// The ORDER BY doesn't affect the row count, and the CTEs are
+		// still in scope, as the range is part of the same statement.
+		f := *frags
+		f.With, f.OrderBy, f.Range = "", "", ""
+		if rc.Renderer.PreRender != nil {
+			if err := rc.Renderer.PreRender(rc, &f); err != nil {
+				return "", err
+			}
+		}
+		return rc.Renderer.Render(rc, &f)
+	}
+
+	var err error
+	frags.Range, err = rc.Renderer.Range(&rrc, rr)
+	return err
+}
+
+// renderQueryModel renders qm as a standalone SELECT statement using rc.
+// The query must be against a single source.
+func renderQueryModel(rc *render.Context, qm *queryModel) (string, error) {
+	var (
+		err   error
+		frags = &render.Fragments{}
+	)
+
+	if frags.From, err = renderFrom(rc, qm); err != nil {
+		return "", err
+	}
+
+	return renderQuery(rc, qm, frags)
+}
+
+// renderFrom renders the FROM clause of qm, which must
+// be against a single source.
+func renderFrom(rc *render.Context, qm *queryModel) (string, error) {
+	if len(qm.Joins) > 0 {
+		return rc.Renderer.Join(rc, qm.Table, qm.Joins)
+	}
+	return rc.Renderer.FromTable(rc, qm.Table)
+}
+
+// nestedRenderContext returns a shallow copy of rc, whose Nested
+// func renders the subqueries and CTEs of qm.
+func nestedRenderContext(rc *render.Context, qm *queryModel) *render.Context {
+	nrc := *rc
+	nrc.Nested = func(rc *render.Context, a *ast.AST) (string, error) {
+		nqm, ok := qm.Nested[a]
+		if !ok {
+			return "", errz.Errorf("no model for nested query: %s", a.Text())
+		}
+		return renderQueryModel(rc, nqm)
+	}
+	return &nrc
+}
+
+// prepareSetOpsCrossSource prepares a query with set operations (UNION, etc.)
+// whose component queries are against more than one source. Each component
+// query is executed against its own source, and the results are copied into
+// the join DB (in the same manner as joinCrossSource), where the set operations
+// are performed. If the join DB is one of the sources, that source's component
+// queries are performed in place, and the copied tables are temporary.
+//
+// On return, pipeline.rc, pipeline.targetDB and pipeline.targetSQL will be set.
+func (p *pipeline) prepareSetOpsCrossSource(ctx context.Context, qm *queryModel) error {
+	// The ordering and range of the main query apply to the combined
+	// result, so the first component query is a copy of the main query
+	// without them.
+	first := *qm
+	first.OrderBy, first.Range, first.SetOps = nil, nil, nil
+	components := []*queryModel{&first}
+	for _, so := range qm.SetOps {
+		components = append(components, so.Query)
+	}
+
+	// A component query that doesn't specify a source uses the
+	// source of the outer query, or else the active source.
+	defaultHandle := p.qc.Collection.ActiveHandle()
+	if handles := qm.handles(); len(handles) > 0 {
+		defaultHandle = handles[0]
+	}
+
+	var (
+		err      error
+		handles  []string
+		cHandles = make([]string, len(components))
+	)
+	for i, cqm := range components {
+		h := cqm.allHandles()
+		switch {
+		case len(h) == 1:
+			cHandles[i] = h[0]
+		case len(h) == 0 && defaultHandle != "":
+			cHandles[i] = defaultHandle
+		case len(h) == 0:
+			return errz.Errorf("set operation: query does not specify source, and no active source: %s",
+				cqm.AST.Text())
+		default:
+			return errz.Errorf("set operation: each query must be against a single source, but got %d: %s",
+				len(h), cqm.AST.Text())
+		}
+		handles = append(handles, cHandles[i])
+	}
+
+	handles = lo.Uniq(handles)
+	srcs := make([]*source.Source, len(handles))
+	for i := range handles {
+		if srcs[i], err = p.qc.Collection.Get(handles[i]); err != nil {
+			return err
+		}
+	}
+
+	joinDB, err := p.qc.JoinDBOpener.OpenJoin(ctx, srcs...)
+	if err != nil {
+		return err
+	}
+
+	p.targetDB = joinDB
+	p.rc = p.newRenderContext(ctx, joinDB)
+	enquote := p.rc.Dialect.Enquote
+
+	// Per driver.OptJoinStrategy, the join DB may be one of the sources.
+	// If so, the component queries against that source are performed in
+	// place, as derived tables, and the results of the others are copied
+	// into temporary tables in the join DB.
+	joinHandle := joinDB.Source().Handle
+	inPlace := lo.Contains(handles, joinHandle)
+
+	frags := &render.Fragments{Columns: "*"}
+	setOps := make([]string, 0, len(qm.SetOps))
+	for i, cqm := range components {
+		var from string
+		toTblName := fmt.Sprintf("setop_%d", i)
+		if inPlace && cHandles[i] == joinHandle {
+			// The component query is rendered as part of the target SQL,
+			// so its params are bound along with the target's.
+			var sql string
+			if sql, err = renderQueryModel(p.rc, cqm); err != nil {
+				return err
+			}
+			from = "(" + sql + ") AS " + enquote(toTblName)
+		} else {
+			if inPlace {
+				toTblName = stringz.UniqTableName(toTblName)
+				p.tmpTbls = append(p.tmpTbls, toTblName)
+			}
+
+			var src *source.Source
+			if src, err = p.qc.Collection.Get(cHandles[i]); err != nil {
+				return err
+			}
+
+			task := &queryCopyTask{toDB: joinDB, toTblName: toTblName}
+			if task.fromDB, err = p.qc.DBOpener.Open(ctx, src); err != nil {
+				return err
+			}
+
+			rc := p.newRenderContext(ctx, task.fromDB)
+			var sql string
+			if sql, err = renderQueryModel(rc, cqm); err != nil {
+				return err
+			}
+			if task.fromSQL, task.fromArgs, err = rc.Params.Bind(rc.Dialect, sql); err != nil {
+				return err
+			}
+			p.tasks = append(p.tasks, task)
+			from = enquote(toTblName)
+		}
+
+		if i == 0 {
+			frags.From = "FROM " + from
+			continue
+		}
+
+		var op string
+		if op, err = p.rc.Renderer.SetOp(p.rc, qm.SetOps[i-1].Node.Op()); err != nil {
+			return err
+		}
+		setOps = append(setOps, op+" SELECT * FROM "+from)
+	}
+	frags.SetOps = strings.Join(setOps, " ")
+
+	rndr := p.rc.Renderer
+	if qm.OrderBy != nil {
+		if frags.OrderBy, err = rndr.OrderBy(p.rc, qm.OrderBy); err != nil {
+			return err
+		}
+	}
+
+	if err = renderRange(p.rc, qm.Range, frags); err != nil {
+		return err
+	}
+
 	if rndr.PreRender != nil {
 		if err = rndr.PreRender(p.rc, frags); err != nil {
 			return err
 		}
 	}
 
-	p.targetSQL, err = rndr.Render(p.rc, frags)
+	var sql string
+	if sql, err = rndr.Render(p.rc, frags); err != nil {
+		return err
+	}
+
+	p.targetSQL, p.targetArgs, err = p.rc.Params.Bind(p.rc.Dialect, sql)
 	return err
 }
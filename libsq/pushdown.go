@@ -0,0 +1,244 @@
+package libsq
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/ast/render"
+	"github.com/neilotoole/sq/libsq/core/jointype"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/driver"
+)
+
+// pushDown holds the parts of a cross-source join query that can be
+// pushed down to the query that copies one of the join's tables into
+// the join DB. Pushing down means that only the rows and columns that
+// the join query needs are copied, instead of the entire table.
+type pushDown struct {
+	// cols are the names of the table's columns that are referenced
+	// by the query. If empty, all the table's columns are copied.
+	cols []string
+
+	// where holds the conjuncts (the operands of the top-level "&&"
+	// operators) of the query's WHERE clause that reference only the
+	// table. They remain part of the join query's WHERE clause.
+	where []*ast.ExprNode
+}
+
+// isEmpty returns true if there's nothing to push down.
+func (pd *pushDown) isEmpty() bool {
+	return pd == nil || (len(pd.cols) == 0 && len(pd.where) == 0)
+}
+
+// pushDowns returns the pushDown for each table of jc, keyed by the
+// table's alias (or name, if there's no alias). The query's WHERE clause
+// and columns are taken from jc.qm.
+//
+// A WHERE conjunct is pushed down only if each of its column selectors
+// is qualified with the table (e.g. ".actor.first_name"), and if the table
+// isn't on the null-supplying side of an outer join (filtering such a
+// table before the join isn't equivalent to filtering after it). Columns
+// are pushed down only if every column selector in the query is
+// qualified, and the query doesn't select all columns.
+func (jc *joinClause) pushDowns() map[string]*pushDown {
+	tbls := jc.tables()
+	pds := make(map[string]*pushDown, len(tbls))
+	for _, tbl := range tbls {
+		pds[tbl.TblAliasOrName()] = &pushDown{}
+	}
+
+	qm := jc.qm
+	if qm == nil {
+		return pds
+	}
+
+	if qm.Where != nil {
+		nullable := jc.nullableTables()
+		for _, expr := range whereConjuncts(qm.Where.Expr()) {
+			tblNames, ok := exprTables(expr)
+			if !ok || len(tblNames) != 1 || nullable[tblNames[0]] {
+				continue
+			}
+
+			if pd, ok := pds[tblNames[0]]; ok {
+				pd.where = append(pd.where, expr)
+			}
+		}
+	}
+
+	if len(qm.Cols) == 0 {
+		// The query selects all columns.
+		return pds
+	}
+
+	cols := map[string][]string{}
+	qualified := true
+	_ = ast.NewWalker(qm.AST).
+		AddVisitor(reflect.TypeOf((*ast.ColSelectorNode)(nil)), func(_ *ast.Walker, _ ast.Node) error {
+			qualified = false
+			return nil
+		}).
+		AddVisitor(reflect.TypeOf((*ast.TblColSelectorNode)(nil)), func(_ *ast.Walker, node ast.Node) error {
+			sel, _ := node.(*ast.TblColSelectorNode)
+			cols[sel.TblName()] = append(cols[sel.TblName()], sel.ColName())
+			return nil
+		}).
+		Walk()
+
+	if !qualified {
+		return pds
+	}
+
+	for tblName, pd := range pds {
+		pd.cols = lo.Uniq(cols[tblName])
+	}
+
+	return pds
+}
+
+// nullableTables returns the set of the aliases (or names) of the
+// tables of jc that are on the null-supplying side of an outer join.
+func (jc *joinClause) nullableTables() map[string]bool {
+	tbls := jc.tables()
+	nullable := map[string]bool{}
+	for i, join := range jc.joins {
+		var from, to int
+		switch join.JoinType() { //nolint:exhaustive
+		case jointype.Left, jointype.LeftOuter:
+			from, to = i+1, i+2
+		case jointype.Right, jointype.RightOuter:
+			from, to = 0, i+1
+		case jointype.FullOuter:
+			from, to = 0, i+2
+		default:
+			continue
+		}
+
+		for _, tbl := range tbls[from:to] {
+			nullable[tbl.TblAliasOrName()] = true
+		}
+	}
+
+	return nullable
+}
+
+// whereConjuncts returns the operands of the top-level "&&" operators
+// of expr. If expr has no such operator, expr itself is returned.
+// Operands that aren't expressions (e.g. a bare selector) are omitted,
+// as they can't be pushed down.
+func whereConjuncts(expr *ast.ExprNode) []*ast.ExprNode {
+	children := expr.Children()
+	if len(children) == 1 {
+		if child, ok := children[0].(*ast.ExprNode); ok {
+			// A parenthesized expression.
+			return whereConjuncts(child)
+		}
+	}
+
+	if len(children) != 3 {
+		return []*ast.ExprNode{expr}
+	}
+
+	if op, ok := children[1].(*ast.OperatorNode); !ok || op.Text() != "&&" {
+		return []*ast.ExprNode{expr}
+	}
+
+	var conjuncts []*ast.ExprNode
+	for _, child := range []ast.Node{children[0], children[2]} {
+		if child, ok := child.(*ast.ExprNode); ok {
+			conjuncts = append(conjuncts, whereConjuncts(child)...)
+		}
+	}
+	return conjuncts
+}
+
+// exprTables returns the distinct table names (or aliases) referenced
+// by the column selectors of expr. It returns false if expr can't be
+// evaluated against a single table, e.g. because it has an unqualified
+// column selector, a subquery, or a proprietary function.
+func exprTables(expr *ast.ExprNode) (tblNames []string, ok bool) {
+	ok = true
+	_ = ast.NewWalker(expr).AddVisitor(reflect.TypeOf((*ast.Node)(nil)).Elem(),
+		func(_ *ast.Walker, node ast.Node) error {
+			switch node := node.(type) {
+			case *ast.TblColSelectorNode:
+				tblNames = append(tblNames, node.TblName())
+			case *ast.ColSelectorNode, *ast.SubqueryNode:
+				ok = false
+			case *ast.FuncNode:
+				// A proprietary function is specific to the join DB,
+				// and may not exist in the table's source DB.
+				if node.IsProprietary() {
+					ok = false
+				}
+			}
+			return nil
+		}).Walk()
+
+	return lo.Uniq(tblNames), ok
+}
+
+// renderPushDown renders the SQL query, and its args, that copies the
+// rows and columns of tbl required by pd from fromDB.
+func (p *pipeline) renderPushDown(ctx context.Context, fromDB driver.Database, tbl *ast.TblSelectorNode,
+	pd *pushDown,
+) (sql string, args []any, err error) {
+	rc := p.newRenderContext(ctx, fromDB)
+	rndr := rc.Renderer
+
+	frags := &render.Fragments{Columns: "*"}
+	if len(pd.cols) > 0 {
+		cols := make([]string, len(pd.cols))
+		for i := range pd.cols {
+			cols[i] = rc.Dialect.Enquote(pd.cols[i])
+		}
+		frags.Columns = strings.Join(cols, ", ")
+	}
+
+	if frags.From, err = rndr.FromTable(rc, tbl); err != nil {
+		return "", nil, err
+	}
+
+	if len(pd.where) > 0 {
+		conds := make([]string, len(pd.where))
+		for i := range pd.where {
+			if conds[i], err = rndr.Expr(rc, pd.where[i]); err != nil {
+				return "", nil, err
+			}
+			if len(pd.where) > 1 && !pd.where[i].HasParens() {
+				conds[i] = "(" + conds[i] + ")"
+			}
+		}
+		frags.Where = "WHERE " + strings.Join(conds, " AND ")
+	}
+
+	if rndr.PreRender != nil {
+		if err = rndr.PreRender(rc, frags); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if sql, err = rndr.Render(rc, frags); err != nil {
+		return "", nil, err
+	}
+
+	if sql, args, err = rc.Params.Bind(rc.Dialect, sql); err != nil {
+		return "", nil, err
+	}
+
+	lg.FromContext(ctx).Debug("Cross-source join: push down to copy query",
+		lga.Src, fromDB.Source().Handle,
+		lga.Table, tbl.TblName(),
+		lga.Col, pd.cols,
+		lga.Count, len(pd.where),
+		lga.SQL, sql,
+		lga.Args, args,
+	)
+
+	return sql, args, nil
+}
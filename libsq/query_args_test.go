@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/postgres"
+	"github.com/neilotoole/sq/drivers/sqlserver"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -12,21 +14,44 @@ import (
 func TestQuery_args(t *testing.T) {
 	testCases := []queryTestCase{
 		{
-			name:         "arg_value_string",
-			in:           `@sakila | .actor | where(.first_name == $first)`,
-			args:         map[string]string{"first": "TOM"},
-			wantSQL:      `SELECT * FROM "actor" WHERE "first_name" = 'TOM'`,
-			override:     driverMap{mysql.Type: "SELECT * FROM `actor` WHERE `first_name` = 'TOM'"},
+			name:    "arg_value_string",
+			in:      `@sakila | .actor | where(.first_name == $first)`,
+			args:    map[string]string{"first": "TOM"},
+			wantSQL: `SELECT * FROM "actor" WHERE "first_name" = ?`,
+			override: driverMap{
+				postgres.Type:  `SELECT * FROM "actor" WHERE "first_name" = $1`,
+				mysql.Type:     "SELECT * FROM `actor` WHERE `first_name` = ?",
+				sqlserver.Type: `SELECT * FROM "actor" WHERE "first_name" = @p1`,
+			},
 			wantRecCount: 2,
 		},
 		{
-			name:         "arg_value_string_2",
-			in:           `@sakila | .actor | where(.first_name == $first && .last_name == $last)`,
-			args:         map[string]string{"first": "TOM", "last": "MIRANDA"},
-			wantSQL:      `SELECT * FROM "actor" WHERE "first_name" = 'TOM' AND "last_name" = 'MIRANDA'`,
-			override:     driverMap{mysql.Type: "SELECT * FROM `actor` WHERE `first_name` = 'TOM' AND `last_name` = 'MIRANDA'"},
+			name:    "arg_value_string_2",
+			in:      `@sakila | .actor | where(.first_name == $first && .last_name == $last)`,
+			args:    map[string]string{"first": "TOM", "last": "MIRANDA"},
+			wantSQL: `SELECT * FROM "actor" WHERE "first_name" = ? AND "last_name" = ?`,
+			override: driverMap{
+				postgres.Type:  `SELECT * FROM "actor" WHERE "first_name" = $1 AND "last_name" = $2`,
+				mysql.Type:     "SELECT * FROM `actor` WHERE `first_name` = ? AND `last_name` = ?",
+				sqlserver.Type: `SELECT * FROM "actor" WHERE "first_name" = @p1 AND "last_name" = @p2`,
+			},
 			wantRecCount: 1,
 		},
+		{
+			// Each occurrence of the arg is a distinct parameter, as the
+			// DB may deduce a different type for each.
+			name:    "arg_value_string_repeated",
+			in:      `@sakila | .actor | where(.first_name == $name || .last_name == $name)`,
+			args:    map[string]string{"name": "TOM"},
+			wantSQL: `SELECT * FROM "actor" WHERE "first_name" = ? OR "last_name" = ?`,
+			override: driverMap{
+				postgres.Type:  `SELECT * FROM "actor" WHERE "first_name" = $1 OR "last_name" = $2`,
+				mysql.Type:     "SELECT * FROM `actor` WHERE `first_name` = ? OR `last_name` = ?",
+				sqlserver.Type: `SELECT * FROM "actor" WHERE "first_name" = @p1 OR "last_name" = @p2`,
+			},
+			wantArgs:     []any{"TOM", "TOM"},
+			wantRecCount: 2,
+		},
 		{
 			name:         "arg_value_int",
 			in:           `@sakila | .actor | where(.actor_id == int($id))`,
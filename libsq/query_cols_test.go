@@ -57,6 +57,37 @@ func TestQuery_cols(t *testing.T) {
 			override:     driverMap{mysql.Type: "SELECT `first_name` AS `Given Name`, `last_name` AS `family_name` FROM `actor`"},
 			wantRecCount: sakila.TblActorCount,
 		},
+		{
+			name:         "cols-aliases-func-name",
+			in:           `@sakila | .actor | .first_name:upper, .last_name:count`,
+			wantSQL:      `SELECT "first_name" AS "upper", "last_name" AS "count" FROM "actor"`,
+			override:     driverMap{mysql.Type: "SELECT `first_name` AS `upper`, `last_name` AS `count` FROM `actor`"},
+			wantRecCount: sakila.TblActorCount,
+		},
+		{
+			name:         "cols-aliases-func-name/extract",
+			in:           `@sakila | .payment | .payment_date:extract`,
+			wantSQL:      `SELECT "payment_date" AS "extract" FROM "payment"`,
+			override:     driverMap{mysql.Type: "SELECT `payment_date` AS `extract` FROM `payment`"},
+			wantRecCount: sakila.TblPaymentCount,
+		},
+		{
+			name:         "cols-aliases-keyword",
+			in:           `@sakila | .actor | .first_name:is, .last_name:in`,
+			wantSQL:      `SELECT "first_name" AS "is", "last_name" AS "in" FROM "actor"`,
+			override:     driverMap{mysql.Type: "SELECT `first_name` AS `is`, `last_name` AS `in` FROM `actor`"},
+			wantRecCount: sakila.TblActorCount,
+		},
+		{
+			name:         "cols-aliases-keyword/set-op",
+			in:           `@sakila | .actor | .first_name:union, .last_name:with, .actor_id:over`,
+			wantSQL:      `SELECT "first_name" AS "union", "last_name" AS "with", "actor_id" AS "over" FROM "actor"`,
+			override:     driverMap{mysql.Type: "SELECT `first_name` AS `union`, `last_name` AS `with`, `actor_id` AS `over` FROM `actor`"},
+			wantRecCount: sakila.TblActorCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkColMungedNames("union", "with", "over"),
+			},
+		},
 		{
 			name:         "handle-table/cols",
 			in:           `@sakila.actor | .first_name, .last_name`,
@@ -0,0 +1,105 @@
+package libsq_test
+
+import (
+	"testing"
+
+	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/postgres"
+	"github.com/neilotoole/sq/drivers/sqlserver"
+	"github.com/neilotoole/sq/testh/sakila"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+//nolint:exhaustive,lll
+func TestQuery_conditional(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:         "if-then-else",
+			in:           `@sakila | .film | .film_id, (if .length < 90 then "short" else "long" end):len`,
+			wantSQL:      `SELECT "film_id", (CASE WHEN "length" < 90 THEN 'short' ELSE 'long' END) AS "len" FROM "film"`,
+			override:     driverMap{mysql.Type: "SELECT `film_id`, (CASE WHEN `length` < 90 THEN 'short' ELSE 'long' END) AS `len` FROM `film`"},
+			wantRecCount: sakila.TblFilmCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkColName(1, "len"),
+			},
+		},
+		{
+			name:         "alias-keyword",
+			in:           `@sakila | .film | .film_id:if, (if .length < 90 then .length else 0 end):end`,
+			wantSQL:      `SELECT "film_id" AS "if", (CASE WHEN "length" < 90 THEN "length" ELSE 0 END) AS "end" FROM "film"`,
+			override:     driverMap{mysql.Type: "SELECT `film_id` AS `if`, (CASE WHEN `length` < 90 THEN `length` ELSE 0 END) AS `end` FROM `film`"},
+			wantRecCount: sakila.TblFilmCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkColName(0, "if"),
+				assertSinkColName(1, "end"),
+			},
+		},
+		{
+			name:         "elif",
+			in:           `@sakila | .film | .film_id, if .length < 60 then "short" elif .length < 120 then "medium" else "long" end:len`,
+			wantSQL:      `SELECT "film_id", CASE WHEN "length" < 60 THEN 'short' WHEN "length" < 120 THEN 'medium' ELSE 'long' END AS "len" FROM "film"`,
+			override:     driverMap{mysql.Type: "SELECT `film_id`, CASE WHEN `length` < 60 THEN 'short' WHEN `length` < 120 THEN 'medium' ELSE 'long' END AS `len` FROM `film`"},
+			wantRecCount: sakila.TblFilmCount,
+		},
+		{
+			name:         "no-else",
+			in:           `@sakila | .film | .film_id, if .length < 60 then "short" end:len`,
+			wantSQL:      `SELECT "film_id", CASE WHEN "length" < 60 THEN 'short' END AS "len" FROM "film"`,
+			override:     driverMap{mysql.Type: "SELECT `film_id`, CASE WHEN `length` < 60 THEN 'short' END AS `len` FROM `film`"},
+			wantRecCount: sakila.TblFilmCount,
+		},
+		{
+			name:    "where",
+			in:      `@sakila | .film | where((if .rating == "R" then .length else 0 end) > 120) | .film_id`,
+			wantSQL: `SELECT "film_id" FROM "film" WHERE (CASE WHEN "rating" = ? THEN "length" ELSE 0 END) > 120`,
+			override: driverMap{
+				postgres.Type:  `SELECT "film_id" FROM "film" WHERE (CASE WHEN "rating" = $1 THEN "length" ELSE 0 END) > 120`,
+				mysql.Type:     "SELECT `film_id` FROM `film` WHERE (CASE WHEN `rating` = ? THEN `length` ELSE 0 END) > 120",
+				sqlserver.Type: `SELECT "film_id" FROM "film" WHERE (CASE WHEN "rating" = @p1 THEN "length" ELSE 0 END) > 120`,
+			},
+			wantArgs:     []any{"R"},
+			wantRecCount: 90,
+		},
+		{
+			name:         "func-arg",
+			in:           `@sakila | .payment | .customer_id, sum(if .amount > 5 then 1 else 0 end):n | group_by(.customer_id)`,
+			wantSQL:      `SELECT "customer_id", sum(CASE WHEN "amount" > 5 THEN 1 ELSE 0 END) AS "n" FROM "payment" GROUP BY "customer_id"`,
+			override:     driverMap{mysql.Type: "SELECT `customer_id`, sum(CASE WHEN `amount` > 5 THEN 1 ELSE 0 END) AS `n` FROM `payment` GROUP BY `customer_id`"},
+			wantRecCount: 599,
+		},
+		{
+			name:         "group_by",
+			in:           `@sakila | .film | (if .length < 90 then "short" else "long" end):len, count | group_by(if .length < 90 then "short" else "long" end)`,
+			wantSQL:      `SELECT (CASE WHEN "length" < 90 THEN 'short' ELSE 'long' END) AS "len", count(*) AS "count" FROM "film" GROUP BY CASE WHEN "length" < 90 THEN 'short' ELSE 'long' END`,
+			override:     driverMap{mysql.Type: "SELECT (CASE WHEN `length` < 90 THEN 'short' ELSE 'long' END) AS `len`, count(*) AS `count` FROM `film` GROUP BY CASE WHEN `length` < 90 THEN 'short' ELSE 'long' END"},
+			wantRecCount: 2,
+		},
+		{
+			// The WHERE literal is bound, but the select list and
+			// GROUP BY literals are inlined, so that they match.
+			name:    "group_by/where",
+			in:      `@sakila | .film | where(.rating == "R") | (if .length < 90 then "short" else "long" end):len, count | group_by(if .length < 90 then "short" else "long" end)`,
+			wantSQL: `SELECT (CASE WHEN "length" < 90 THEN 'short' ELSE 'long' END) AS "len", count(*) AS "count" FROM "film" WHERE "rating" = ? GROUP BY CASE WHEN "length" < 90 THEN 'short' ELSE 'long' END`,
+			override: driverMap{
+				postgres.Type:  `SELECT (CASE WHEN "length" < 90 THEN 'short' ELSE 'long' END) AS "len", count(*) AS "count" FROM "film" WHERE "rating" = $1 GROUP BY CASE WHEN "length" < 90 THEN 'short' ELSE 'long' END`,
+				mysql.Type:     "SELECT (CASE WHEN `length` < 90 THEN 'short' ELSE 'long' END) AS `len`, count(*) AS `count` FROM `film` WHERE `rating` = ? GROUP BY CASE WHEN `length` < 90 THEN 'short' ELSE 'long' END",
+				sqlserver.Type: `SELECT (CASE WHEN "length" < 90 THEN 'short' ELSE 'long' END) AS "len", count(*) AS "count" FROM "film" WHERE "rating" = @p1 GROUP BY CASE WHEN "length" < 90 THEN 'short' ELSE 'long' END`,
+			},
+			wantArgs:     []any{"R"},
+			wantRecCount: 2,
+		},
+		{
+			name:    "error/no-end",
+			in:      `@sakila | .film | if .length < 90 then "short" else "long"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
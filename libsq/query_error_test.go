@@ -0,0 +1,105 @@
+package libsq_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/libsq"
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+	"github.com/neilotoole/sq/testh/tutil"
+)
+
+// TestQuery_error verifies that a query that references a source,
+// table or column that doesn't exist returns an *ast.QueryError that
+// identifies the reference, and suggests near matches.
+func TestQuery_error(t *testing.T) {
+	testCases := []struct {
+		in              string
+		wantKind        string
+		wantText        string
+		wantLine        int
+		wantCol         int
+		wantSuggestions []string
+	}{
+		{
+			in:              `@sakila_sl | .actor`,
+			wantKind:        "unknown source",
+			wantText:        "@sakila_sl",
+			wantLine:        1,
+			wantCol:         1,
+			wantSuggestions: []string{sakila.SL3},
+		},
+		{
+			in:              `@sakila_sl3 | .actor | join(@sakila_sl.film_actor, .actor_id)`,
+			wantKind:        "unknown source",
+			wantText:        "join(@sakila_sl.film_actor, .actor_id)",
+			wantLine:        1,
+			wantCol:         24,
+			wantSuggestions: []string{sakila.SL3},
+		},
+		{
+			in:              `@sakila_sl3 | .actr | .first_name`,
+			wantKind:        "unknown table",
+			wantText:        ".actr",
+			wantLine:        1,
+			wantCol:         15,
+			wantSuggestions: []string{"actor"},
+		},
+		{
+			in:              "@sakila_sl3\n| .actor\n| .actor.first_nme, .last_name",
+			wantKind:        "unknown column",
+			wantText:        ".actor.first_nme",
+			wantLine:        3,
+			wantCol:         3,
+			wantSuggestions: []string{"first_name"},
+		},
+		{
+			in:              `@sakila_sl3 | .actor:a | join(.film_actor:fa, .a.actor_id == .fa.actr_id)`,
+			wantKind:        "unknown column",
+			wantText:        ".fa.actr_id",
+			wantLine:        1,
+			wantCol:         62,
+			wantSuggestions: []string{"actor_id"},
+		},
+		{
+			in:              `@sakila_sl3 | .actor | .first_name:given | order_by(.given, .actor.xyz)`,
+			wantKind:        "unknown column",
+			wantText:        ".actor.xyz",
+			wantLine:        1,
+			wantCol:         61,
+			wantSuggestions: []string{},
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(tutil.Name(i, tc.in), func(t *testing.T) {
+			th := testh.New(t)
+			dbases := th.Databases()
+			qc := &libsq.QueryContext{
+				Collection:      th.NewCollection(sakila.SL3),
+				DBOpener:        dbases,
+				JoinDBOpener:    dbases,
+				ScratchDBOpener: dbases,
+			}
+
+			recw := output.NewRecordWriterAdapter(th.Context, &testh.RecordSink{})
+			err := libsq.ExecuteSLQ(th.Context, qc, tc.in, recw)
+			require.Error(t, err)
+			t.Log(err)
+
+			var qErr *ast.QueryError
+			require.True(t, errors.As(err, &qErr))
+			require.Equal(t, tc.wantKind, qErr.Kind)
+			require.Equal(t, tc.wantText, qErr.Text)
+			require.Equal(t, tc.wantLine, qErr.Line)
+			require.Equal(t, tc.wantCol, qErr.Column)
+			require.Equal(t, tc.wantSuggestions, qErr.Suggestions)
+		})
+	}
+}
@@ -5,9 +5,13 @@ import (
 
 	"github.com/neilotoole/sq/testh/tutil"
 
+	"github.com/neilotoole/sq/libsq/source"
 	"github.com/neilotoole/sq/testh/sakila"
 
 	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/postgres"
+	"github.com/neilotoole/sq/drivers/sqlite3"
+	"github.com/neilotoole/sq/drivers/sqlserver"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -16,17 +20,25 @@ import (
 func TestQuery_expr_where(t *testing.T) {
 	testCases := []queryTestCase{
 		{
-			name:         "literal/string",
-			in:           `@sakila | .actor | where(.first_name == "TOM")`,
-			wantSQL:      `SELECT * FROM "actor" WHERE "first_name" = 'TOM'`,
-			override:     driverMap{mysql.Type: "SELECT * FROM `actor` WHERE `first_name` = 'TOM'"},
+			name:    "literal/string",
+			in:      `@sakila | .actor | where(.first_name == "TOM")`,
+			wantSQL: `SELECT * FROM "actor" WHERE "first_name" = ?`,
+			override: driverMap{
+				postgres.Type:  `SELECT * FROM "actor" WHERE "first_name" = $1`,
+				mysql.Type:     "SELECT * FROM `actor` WHERE `first_name` = ?",
+				sqlserver.Type: `SELECT * FROM "actor" WHERE "first_name" = @p1`,
+			},
 			wantRecCount: 2,
 		},
 		{
-			name:         "literal/two-strings",
-			in:           `@sakila | .actor | where(.first_name == "TOM" && .last_name == "MIRANDA")`,
-			wantSQL:      `SELECT * FROM "actor" WHERE "first_name" = 'TOM' AND "last_name" = 'MIRANDA'`,
-			override:     driverMap{mysql.Type: "SELECT * FROM `actor` WHERE `first_name` = 'TOM' AND `last_name` = 'MIRANDA'"},
+			name:    "literal/two-strings",
+			in:      `@sakila | .actor | where(.first_name == "TOM" && .last_name == "MIRANDA")`,
+			wantSQL: `SELECT * FROM "actor" WHERE "first_name" = ? AND "last_name" = ?`,
+			override: driverMap{
+				postgres.Type:  `SELECT * FROM "actor" WHERE "first_name" = $1 AND "last_name" = $2`,
+				mysql.Type:     "SELECT * FROM `actor` WHERE `first_name` = ? AND `last_name` = ?",
+				sqlserver.Type: `SELECT * FROM "actor" WHERE "first_name" = @p1 AND "last_name" = @p2`,
+			},
 			wantRecCount: 1,
 		},
 		{
@@ -164,3 +176,71 @@ func TestQuery_expr_literal(t *testing.T) {
 		})
 	}
 }
+
+//nolint:exhaustive,lll
+func TestQuery_expr_coalesce(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:         "col/chain",
+			in:           `@sakila | .address | .address_id, .address2 // .postal_code // "none":code`,
+			wantSQL:      `SELECT "address_id", coalesce("address2", "postal_code", 'none') AS "code" FROM "address"`,
+			override:     driverMap{mysql.Type: "SELECT `address_id`, coalesce(`address2`, `postal_code`, 'none') AS `code` FROM `address`"},
+			wantRecCount: sakila.TblAddressCount,
+		},
+		{
+			name:         "where",
+			in:           `@sakila | .actor | where((.actor_id // 0) == 1)`,
+			wantSQL:      `SELECT * FROM "actor" WHERE (coalesce("actor_id", 0)) = 1`,
+			override:     driverMap{mysql.Type: "SELECT * FROM `actor` WHERE (coalesce(`actor_id`, 0)) = 1"},
+			wantRecCount: 1,
+		},
+		{
+			// As in jq, "//" binds more loosely than the arithmetic
+			// operators, so this is ".actor_id // (0 + 1)".
+			name:         "precedence/arithmetic",
+			in:           `@sakila | .actor | .actor_id // 0 + 1:id | order_by(.actor_id)`,
+			wantSQL:      `SELECT coalesce("actor_id", 0+1) AS "id" FROM "actor" ORDER BY "actor_id"`,
+			override:     driverMap{mysql.Type: "SELECT coalesce(`actor_id`, 0+1) AS `id` FROM `actor` ORDER BY `actor_id`"},
+			wantRecCount: sakila.TblActorCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(1)),
+			},
+		},
+		{
+			name:         "precedence/comparison",
+			in:           `@sakila | .actor | where(.actor_id > 1 // .actor_id == 1)`,
+			wantSQL:      `SELECT * FROM "actor" WHERE coalesce("actor_id" > 1, "actor_id" = 1)`,
+			override:     driverMap{mysql.Type: "SELECT * FROM `actor` WHERE coalesce(`actor_id` > 1, `actor_id` = 1)"},
+			onlyFor:      []source.DriverType{sqlite3.Type, postgres.Type, mysql.Type},
+			wantRecCount: sakila.TblActorCount - 1,
+		},
+		{
+			name:         "where/parens",
+			in:           `@sakila | .actor | where((.actor_id // 0) + 1 == 2)`,
+			wantSQL:      `SELECT * FROM "actor" WHERE (coalesce("actor_id", 0))+1 = 2`,
+			override:     driverMap{mysql.Type: "SELECT * FROM `actor` WHERE (coalesce(`actor_id`, 0))+1 = 2"},
+			wantRecCount: 1,
+		},
+		{
+			name:         "func",
+			in:           `@sakila | .actor | upper(.first_name // .last_name):name`,
+			wantSQL:      `SELECT upper(coalesce("first_name", "last_name")) AS "name" FROM "actor"`,
+			override:     driverMap{mysql.Type: "SELECT upper(coalesce(`first_name`, `last_name`)) AS `name` FROM `actor`"},
+			wantRecCount: sakila.TblActorCount,
+		},
+		{
+			name:         "nested_parens",
+			in:           `@sakila | .actor | .actor_id // (.actor_id // 0):id`,
+			wantSQL:      `SELECT coalesce("actor_id", (coalesce("actor_id", 0))) AS "id" FROM "actor"`,
+			override:     driverMap{mysql.Type: "SELECT coalesce(`actor_id`, (coalesce(`actor_id`, 0))) AS `id` FROM `actor`"},
+			wantRecCount: sakila.TblActorCount,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(tutil.Name(i, tc.name), func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
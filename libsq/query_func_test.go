@@ -4,11 +4,16 @@ import (
 	"testing"
 
 	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/postgres"
+	"github.com/neilotoole/sq/drivers/sqlite3"
+	"github.com/neilotoole/sq/drivers/sqlserver"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/testh/sakila"
 
 	_ "github.com/mattn/go-sqlite3"
 )
 
-//nolint:exhaustive
+//nolint:exhaustive,lll
 func TestQuery_func(t *testing.T) {
 	testCases := []queryTestCase{
 		{
@@ -22,6 +27,215 @@ func TestQuery_func(t *testing.T) {
 				assertSinkColValue(0, int64(200)),
 			},
 		},
+		{
+			name:    "window/row_number/partition_order",
+			in:      `@sakila | .payment | .payment_id, row_number() over(partition_by(.customer_id), order_by(.payment_date-)):rn`,
+			wantSQL: `SELECT "payment_id", row_number() OVER (PARTITION BY "customer_id" ORDER BY "payment_date" DESC) AS "rn" FROM "payment"`,
+			override: driverMap{
+				mysql.Type: "SELECT `payment_id`, row_number() OVER (PARTITION BY `customer_id` ORDER BY `payment_date` DESC) AS `rn` FROM `payment`",
+			},
+			wantRecCount: sakila.TblPaymentCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkColName(1, "rn"),
+			},
+		},
+		{
+			name:    "window/sum/running_total",
+			in:      `@sakila | .payment | .payment_id, sum(.amount) over(order_by(.payment_date, .payment_id)):total`,
+			wantSQL: `SELECT "payment_id", sum("amount") OVER (ORDER BY "payment_date", "payment_id") AS "total" FROM "payment"`,
+			override: driverMap{
+				mysql.Type: "SELECT `payment_id`, sum(`amount`) OVER (ORDER BY `payment_date`, `payment_id`) AS `total` FROM `payment`",
+			},
+			wantRecCount: sakila.TblPaymentCount,
+		},
+		{
+			name:    "window/lag/partition_only",
+			in:      `@sakila | .payment | lag(.amount) over(partition_by(.customer_id, .staff_id)):prev`,
+			wantSQL: `SELECT lag("amount") OVER (PARTITION BY "customer_id", "staff_id") AS "prev" FROM "payment"`,
+			override: driverMap{
+				mysql.Type:     "SELECT lag(`amount`) OVER (PARTITION BY `customer_id`, `staff_id`) AS `prev` FROM `payment`",
+				sqlserver.Type: `SELECT lag("amount") OVER (PARTITION BY "customer_id", "staff_id" ORDER BY (SELECT NULL)) AS "prev" FROM "payment"`,
+			},
+			wantRecCount: sakila.TblPaymentCount,
+		},
+		{
+			name:    "window/rank/empty_over",
+			in:      `@sakila | .actor | rank() over():rank`,
+			wantSQL: `SELECT rank() OVER () AS "rank" FROM "actor"`,
+			override: driverMap{
+				mysql.Type:     "SELECT rank() OVER () AS `rank` FROM `actor`",
+				sqlserver.Type: `SELECT rank() OVER (ORDER BY (SELECT NULL)) AS "rank" FROM "actor"`,
+			},
+			wantRecCount: sakila.TblActorCount,
+		},
+		{
+			name:    "portable/string",
+			in:      `@sakila | .actor | where(.actor_id == 1) | lower(.first_name):lower, length(.first_name):len, substr(.first_name, 1, 3):sub, replace(.first_name, "E", "e"):rep, concat(.first_name, " ", .last_name):name`,
+			wantSQL: `SELECT lower("first_name") AS "lower", length("first_name") AS "len", substr("first_name", 1, 3) AS "sub", replace("first_name", 'E', 'e') AS "rep", (coalesce("first_name", '') || coalesce(' ', '') || coalesce("last_name", '')) AS "name" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				postgres.Type:  `SELECT lower("first_name") AS "lower", length("first_name") AS "len", substr("first_name", 1, 3) AS "sub", replace("first_name", 'E', 'e') AS "rep", concat(CAST("first_name" AS TEXT), CAST(' ' AS TEXT), CAST("last_name" AS TEXT)) AS "name" FROM "actor" WHERE "actor_id" = 1`,
+				mysql.Type:     "SELECT lower(`first_name`) AS `lower`, char_length(`first_name`) AS `len`, substr(`first_name`, 1, 3) AS `sub`, replace(`first_name`, 'E', 'e') AS `rep`, CONCAT_WS('', `first_name`, ' ', `last_name`) AS `name` FROM `actor` WHERE `actor_id` = 1",
+				sqlserver.Type: `SELECT lower("first_name") AS "lower", len("first_name") AS "len", SUBSTRING("first_name", 1, 3) AS "sub", replace("first_name", 'E', 'e') AS "rep", concat("first_name", ' ', "last_name") AS "name" FROM "actor" WHERE "actor_id" = 1`,
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkColName(0, "lower"),
+				assertSinkColValue(0, "penelope"),
+				assertSinkColValue(2, "PEN"),
+				assertSinkColValue(3, "PeNeLOPe"),
+				assertSinkColValue(4, "PENELOPE GUINESS"),
+			},
+		},
+		{
+			name:         "portable/math",
+			in:           `@sakila | .payment | where(ceil(.amount) >= .amount && floor(.amount) <= .amount && abs(.amount) == .amount) | .payment_id, round(.amount):r`,
+			wantSQL:      `SELECT "payment_id", round("amount") AS "r" FROM "payment" WHERE ceil("amount") >= "amount" AND floor("amount") <= "amount" AND abs("amount") = "amount"`,
+			wantRecCount: sakila.TblPaymentCount,
+			override: driverMap{
+				sqlite3.Type:   `SELECT "payment_id", round("amount") AS "r" FROM "payment" WHERE (CAST("amount" AS INTEGER) + ("amount" > CAST("amount" AS INTEGER))) >= "amount" AND (CAST("amount" AS INTEGER) - ("amount" < CAST("amount" AS INTEGER))) <= "amount" AND abs("amount") = "amount"`,
+				mysql.Type:     "SELECT `payment_id`, round(`amount`) AS `r` FROM `payment` WHERE ceil(`amount`) >= `amount` AND floor(`amount`) <= `amount` AND abs(`amount`) = `amount`",
+				sqlserver.Type: `SELECT "payment_id", round("amount", 0) AS "r" FROM "payment" WHERE ceiling("amount") >= "amount" AND floor("amount") <= "amount" AND abs("amount") = "amount"`,
+			},
+		},
+		{
+			name:         "portable/coalesce",
+			in:           `@sakila | .address | coalesce(.address2, .address):addr, nullif(.district, ""):district`,
+			wantSQL:      `SELECT coalesce("address2", "address") AS "addr", nullif("district", '') AS "district" FROM "address"`,
+			override:     driverMap{mysql.Type: "SELECT coalesce(`address2`, `address`) AS `addr`, nullif(`district`, '') AS `district` FROM `address`"},
+			wantRecCount: sakila.TblAddressCount,
+		},
+		{
+			name:         "portable/extract",
+			in:           `@sakila | .payment | where(extract("month", .payment_date) between 1 and 12) | .payment_id`,
+			wantSQL:      `SELECT "payment_id" FROM "payment" WHERE EXTRACT(MONTH FROM "payment_date") BETWEEN 1 AND 12`,
+			wantRecCount: sakila.TblPaymentCount,
+			override: driverMap{
+				sqlite3.Type:   `SELECT "payment_id" FROM "payment" WHERE CAST(strftime('%m', "payment_date") AS INTEGER) BETWEEN 1 AND 12`,
+				mysql.Type:     "SELECT `payment_id` FROM `payment` WHERE EXTRACT(MONTH FROM `payment_date`) BETWEEN 1 AND 12",
+				sqlserver.Type: `SELECT "payment_id" FROM "payment" WHERE DATEPART(month, "payment_date") BETWEEN 1 AND 12`,
+			},
+		},
+		{
+			name:         "portable/date_trunc",
+			in:           `@sakila | .payment | date_trunc("month", .payment_date):month, count | group_by(date_trunc("month", .payment_date))`,
+			wantSQL:      `SELECT strftime('%Y-%m-01 00:00:00', "payment_date") AS "month", count(*) AS "count" FROM "payment" GROUP BY strftime('%Y-%m-01 00:00:00', "payment_date")`,
+			onlyFor:      []source.DriverType{sqlite3.Type},
+			wantRecCount: 5,
+		},
+		{
+			name:    "portable/now_date_add",
+			in:      `@sakila | .payment | where(.payment_id == 1) | now():now, date_add(.payment_date, 7, "day"):due`,
+			wantSQL: `SELECT now() AS "now", ("payment_date" + 7 * INTERVAL '1 day') AS "due" FROM "payment" WHERE "payment_id" = 1`,
+			override: driverMap{
+				sqlite3.Type:   `SELECT datetime('now') AS "now", datetime("payment_date", 7 || ' days') AS "due" FROM "payment" WHERE "payment_id" = 1`,
+				mysql.Type:     "SELECT now() AS `now`, DATE_ADD(`payment_date`, INTERVAL 7 DAY) AS `due` FROM `payment` WHERE `payment_id` = 1",
+				sqlserver.Type: `SELECT getdate() AS "now", DATEADD(day, 7, "payment_date") AS "due" FROM "payment" WHERE "payment_id" = 1`,
+			},
+			wantRecCount: 1,
+		},
+		{
+			name:    "portable/cast",
+			in:      `@sakila | .payment | where(.payment_id == 1) | cast(.amount, "int"):amount, cast(.customer_id, "text"):customer, cast(.payment_date, "date"):day`,
+			wantSQL: `SELECT CAST("amount" AS INTEGER) AS "amount", CAST("customer_id" AS TEXT) AS "customer", date("payment_date") AS "day" FROM "payment" WHERE "payment_id" = 1`,
+			override: driverMap{
+				postgres.Type:  `SELECT CAST("amount" AS BIGINT) AS "amount", CAST("customer_id" AS TEXT) AS "customer", CAST("payment_date" AS DATE) AS "day" FROM "payment" WHERE "payment_id" = 1`,
+				mysql.Type:     "SELECT CAST(`amount` AS SIGNED) AS `amount`, CAST(`customer_id` AS CHAR) AS `customer`, CAST(`payment_date` AS DATE) AS `day` FROM `payment` WHERE `payment_id` = 1",
+				sqlserver.Type: `SELECT CAST("amount" AS BIGINT) AS "amount", CAST("customer_id" AS NVARCHAR(MAX)) AS "customer", CAST("payment_date" AS DATE) AS "day" FROM "payment" WHERE "payment_id" = 1`,
+			},
+			wantRecCount: 1,
+		},
+		{
+			name:    "portable/cast/where",
+			in:      `@sakila | .payment | where(cast(.payment_date, "date") == "2005-05-25") | .payment_id`,
+			wantSQL: `SELECT "payment_id" FROM "payment" WHERE date("payment_date") = ?`,
+			override: driverMap{
+				postgres.Type:  `SELECT "payment_id" FROM "payment" WHERE CAST("payment_date" AS DATE) = $1`,
+				mysql.Type:     "SELECT `payment_id` FROM `payment` WHERE CAST(`payment_date` AS DATE) = ?",
+				sqlserver.Type: `SELECT "payment_id" FROM "payment" WHERE CAST("payment_date" AS DATE) = @p1`,
+			},
+			wantRecCount: 137,
+		},
+		{
+			name:    "portable/error/cast-kind",
+			in:      `@sakila | .payment | cast(.amount, "money")`,
+			wantErr: true,
+		},
+		{
+			name:    "portable/error/arg-count",
+			in:      `@sakila | .actor | upper(.first_name, .last_name)`,
+			wantErr: true,
+		},
+		{
+			name:    "portable/error/unit",
+			in:      `@sakila | .payment | extract("fortnight", .payment_date)`,
+			wantErr: true,
+		},
+		{
+			name:    "aggregate/stddev_variance",
+			in:      `@sakila | .payment | stddev(.amount), variance(.amount)`,
+			wantSQL: `SELECT stddev_samp("amount") AS "stddev(.amount)", var_samp("amount") AS "variance(.amount)" FROM "payment"`,
+			override: driverMap{
+				mysql.Type:     "SELECT stddev_samp(`amount`) AS `stddev(.amount)`, var_samp(`amount`) AS `variance(.amount)` FROM `payment`",
+				sqlserver.Type: `SELECT stdev("amount") AS "stddev(.amount)", var("amount") AS "variance(.amount)" FROM "payment"`,
+			},
+			wantRecCount: 1,
+		},
+		{
+			name:    "aggregate/median",
+			in:      `@sakila | .payment | median(.amount), percentile_cont(.amount, 0.9):p90`,
+			wantSQL: `SELECT percentile_cont(0.5) WITHIN GROUP (ORDER BY "amount") AS "median(.amount)", percentile_cont(0.9) WITHIN GROUP (ORDER BY "amount") AS "p90" FROM "payment"`,
+			override: driverMap{
+				sqlite3.Type: `SELECT median("amount") AS "median(.amount)", percentile_cont("amount", 0.9) AS "p90" FROM "payment"`,
+			},
+			onlyFor:      []source.DriverType{sqlite3.Type, postgres.Type},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkColName(0, "median(.amount)"),
+			},
+		},
+		{
+			name:         "aggregate/median/window",
+			in:           `@sakila | .payment | .customer_id, median(.amount) over(partition_by(.customer_id)):m`,
+			wantSQL:      `SELECT "customer_id", percentile_cont(0.5) WITHIN GROUP (ORDER BY "amount") OVER (PARTITION BY "customer_id") AS "m" FROM "payment"`,
+			onlyFor:      []source.DriverType{sqlserver.Type},
+			wantRecCount: sakila.TblPaymentCount,
+		},
+		{
+			name:     "aggregate/error/median-mysql",
+			in:       `@sakila | .payment | median(.amount)`,
+			onlyFor:  []source.DriverType{mysql.Type},
+			wantErr:  true,
+			skipExec: true,
+		},
+		{
+			name:    "aggregate/error/fraction",
+			in:      `@sakila | .payment | percentile_cont(.amount, 1.5)`,
+			wantErr: true,
+		},
+		{
+			name:    "aggregate/string_agg",
+			in:      `@sakila | .actor | where(.last_name == "AKROYD") | string_agg(.first_name, "; "):names`,
+			wantSQL: `SELECT group_concat("first_name", '; ') AS "names" FROM "actor" WHERE "last_name" = ?`,
+			override: driverMap{
+				postgres.Type:  `SELECT string_agg(CAST("first_name" AS TEXT), '; ') AS "names" FROM "actor" WHERE "last_name" = $1`,
+				mysql.Type:     "SELECT GROUP_CONCAT(`first_name` SEPARATOR '; ') AS `names` FROM `actor` WHERE `last_name` = ?",
+				sqlserver.Type: `SELECT string_agg(CAST("first_name" AS NVARCHAR(MAX)), '; ') AS "names" FROM "actor" WHERE "last_name" = @p1`,
+			},
+			wantArgs:     []any{"AKROYD"},
+			wantRecCount: 1,
+		},
+		{
+			name:    "aggregate/error/string_agg-sep",
+			in:      `@sakila | .actor | string_agg(.first_name, .last_name)`,
+			wantErr: true,
+		},
+		{
+			name:         "proprietary",
+			in:           `@sakila | .actor | where(.actor_id == 1) | _upper(.first_name):name`,
+			wantSQL:      `SELECT upper("first_name") AS "name" FROM "actor" WHERE "actor_id" = 1`,
+			override:     driverMap{mysql.Type: "SELECT upper(`first_name`) AS `name` FROM `actor` WHERE `actor_id` = 1"},
+			wantRecCount: 1,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -36,6 +36,92 @@ func TestQuery_groupby(t *testing.T) {
 			onlyFor:      []source.DriverType{sqlite3.Type},
 			wantRecCount: 1,
 		},
+		{
+			name:         "group_by/having",
+			in:           `@sakila | .payment | .customer_id, sum(.amount) | group_by(.customer_id) | where(sum(.amount) > 200)`,
+			wantSQL:      `SELECT "customer_id", sum("amount") AS "sum(.amount)" FROM "payment" GROUP BY "customer_id" HAVING sum("amount") > 200`,
+			override:     driverMap{mysql.Type: "SELECT `customer_id`, sum(`amount`) AS `sum(.amount)` FROM `payment` GROUP BY `customer_id` HAVING sum(`amount`) > 200"},
+			wantRecCount: 2,
+		},
+		{
+			name:         "group_by/having_count",
+			in:           `@sakila | .payment | .customer_id, count(.payment_id):n | group_by(.customer_id) | where(count(.payment_id) >= 40)`,
+			wantSQL:      `SELECT "customer_id", count("payment_id") AS "n" FROM "payment" GROUP BY "customer_id" HAVING count("payment_id") >= 40`,
+			override:     driverMap{mysql.Type: "SELECT `customer_id`, count(`payment_id`) AS `n` FROM `payment` GROUP BY `customer_id` HAVING count(`payment_id`) >= 40"},
+			wantRecCount: 7,
+		},
+		{
+			name:         "group_by/where_and_having",
+			in:           `@sakila | .payment | where(.staff_id == 1) | .customer_id, count:n | group_by(.customer_id) | where(count() >= 25 && sum(.amount) > 100)`,
+			wantSQL:      `SELECT "customer_id", count(*) AS "n" FROM "payment" WHERE "staff_id" = 1 GROUP BY "customer_id" HAVING count(*) >= 25 AND sum("amount") > 100`,
+			override:     driverMap{mysql.Type: "SELECT `customer_id`, count(*) AS `n` FROM `payment` WHERE `staff_id` = 1 GROUP BY `customer_id` HAVING count(*) >= 25 AND sum(`amount`) > 100"},
+			wantRecCount: 1,
+		},
+		{
+			name:         "group_by/having_order_by",
+			in:           `@sakila | .payment | .customer_id, sum(.amount):total | group_by(.customer_id) | where(sum(.amount) > 200) | order_by(.customer_id)`,
+			wantSQL:      `SELECT "customer_id", sum("amount") AS "total" FROM "payment" GROUP BY "customer_id" HAVING sum("amount") > 200 ORDER BY "customer_id"`,
+			override:     driverMap{mysql.Type: "SELECT `customer_id`, sum(`amount`) AS `total` FROM `payment` GROUP BY `customer_id` HAVING sum(`amount`) > 200 ORDER BY `customer_id`"},
+			wantRecCount: 2,
+		},
+		{
+			name:    "group_by/rollup",
+			in:      `@sakila | .payment | .customer_id, .staff_id, count:n | group_by(rollup(.customer_id, .staff_id))`,
+			wantSQL: `SELECT "customer_id", "staff_id", count(*) AS "n" FROM "payment" GROUP BY ROLLUP("customer_id", "staff_id")`,
+			override: driverMap{
+				sqlite3.Type: `SELECT "customer_id", "staff_id", count(*) AS "n" FROM "payment" GROUP BY "customer_id", "staff_id" UNION ALL SELECT "customer_id", NULL AS "staff_id", count(*) AS "n" FROM "payment" GROUP BY "customer_id" UNION ALL SELECT NULL AS "customer_id", NULL AS "staff_id", count(*) AS "n" FROM "payment"`,
+				mysql.Type:   "SELECT `customer_id`, `staff_id`, count(*) AS `n` FROM `payment` GROUP BY `customer_id`, `staff_id` WITH ROLLUP",
+			},
+			wantRecCount: 1198 + 599 + 1,
+		},
+		{
+			name:    "group_by/cube",
+			in:      `@sakila | .payment | .customer_id, .staff_id, count:n | group_by(cube(.customer_id, .staff_id))`,
+			wantSQL: `SELECT "customer_id", "staff_id", count(*) AS "n" FROM "payment" GROUP BY CUBE("customer_id", "staff_id")`,
+			override: driverMap{
+				sqlite3.Type: `SELECT "customer_id", "staff_id", count(*) AS "n" FROM "payment" GROUP BY "customer_id", "staff_id" UNION ALL SELECT "customer_id", NULL AS "staff_id", count(*) AS "n" FROM "payment" GROUP BY "customer_id" UNION ALL SELECT NULL AS "customer_id", "staff_id", count(*) AS "n" FROM "payment" GROUP BY "staff_id" UNION ALL SELECT NULL AS "customer_id", NULL AS "staff_id", count(*) AS "n" FROM "payment"`,
+				mysql.Type:   "SELECT `customer_id`, `staff_id`, count(*) AS `n` FROM `payment` GROUP BY `customer_id`, `staff_id` UNION ALL SELECT `customer_id`, NULL AS `staff_id`, count(*) AS `n` FROM `payment` GROUP BY `customer_id` UNION ALL SELECT NULL AS `customer_id`, `staff_id`, count(*) AS `n` FROM `payment` GROUP BY `staff_id` UNION ALL SELECT NULL AS `customer_id`, NULL AS `staff_id`, count(*) AS `n` FROM `payment`",
+			},
+			wantRecCount: 1198 + 599 + 2 + 1,
+		},
+		{
+			name:    "group_by/grouping_sets",
+			in:      `@sakila | .payment | .staff_id, count:n | group_by(grouping_sets(.staff_id, ())) | order_by(.staff_id)`,
+			wantSQL: `SELECT "staff_id", count(*) AS "n" FROM "payment" GROUP BY GROUPING SETS(("staff_id"), ()) ORDER BY "staff_id"`,
+			override: driverMap{
+				sqlite3.Type: `SELECT * FROM (SELECT "staff_id", count(*) AS "n" FROM "payment" GROUP BY "staff_id" UNION ALL SELECT NULL AS "staff_id", count(*) AS "n" FROM "payment") AS "sq_groupings" ORDER BY "staff_id"`,
+				mysql.Type:   "SELECT * FROM (SELECT `staff_id`, count(*) AS `n` FROM `payment` GROUP BY `staff_id` UNION ALL SELECT NULL AS `staff_id`, count(*) AS `n` FROM `payment`) AS `sq_groupings` ORDER BY `staff_id`",
+			},
+			wantRecCount: 3,
+		},
+		{
+			// For SQLite, the ORDER BY of the UNION ALL couldn't
+			// reference an expression of the result columns.
+			name:    "group_by/rollup/order_by_expr",
+			in:      `@sakila | .payment | .staff_id, count:n | group_by(rollup(.staff_id)) | order_by(.staff_id * 1 -)`,
+			wantSQL: `SELECT "staff_id", count(*) AS "n" FROM "payment" GROUP BY ROLLUP("staff_id") ORDER BY "staff_id"*1 DESC`,
+			override: driverMap{
+				sqlite3.Type: `SELECT * FROM (SELECT "staff_id", count(*) AS "n" FROM "payment" GROUP BY "staff_id" UNION ALL SELECT NULL AS "staff_id", count(*) AS "n" FROM "payment") AS "sq_groupings" ORDER BY "staff_id"*1 DESC`,
+				mysql.Type:   "SELECT `staff_id`, count(*) AS `n` FROM `payment` GROUP BY `staff_id` WITH ROLLUP ORDER BY `staff_id`*1 DESC",
+			},
+			wantRecCount: 3,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(2)),
+			},
+		},
+		{
+			name:         "group_by/rollup/having",
+			in:           `@sakila | .payment | .staff_id, count:n | group_by(rollup(.staff_id)) | where(count() > 8000)`,
+			wantRecCount: 2,
+		},
+		{
+			// For SQLite, the where() would be rendered as HAVING in each of
+			// the UNION ALL queries, in which .staff_id wouldn't be null.
+			name:    "group_by/rollup/having_grouping_col",
+			in:      `@sakila | .payment | .staff_id, count:n | group_by(rollup(.staff_id)) | where(.staff_id == null)`,
+			onlyFor: []source.DriverType{sqlite3.Type},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4,22 +4,21 @@ import (
 	"fmt"
 	"testing"
 
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/drivers/mysql"
 	"github.com/neilotoole/sq/drivers/postgres"
 	"github.com/neilotoole/sq/drivers/sqlite3"
 	"github.com/neilotoole/sq/drivers/sqlserver"
-	"github.com/neilotoole/sq/libsq/source"
-
 	"github.com/neilotoole/sq/libsq/core/jointype"
-
-	"github.com/samber/lo"
-
-	"github.com/neilotoole/sq/testh/tutil"
-
+	"github.com/neilotoole/sq/libsq/core/options"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/testh"
 	"github.com/neilotoole/sq/testh/sakila"
-
-	"github.com/neilotoole/sq/drivers/mysql"
-
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/neilotoole/sq/testh/tutil"
 )
 
 func TestQuery_join_args(t *testing.T) {
@@ -225,6 +224,49 @@ func TestQuery_join_multi_source(t *testing.T) {
 				assertSinkColMungedNames("first_name", "last_name", "title"),
 			},
 		},
+		{
+			name: "n3/mixed-join-types",
+			in: fmt.Sprintf(
+				`@sakila | .actor | join(%s.film_actor:fa, .actor.actor_id == .fa.actor_id) | left_join(%s.film:f, .fa.film_id == .f.film_id) | join(%s.language:l, .f.language_id == .l.language_id) | .first_name, .title, .name`,
+				sakila.Pg,
+				sakila.My,
+				sakila.SL3,
+			),
+			wantRecCount: sakila.TblFilmActorCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkColMungedNames("first_name", "title", "name"),
+			},
+		},
+		{
+			name: "n2/push-down",
+			in: fmt.Sprintf(
+				`@sakila | .actor:a | join(%s.film_actor:fa, .a.actor_id == .fa.actor_id) | where(.a.first_name == "PENELOPE" && .fa.film_id > 100) | .a.first_name, .fa.film_id`,
+				sakila.Pg,
+			),
+			wantRecCount: 91,
+			sinkFns: []SinkTestFunc{
+				assertSinkColMungedNames("first_name", "film_id"),
+			},
+		},
+		{
+			// The predicate on the null-supplying side of the
+			// left join must not be pushed down.
+			name: "n2/push-down/left-join",
+			in: fmt.Sprintf(
+				`@sakila | .actor:a | left_join(%s.film_actor:fa, .a.actor_id == .fa.actor_id) | where(.a.first_name == "PENELOPE" && .fa.film_id is null) | .a.first_name, .fa.film_id`,
+				sakila.Pg,
+			),
+			wantRecCount: 0,
+		},
+		{
+			name: "n3/error/duplicate-table-name",
+			in: fmt.Sprintf(
+				`@sakila | .actor | join(%s.film_actor, .actor_id) | join(%s.film_actor, .actor_id)`,
+				sakila.SL3,
+				sakila.Pg,
+			),
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -451,3 +493,30 @@ var (
 		"last_update_1",
 	}
 )
+
+// TestQuery_join_strategy_auto verifies that, when join.strategy is
+// "auto" and the cross-source join is performed in the SQL source,
+// the tables copied into that source are dropped after the query.
+func TestQuery_join_strategy_auto(t *testing.T) {
+	th := testh.New(t)
+	th.Context = options.NewContext(th.Context, options.Options{
+		driver.OptJoinStrategy.Key(): driver.JoinStrategyAuto,
+	})
+
+	src := th.Source(sakila.SL3)
+	_ = th.Source(sakila.CSVActor)
+
+	md, err := th.SourceMetadata(src)
+	require.NoError(t, err)
+	wantTbls := md.TableNames()
+
+	in := fmt.Sprintf(`%s.actor | join(%s.data, .actor.actor_id == .data.actor_id) | .actor.actor_id, .data.first_name`,
+		sakila.SL3, sakila.CSVActor)
+	sink, err := th.QuerySLQ(in, nil)
+	require.NoError(t, err)
+	require.Equal(t, sakila.TblActorCount, len(sink.Recs))
+
+	md, err = th.SourceMetadata(src)
+	require.NoError(t, err)
+	require.Equal(t, wantTbls, md.TableNames())
+}
@@ -3,6 +3,8 @@ package libsq
 import (
 	"fmt"
 
+	"github.com/samber/lo"
+
 	"github.com/neilotoole/sq/libsq/core/loz"
 
 	"github.com/neilotoole/sq/libsq/ast"
@@ -19,15 +21,62 @@ type queryModel struct {
 	Where    *ast.WhereNode
 	OrderBy  *ast.OrderByNode
 	GroupBy  *ast.GroupByNode
+	Having   *ast.HavingNode
 	Distinct *ast.UniqueNode
+	Top      *ast.TopNode
+
+	// SetOps holds any compound SELECT operations (UNION, etc.), in
+	// query order.
+	SetOps []*setOpModel
+
+	// CTEs holds the query's common table expressions (the "WITH"
+	// clause), in query order.
+	CTEs []*ast.CTENode
+
+	// Nested holds the models of the query's subqueries and CTEs,
+	// keyed by the nested query's AST.
+	Nested map[*ast.AST]*queryModel
+}
+
+// setOpModel models a compound SELECT operation, such as UNION.
+type setOpModel struct {
+	Node  *ast.SetOpNode
+	Query *queryModel
 }
 
 func (qm *queryModel) String() string {
 	return fmt.Sprintf("%v | %v  |  %v", qm.Table, qm.Cols, qm.Range)
 }
 
-// buildQueryModel creates a queryModel instance from the AST.
-func buildQueryModel(qc *QueryContext, a *ast.AST) (*queryModel, error) {
+// handles returns the distinct (non-empty) source handles referenced by
+// the query's table and joins, but not by its set operations.
+func (qm *queryModel) handles() []string {
+	if qm.Table == nil {
+		return nil
+	}
+
+	jc := &joinClause{leftTbl: qm.Table, joins: qm.Joins}
+	return jc.handles()
+}
+
+// allHandles is like handles, but also includes the handles
+// referenced by the query's set operations, subqueries and CTEs.
+func (qm *queryModel) allHandles() []string {
+	handles := qm.handles()
+	for _, so := range qm.SetOps {
+		handles = append(handles, so.Query.allHandles()...)
+	}
+	for _, nqm := range qm.Nested {
+		handles = append(handles, nqm.allHandles()...)
+	}
+	return lo.Uniq(handles)
+}
+
+// buildQueryModel creates a queryModel instance from the AST. Arg
+// defaultHandle is used for a table selector that doesn't specify a
+// source. For the top-level query, that's the active source's handle;
+// for a nested query, it's the handle of the enclosing query.
+func buildQueryModel(qc *QueryContext, a *ast.AST, defaultHandle string) (*queryModel, error) {
 	if len(a.Segments()) == 0 {
 		return nil, errz.Errorf("invalid query: no segments")
 	}
@@ -36,20 +85,29 @@ func buildQueryModel(qc *QueryContext, a *ast.AST) (*queryModel, error) {
 		ok   bool
 		err  error
 		insp = ast.NewInspector(a)
-		qm   = &queryModel{AST: a}
+		qm   = &queryModel{AST: a, Nested: map[*ast.AST]*queryModel{}}
 	)
 
 	qm.Table = insp.FindFirstTableSelector()
 	if qm.Table != nil {
 		// If the table selector doesn't specify a handle, set the
-		// table's handle to the active handle.
+		// table's handle to the default handle.
 		if qm.Table.Handle() == "" {
 			// It's possible that there's no active source: this
 			// is effectively a no-op in that case.
-			qm.Table.SetHandle(qc.Collection.ActiveHandle())
+			qm.Table.SetHandle(defaultHandle)
 		}
 	}
 
+	// Nested queries that don't specify a source use the source
+	// of this query.
+	nestedHandle := defaultHandle
+	if qm.Table != nil {
+		nestedHandle = qm.Table.Handle()
+	} else if handle := insp.FindFirstHandle(); handle != "" {
+		nestedHandle = handle
+	}
+
 	if qm.Joins, err = insp.FindJoins(); err != nil {
 		return nil, err
 	}
@@ -96,9 +154,83 @@ func buildQueryModel(qc *QueryContext, a *ast.AST) (*queryModel, error) {
 		return nil, err
 	}
 
+	if qm.Having, err = insp.FindHavingNode(); err != nil {
+		return nil, err
+	}
+
 	if qm.Distinct, err = insp.FindUniqueNode(); err != nil {
 		return nil, err
 	}
 
+	if qm.Top, err = insp.FindTopNode(); err != nil {
+		return nil, err
+	}
+
+	if qm.Top != nil && qm.Distinct != nil {
+		return nil, errz.Errorf("invalid query: top() can't be used with unique: %s", a.Text())
+	}
+
+	for _, node := range insp.FindSetOpNodes() {
+		var sqm *queryModel
+		if sqm, err = buildQueryModel(qc, node.Query(), nestedHandle); err != nil {
+			return nil, err
+		}
+
+		if sqm.Table == nil {
+			return nil, errz.Errorf("invalid query: %s() requires a table selector: %s", node.Op(), node.Text())
+		}
+
+		if sqm.OrderBy != nil || sqm.Range != nil || len(sqm.SetOps) > 0 || len(sqm.CTEs) > 0 {
+			return nil, errz.Errorf(
+				"invalid query: %s() query may not contain order_by, row range, with, or nested set operation: %s",
+				node.Op(), node.Text())
+		}
+
+		qm.SetOps = append(qm.SetOps, &setOpModel{Node: node, Query: sqm})
+	}
+
+	if len(qm.SetOps) > 0 && qm.Table == nil {
+		return nil, errz.Errorf("invalid query: set operation doesn't have a preceding table selector")
+	}
+
+	for _, node := range insp.FindCTENodes() {
+		var nqm *queryModel
+		if nqm, err = buildNestedQueryModel(qc, node.Query(), nestedHandle); err != nil {
+			return nil, err
+		}
+
+		qm.CTEs = append(qm.CTEs, node)
+		qm.Nested[node.Query()] = nqm
+	}
+
+	for _, node := range insp.FindSubqueryNodes() {
+		var nqm *queryModel
+		if nqm, err = buildNestedQueryModel(qc, node.Query(), nestedHandle); err != nil {
+			return nil, err
+		}
+
+		qm.Nested[node.Query()] = nqm
+	}
+
 	return qm, nil
 }
+
+// buildNestedQueryModel builds the model for a nested query, i.e. a
+// subquery or the query of a CTE. A nested query must have a table
+// selector, and can't have set operations.
+func buildNestedQueryModel(qc *QueryContext, a *ast.AST, defaultHandle string) (*queryModel, error) {
+	nqm, err := buildQueryModel(qc, a, defaultHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	if nqm.Table == nil {
+		return nil, errz.Errorf("invalid query: nested query requires a table selector: %s", a.Text())
+	}
+
+	if len(nqm.SetOps) > 0 {
+		return nil, errz.Errorf("invalid query: nested query may not contain a set operation: %s", a.Text())
+	}
+
+	return nqm, nil
+}
@@ -40,7 +40,7 @@ func TestQuery_no_source(t *testing.T) {
 				ScratchDBOpener: dbases,
 			}
 
-			gotSQL, gotErr := libsq.SLQ2SQL(th.Context, qc, tc.in)
+			gotSQL, _, gotErr := libsq.SLQ2SQL(th.Context, qc, tc.in)
 			if tc.wantErr {
 				require.Error(t, gotErr)
 				return
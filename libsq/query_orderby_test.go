@@ -6,11 +6,12 @@ import (
 	"github.com/neilotoole/sq/testh/sakila"
 
 	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/sqlserver"
 
 	_ "github.com/mattn/go-sqlite3"
 )
 
-//nolint:exhaustive
+//nolint:exhaustive,lll
 func TestQuery_orderby(t *testing.T) {
 	testCases := []queryTestCase{
 		{
@@ -55,6 +56,86 @@ func TestQuery_orderby(t *testing.T) {
 			override:     driverMap{mysql.Type: "SELECT * FROM `actor` ORDER BY `first_name`"},
 			wantRecCount: sakila.TblActorCount,
 		},
+		{
+			name:         "order_by/expr",
+			in:           `@sakila | .payment | order_by(.amount * .customer_id -, .payment_id)`,
+			wantSQL:      `SELECT * FROM "payment" ORDER BY "amount"*"customer_id" DESC, "payment_id"`,
+			override:     driverMap{mysql.Type: "SELECT * FROM `payment` ORDER BY `amount`*`customer_id` DESC, `payment_id`"},
+			wantRecCount: sakila.TblPaymentCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(15850)),
+			},
+		},
+		{
+			name:    "order_by/func",
+			in:      `@sakila | .actor | order_by(length(.first_name)-, .actor_id)`,
+			wantSQL: `SELECT * FROM "actor" ORDER BY length("first_name") DESC, "actor_id"`,
+			override: driverMap{
+				mysql.Type:     "SELECT * FROM `actor` ORDER BY char_length(`first_name`) DESC, `actor_id`",
+				sqlserver.Type: `SELECT * FROM "actor" ORDER BY len("first_name") DESC, "actor_id"`,
+			},
+			wantRecCount: sakila.TblActorCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(91)),
+				assertSinkCellValue(0, 1, "CHRISTOPHER"),
+			},
+		},
+		{
+			// The conditional is null for the last few addresses, which,
+			// with nulls_last, must come after the address with id 1.
+			name:    "order_by/nulls_last",
+			in:      `@sakila | .address | order_by((if .address_id > 600 then null else .address_id end) nulls_last, .address_id)`,
+			wantSQL: `SELECT * FROM "address" ORDER BY (CASE WHEN "address_id" > 600 THEN NULL ELSE "address_id" END) NULLS LAST, "address_id"`,
+			override: driverMap{
+				mysql.Type:     "SELECT * FROM `address` ORDER BY CASE WHEN (CASE WHEN `address_id` > 600 THEN NULL ELSE `address_id` END) IS NULL THEN 1 ELSE 0 END, (CASE WHEN `address_id` > 600 THEN NULL ELSE `address_id` END), `address_id`",
+				sqlserver.Type: `SELECT * FROM "address" ORDER BY CASE WHEN (CASE WHEN "address_id" > 600 THEN NULL ELSE "address_id" END) IS NULL THEN 1 ELSE 0 END, (CASE WHEN "address_id" > 600 THEN NULL ELSE "address_id" END), "address_id"`,
+			},
+			wantRecCount: sakila.TblAddressCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(1)),
+			},
+		},
+		{
+			name:    "order_by/desc-nulls_first",
+			in:      `@sakila | .address | order_by((if .address_id > 600 then null else .address_id end)- nulls_first, .address_id)`,
+			wantSQL: `SELECT * FROM "address" ORDER BY (CASE WHEN "address_id" > 600 THEN NULL ELSE "address_id" END) DESC NULLS FIRST, "address_id"`,
+			override: driverMap{
+				mysql.Type:     "SELECT * FROM `address` ORDER BY CASE WHEN (CASE WHEN `address_id` > 600 THEN NULL ELSE `address_id` END) IS NULL THEN 0 ELSE 1 END, (CASE WHEN `address_id` > 600 THEN NULL ELSE `address_id` END) DESC, `address_id`",
+				sqlserver.Type: `SELECT * FROM "address" ORDER BY CASE WHEN (CASE WHEN "address_id" > 600 THEN NULL ELSE "address_id" END) IS NULL THEN 0 ELSE 1 END, (CASE WHEN "address_id" > 600 THEN NULL ELSE "address_id" END) DESC, "address_id"`,
+			},
+			wantRecCount: sakila.TblAddressCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(601)),
+			},
+		},
+		{
+			// The DB orders nulls last for DESC, so there's no emulation.
+			name:    "order_by/desc-nulls_last",
+			in:      `@sakila | .address | order_by((if .address_id > 600 then null else .address_id end)- nulls_last, .address_id)`,
+			wantSQL: `SELECT * FROM "address" ORDER BY (CASE WHEN "address_id" > 600 THEN NULL ELSE "address_id" END) DESC NULLS LAST, "address_id"`,
+			override: driverMap{
+				mysql.Type:     "SELECT * FROM `address` ORDER BY (CASE WHEN `address_id` > 600 THEN NULL ELSE `address_id` END) DESC, `address_id`",
+				sqlserver.Type: `SELECT * FROM "address" ORDER BY (CASE WHEN "address_id" > 600 THEN NULL ELSE "address_id" END) DESC, "address_id"`,
+			},
+			wantRecCount: sakila.TblAddressCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(600)),
+			},
+		},
+		{
+			name:    "order_by/alias-nulls_last",
+			in:      `@sakila | .actor | .first_name:nulls_first, .last_name:nulls_last | order_by(.first_name- nulls_last)`,
+			wantSQL: `SELECT "first_name" AS "nulls_first", "last_name" AS "nulls_last" FROM "actor" ORDER BY "first_name" DESC NULLS LAST`,
+			override: driverMap{
+				mysql.Type:     "SELECT `first_name` AS `nulls_first`, `last_name` AS `nulls_last` FROM `actor` ORDER BY `first_name` DESC",
+				sqlserver.Type: `SELECT "first_name" AS "nulls_first", "last_name" AS "nulls_last" FROM "actor" ORDER BY "first_name" DESC`,
+			},
+			wantRecCount: sakila.TblActorCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkColName(0, "nulls_first"),
+				assertSinkColName(1, "nulls_last"),
+			},
+		},
 		{
 			name:    "order_by/error-no-selector",
 			in:      `@sakila | .actor | order_by()`,
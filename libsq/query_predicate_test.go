@@ -0,0 +1,134 @@
+package libsq_test
+
+import (
+	"testing"
+
+	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/postgres"
+	"github.com/neilotoole/sq/drivers/sqlite3"
+	"github.com/neilotoole/sq/drivers/sqlserver"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/testh/sakila"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+//nolint:exhaustive,lll
+func TestQuery_predicate(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:         "in",
+			in:           `@sakila | .actor | where(.actor_id in [1, 2, 3])`,
+			wantSQL:      `SELECT * FROM "actor" WHERE "actor_id" IN (1, 2, 3)`,
+			override:     driverMap{mysql.Type: "SELECT * FROM `actor` WHERE `actor_id` IN (1, 2, 3)"},
+			wantRecCount: 3,
+		},
+		{
+			name:    "not-in",
+			in:      `@sakila | .actor | where(.first_name not in ["PENELOPE", "NICK"])`,
+			wantSQL: `SELECT * FROM "actor" WHERE "first_name" NOT IN (?, ?)`,
+			override: driverMap{
+				postgres.Type:  `SELECT * FROM "actor" WHERE "first_name" NOT IN ($1, $2)`,
+				mysql.Type:     "SELECT * FROM `actor` WHERE `first_name` NOT IN (?, ?)",
+				sqlserver.Type: `SELECT * FROM "actor" WHERE "first_name" NOT IN (@p1, @p2)`,
+			},
+			wantRecCount: 193,
+		},
+		{
+			name:         "between",
+			in:           `@sakila | .payment | where(.amount between 1 and 2.99) | .payment_id`,
+			wantSQL:      `SELECT "payment_id" FROM "payment" WHERE "amount" BETWEEN 1 AND 2.99`,
+			override:     driverMap{mysql.Type: "SELECT `payment_id` FROM `payment` WHERE `amount` BETWEEN 1 AND 2.99"},
+			wantRecCount: 4183,
+		},
+		{
+			name:    "like",
+			in:      `@sakila | .actor | where(.first_name like "PEN%")`,
+			wantSQL: `SELECT * FROM "actor" WHERE "first_name" LIKE ?`,
+			override: driverMap{
+				postgres.Type:  `SELECT * FROM "actor" WHERE "first_name" LIKE $1`,
+				mysql.Type:     "SELECT * FROM `actor` WHERE `first_name` LIKE ?",
+				sqlserver.Type: `SELECT * FROM "actor" WHERE "first_name" LIKE @p1`,
+			},
+			wantRecCount: 4,
+		},
+		{
+			name:    "ilike",
+			in:      `@sakila | .actor | where(.first_name ilike "pen%")`,
+			wantSQL: `SELECT * FROM "actor" WHERE lower("first_name") LIKE lower(?)`,
+			override: driverMap{
+				postgres.Type:  `SELECT * FROM "actor" WHERE lower("first_name") LIKE lower($1)`,
+				mysql.Type:     "SELECT * FROM `actor` WHERE lower(`first_name`) LIKE lower(?)",
+				sqlserver.Type: `SELECT * FROM "actor" WHERE lower("first_name") LIKE lower(@p1)`,
+			},
+			wantRecCount: 4,
+		},
+		{
+			name:    "not-like-escape",
+			in:      `@sakila | .actor | where(.first_name not like "%\\_%")`,
+			wantSQL: `SELECT * FROM "actor" WHERE "first_name" NOT LIKE ? ESCAPE '\'`,
+			override: driverMap{
+				postgres.Type:  `SELECT * FROM "actor" WHERE "first_name" NOT LIKE $1 ESCAPE '\'`,
+				mysql.Type:     "SELECT * FROM `actor` WHERE `first_name` NOT LIKE ?",
+				sqlserver.Type: `SELECT * FROM "actor" WHERE "first_name" NOT LIKE @p1 ESCAPE '\'`,
+			},
+			wantRecCount: sakila.TblActorCount,
+		},
+		{
+			name:         "is-null",
+			in:           `@sakila | .address | where(.address_id is null)`,
+			wantSQL:      `SELECT * FROM "address" WHERE "address_id" IS NULL`,
+			override:     driverMap{mysql.Type: "SELECT * FROM `address` WHERE `address_id` IS NULL"},
+			wantRecCount: 0,
+		},
+		{
+			name:    "is-not",
+			in:      `@sakila | .address | where(.address2 is not .address)`,
+			wantSQL: `SELECT * FROM "address" WHERE "address2" IS DISTINCT FROM "address"`,
+			override: driverMap{
+				sqlite3.Type:   `SELECT * FROM "address" WHERE "address2" IS NOT "address"`,
+				mysql.Type:     "SELECT * FROM `address` WHERE NOT (`address2` <=> `address`)",
+				sqlserver.Type: `SELECT * FROM "address" WHERE NOT EXISTS (SELECT "address2" INTERSECT SELECT "address")`,
+			},
+			wantRecCount: sakila.TblAddressCount,
+		},
+		{
+			name:         "null-lhs",
+			in:           `@sakila | .actor | where(null != .actor_id)`,
+			wantSQL:      `SELECT * FROM "actor" WHERE "actor_id" IS NOT NULL`,
+			override:     driverMap{mysql.Type: "SELECT * FROM `actor` WHERE `actor_id` IS NOT NULL"},
+			wantRecCount: sakila.TblActorCount,
+		},
+		{
+			name:    "and",
+			in:      `@sakila | .actor | where(.actor_id between 1 and 10 && .first_name in ["PENELOPE", "NICK"])`,
+			wantSQL: `SELECT * FROM "actor" WHERE "actor_id" BETWEEN 1 AND 10 AND "first_name" IN (?, ?)`,
+			override: driverMap{
+				postgres.Type:  `SELECT * FROM "actor" WHERE "actor_id" BETWEEN 1 AND 10 AND "first_name" IN ($1, $2)`,
+				mysql.Type:     "SELECT * FROM `actor` WHERE `actor_id` BETWEEN 1 AND 10 AND `first_name` IN (?, ?)",
+				sqlserver.Type: `SELECT * FROM "actor" WHERE "actor_id" BETWEEN 1 AND 10 AND "first_name" IN (@p1, @p2)`,
+			},
+			wantRecCount: 2,
+		},
+		{
+			name:         "select-col",
+			in:           `@sakila | .actor | .actor_id, (.actor_id in [1, 2]):x`,
+			wantSQL:      `SELECT "actor_id", ("actor_id" IN (1, 2)) AS "x" FROM "actor"`,
+			override:     driverMap{mysql.Type: "SELECT `actor_id`, (`actor_id` IN (1, 2)) AS `x` FROM `actor`"},
+			wantRecCount: sakila.TblActorCount,
+			onlyFor:      []source.DriverType{sqlite3.Type, mysql.Type},
+		},
+		{
+			name:    "error/empty-list",
+			in:      `@sakila | .actor | where(.actor_id in [])`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package libsq_test
+
+import (
+	"testing"
+
+	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/postgres"
+	"github.com/neilotoole/sq/drivers/sqlite3"
+	"github.com/neilotoole/sq/drivers/sqlserver"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/testh/sakila"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+//nolint:exhaustive,lll
+func TestQuery_range(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:    "range/offset-limit",
+			in:      `@sakila | .actor | .actor_id | order_by(.actor_id) | .[2:5]`,
+			wantSQL: `SELECT "actor_id" FROM "actor" ORDER BY "actor_id" LIMIT 3 OFFSET 2`,
+			override: driverMap{
+				mysql.Type:     "SELECT `actor_id` FROM `actor` ORDER BY `actor_id` LIMIT 3 OFFSET 2",
+				sqlserver.Type: `SELECT "actor_id" FROM "actor" ORDER BY "actor_id" OFFSET 2 ROWS FETCH NEXT 3 ROWS ONLY`,
+			},
+			wantRecCount: 3,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, int64(3), int64(4), int64(5))},
+		},
+		{
+			name:    "range/negative/last-n",
+			in:      `@sakila | .actor | .actor_id | order_by(.actor_id) | .[-3:]`,
+			wantSQL: `SELECT "actor_id" FROM "actor" ORDER BY "actor_id" LIMIT 9223372036854775807 OFFSET (SELECT CASE WHEN COUNT(*) > 3 THEN COUNT(*) - 3 ELSE 0 END FROM (SELECT "actor_id" FROM "actor") AS "sq_range")`,
+			override: driverMap{
+				mysql.Type:     "SELECT `actor_id` FROM (SELECT `actor_id`, ROW_NUMBER() OVER (ORDER BY `actor_id`) AS `sq_rn`, COUNT(*) OVER () AS `sq_count` FROM `actor`) AS `sq_range` WHERE `sq_rn` > `sq_count` - 3 ORDER BY `sq_rn`",
+				sqlserver.Type: `SELECT "actor_id" FROM "actor" ORDER BY "actor_id" OFFSET (SELECT CASE WHEN COUNT(*) > 3 THEN COUNT(*) - 3 ELSE 0 END FROM (SELECT "actor_id" FROM "actor") AS "sq_range") ROWS`,
+			},
+			wantRecCount: 3,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, int64(198), int64(199), int64(200))},
+		},
+		{
+			name:         "range/negative/last-row",
+			in:           `@sakila | .actor | .actor_id | order_by(.actor_id) | .[-1]`,
+			wantRecCount: 1,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, int64(sakila.TblActorCount))},
+		},
+		{
+			name:    "range/negative/single-row",
+			in:      `@sakila | .actor | .actor_id | order_by(.actor_id) | .[-3]`,
+			wantSQL: `SELECT "actor_id" FROM "actor" ORDER BY "actor_id" LIMIT (SELECT CASE WHEN CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END > CASE WHEN COUNT(*) > 3 THEN COUNT(*) - 3 ELSE 0 END THEN CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END - CASE WHEN COUNT(*) > 3 THEN COUNT(*) - 3 ELSE 0 END ELSE 0 END FROM (SELECT "actor_id" FROM "actor") AS "sq_range") OFFSET (SELECT CASE WHEN COUNT(*) > 3 THEN COUNT(*) - 3 ELSE 0 END FROM (SELECT "actor_id" FROM "actor") AS "sq_range")`,
+			override: driverMap{
+				mysql.Type:     "SELECT `actor_id` FROM (SELECT `actor_id`, ROW_NUMBER() OVER (ORDER BY `actor_id`) AS `sq_rn`, COUNT(*) OVER () AS `sq_count` FROM `actor`) AS `sq_range` WHERE `sq_rn` > `sq_count` - 3 AND `sq_rn` <= `sq_count` - 2 ORDER BY `sq_rn`",
+				sqlserver.Type: `SELECT "actor_id" FROM "actor" ORDER BY "actor_id" OFFSET (SELECT CASE WHEN CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END > CASE WHEN COUNT(*) > 3 THEN COUNT(*) - 3 ELSE 0 END THEN CASE WHEN COUNT(*) > 3 THEN COUNT(*) - 3 ELSE 0 END ELSE COUNT(*) END FROM (SELECT "actor_id" FROM "actor") AS "sq_range") ROWS FETCH NEXT (SELECT CASE WHEN CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END > CASE WHEN COUNT(*) > 3 THEN COUNT(*) - 3 ELSE 0 END THEN CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END - CASE WHEN COUNT(*) > 3 THEN COUNT(*) - 3 ELSE 0 END ELSE 1 END FROM (SELECT "actor_id" FROM "actor") AS "sq_range") ROWS ONLY`,
+			},
+			wantRecCount: 1,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, int64(198))},
+		},
+		{
+			name:         "range/negative/all-but-last-n",
+			in:           `@sakila | .actor | .actor_id | order_by(.actor_id-) | .[:-197]`,
+			wantRecCount: 3,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, int64(200), int64(199), int64(198))},
+		},
+		{
+			name:         "range/negative/start-and-end",
+			in:           `@sakila | .actor | .actor_id | order_by(.actor_id) | .[-5:-3]`,
+			wantRecCount: 2,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, int64(196), int64(197))},
+		},
+		{
+			name:    "range/negative/positive-start",
+			in:      `@sakila | .actor | .actor_id | order_by(.actor_id) | .[196:-2]`,
+			wantSQL: `SELECT "actor_id" FROM "actor" ORDER BY "actor_id" LIMIT (SELECT CASE WHEN CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END > 196 THEN CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END - 196 ELSE 0 END FROM (SELECT "actor_id" FROM "actor") AS "sq_range") OFFSET 196`,
+			override: driverMap{
+				mysql.Type:     "SELECT `actor_id` FROM (SELECT `actor_id`, ROW_NUMBER() OVER (ORDER BY `actor_id`) AS `sq_rn`, COUNT(*) OVER () AS `sq_count` FROM `actor`) AS `sq_range` WHERE `sq_rn` > 196 AND `sq_rn` <= `sq_count` - 2 ORDER BY `sq_rn`",
+				sqlserver.Type: `SELECT "actor_id" FROM "actor" ORDER BY "actor_id" OFFSET (SELECT CASE WHEN CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END > 196 THEN 196 ELSE COUNT(*) END FROM (SELECT "actor_id" FROM "actor") AS "sq_range") ROWS FETCH NEXT (SELECT CASE WHEN CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END > 196 THEN CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END - 196 ELSE 1 END FROM (SELECT "actor_id" FROM "actor") AS "sq_range") ROWS ONLY`,
+			},
+			wantRecCount: 2,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, int64(197), int64(198))},
+		},
+		{
+			name:         "range/negative/positive-start/no-rows",
+			in:           `@sakila | .actor | where(.actor_id > 1000) | .actor_id | order_by(.actor_id) | .[1:-1]`,
+			wantRecCount: 0,
+		},
+		{
+			name:         "range/negative/positive-end",
+			in:           `@sakila | .actor | .actor_id | order_by(.actor_id) | .[-500:2]`,
+			wantRecCount: 2,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, int64(1), int64(2))},
+		},
+		{
+			name:         "range/negative/empty",
+			in:           `@sakila | .actor | .actor_id | order_by(.actor_id) | .[-2:-5]`,
+			wantRecCount: 0,
+		},
+		{
+			name:         "range/negative/where",
+			in:           `@sakila | .actor | where(.actor_id < 100) | .actor_id | order_by(.actor_id) | .[-2:]`,
+			wantSQL:      `SELECT "actor_id" FROM "actor" WHERE "actor_id" < 100 ORDER BY "actor_id" LIMIT 9223372036854775807 OFFSET (SELECT CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END FROM (SELECT "actor_id" FROM "actor" WHERE "actor_id" < 100) AS "sq_range")`,
+			onlyFor:      []source.DriverType{sqlite3.Type, postgres.Type},
+			wantRecCount: 2,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, int64(98), int64(99))},
+		},
+		{
+			name:         "range/negative/group_by",
+			in:           `@sakila | .payment | .customer_id, count():n | group_by(.customer_id) | order_by(.customer_id) | .[-2:]`,
+			wantRecCount: 2,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, int64(598), int64(599))},
+		},
+		{
+			name:         "range/negative/union",
+			in:           `@sakila | .actor | .first_name | union(.customer | .first_name) | order_by(.first_name) | .[-1]`,
+			wantRecCount: 1,
+		},
+		{
+			name:         "range/negative/cte",
+			in:           `@sakila | with(.a, .actor | where(.actor_id < 10)) | .a | .actor_id | order_by(.actor_id) | .[-2:]`,
+			wantSQL:      `WITH "a" AS (SELECT * FROM "actor" WHERE "actor_id" < 10) SELECT "actor_id" FROM "a" ORDER BY "actor_id" LIMIT 9223372036854775807 OFFSET (SELECT CASE WHEN COUNT(*) > 2 THEN COUNT(*) - 2 ELSE 0 END FROM (SELECT "actor_id" FROM "a") AS "sq_range")`,
+			onlyFor:      []source.DriverType{sqlite3.Type, postgres.Type},
+			wantRecCount: 2,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, int64(8), int64(9))},
+		},
+		{
+			name:         "range/negative/all-cols",
+			in:           `@sakila | .actor | order_by(.actor_id) | .[-3:]`,
+			wantRecCount: 3,
+			sinkFns: []SinkTestFunc{
+				assertSinkColValues(0, int64(198), int64(199), int64(200)),
+				assertSinkColName(3, "last_update"),
+			},
+		},
+		{
+			name:         "range/negative/unique",
+			in:           `@sakila | .actor | .first_name | unique | order_by(.first_name) | .[-2:]`,
+			wantRecCount: 2,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, "WOODY", "ZERO")},
+		},
+		{
+			name:         "range/negative/order_by-not-selected",
+			in:           `@sakila | .actor | .first_name | order_by(.actor_id) | .[-1]`,
+			wantRecCount: 1,
+			sinkFns:      []SinkTestFunc{assertSinkColValues(0, "THORA")},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
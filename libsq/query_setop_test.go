@@ -0,0 +1,163 @@
+package libsq_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/postgres"
+	"github.com/neilotoole/sq/drivers/sqlite3"
+	"github.com/neilotoole/sq/drivers/sqlserver"
+	"github.com/neilotoole/sq/libsq"
+	"github.com/neilotoole/sq/libsq/core/options"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+//nolint:exhaustive,lll
+func TestQuery_setop(t *testing.T) {
+	// Not all MySQL versions support INTERSECT and EXCEPT.
+	noMySQL := []source.DriverType{sqlite3.Type, postgres.Type, sqlserver.Type}
+
+	testCases := []queryTestCase{
+		{
+			name:         "union",
+			in:           `@sakila | .actor | .first_name | union(.customer | .first_name)`,
+			wantSQL:      `SELECT "first_name" FROM "actor" UNION SELECT "first_name" FROM "customer"`,
+			override:     driverMap{mysql.Type: "SELECT `first_name` FROM `actor` UNION SELECT `first_name` FROM `customer`"},
+			wantRecCount: 647,
+		},
+		{
+			name:         "union_all",
+			in:           `@sakila | .actor | .first_name | union_all(.customer | .first_name)`,
+			wantSQL:      `SELECT "first_name" FROM "actor" UNION ALL SELECT "first_name" FROM "customer"`,
+			override:     driverMap{mysql.Type: "SELECT `first_name` FROM `actor` UNION ALL SELECT `first_name` FROM `customer`"},
+			wantRecCount: sakila.TblActorCount + 599,
+		},
+		{
+			name:         "intersect",
+			in:           `@sakila | .actor | .first_name | intersect(.customer | .first_name)`,
+			wantSQL:      `SELECT "first_name" FROM "actor" INTERSECT SELECT "first_name" FROM "customer"`,
+			onlyFor:      noMySQL,
+			wantRecCount: 72,
+		},
+		{
+			name:         "except",
+			in:           `@sakila | .actor | .first_name | except(.customer | .first_name)`,
+			wantSQL:      `SELECT "first_name" FROM "actor" EXCEPT SELECT "first_name" FROM "customer"`,
+			onlyFor:      noMySQL,
+			wantRecCount: 56,
+		},
+		{
+			name:         "union/where/order_by",
+			in:           `@sakila | .actor | where(.actor_id < 10) | .first_name | union(.customer | where(.customer_id < 10) | .first_name) | order_by(.first_name)`,
+			wantSQL:      `SELECT "first_name" FROM "actor" WHERE "actor_id" < 10 UNION SELECT "first_name" FROM "customer" WHERE "customer_id" < 10 ORDER BY "first_name"`,
+			override:     driverMap{mysql.Type: "SELECT `first_name` FROM `actor` WHERE `actor_id` < 10 UNION SELECT `first_name` FROM `customer` WHERE `customer_id` < 10 ORDER BY `first_name`"},
+			wantRecCount: 17,
+		},
+		{
+			name:         "union/multiple",
+			in:           `@sakila | .actor | .first_name | union(.customer | .first_name) | union_all(.staff | .first_name)`,
+			wantSQL:      `SELECT "first_name" FROM "actor" UNION SELECT "first_name" FROM "customer" UNION ALL SELECT "first_name" FROM "staff"`,
+			override:     driverMap{mysql.Type: "SELECT `first_name` FROM `actor` UNION SELECT `first_name` FROM `customer` UNION ALL SELECT `first_name` FROM `staff`"},
+			wantRecCount: 649,
+		},
+		{
+			name:    "error/order_by-in-arg-query",
+			in:      `@sakila | .actor | .first_name | union(.customer | .first_name | order_by(.first_name))`,
+			wantErr: true,
+		},
+		{
+			name:    "error/no-table",
+			in:      `@sakila | union(.customer | .first_name)`,
+			wantErr: true,
+		},
+		{
+			name: "cross-source",
+			in: fmt.Sprintf(
+				`@sakila | .actor | .first_name | union(%s.customer | .first_name) | order_by(.first_name)`,
+				sakila.Pg,
+			),
+			wantSQL:      `SELECT * FROM "setop_0" UNION SELECT * FROM "setop_1" ORDER BY "first_name"`,
+			wantRecCount: 647,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
+
+// TestQuery_setop_cross_source_no_handle verifies that, for a cross-source
+// set operation, a query that doesn't specify a source uses the active
+// source, and that it's an error if there's no active source.
+//
+//nolint:lll
+func TestQuery_setop_cross_source_no_handle(t *testing.T) {
+	const (
+		in      = `.actor | .first_name | union_all(@sakila_sl3.actor | .first_name) | union_all(@sakila_csv_actor.data | .first_name)`
+		wantSQL = `SELECT * FROM "setop_0" UNION ALL SELECT * FROM "setop_1" UNION ALL SELECT * FROM "setop_2"`
+	)
+
+	th := testh.New(t)
+	coll := th.NewCollection(sakila.SL3, sakila.CSVActor)
+	dbases := th.Databases()
+	qc := &libsq.QueryContext{
+		Collection:      coll,
+		DBOpener:        dbases,
+		JoinDBOpener:    dbases,
+		ScratchDBOpener: dbases,
+	}
+
+	_, err := coll.SetActive("", false)
+	require.NoError(t, err)
+	_, _, err = libsq.SLQ2SQL(th.Context, qc, in)
+	require.ErrorContains(t, err, "no active source")
+
+	_, err = coll.SetActive(sakila.SL3, false)
+	require.NoError(t, err)
+	gotSQL, _, err := libsq.SLQ2SQL(th.Context, qc, in)
+	require.NoError(t, err)
+	require.Equal(t, wantSQL, gotSQL)
+}
+
+// TestQuery_setop_cross_source_join_strategy_auto verifies that, when
+// join.strategy is "auto" and the join DB is one of the sources, the
+// component query against that source is performed in place, and that
+// the tables copied into that source are dropped after the query, so
+// that the query can be executed again.
+func TestQuery_setop_cross_source_join_strategy_auto(t *testing.T) {
+	th := testh.New(t)
+	th.Context = options.NewContext(th.Context, options.Options{
+		driver.OptJoinStrategy.Key(): driver.JoinStrategyAuto,
+	})
+
+	src := th.Source(sakila.SL3)
+	_ = th.Source(sakila.CSVActor)
+
+	md, err := th.SourceMetadata(src)
+	require.NoError(t, err)
+	wantTbls := md.TableNames()
+
+	in := fmt.Sprintf(`%s.actor | where(.actor_id <= 3) | .first_name | union(%s.data | .first_name) | order_by(.first_name)`,
+		sakila.SL3, sakila.CSVActor)
+
+	for i := 0; i < 2; i++ {
+		sink, err := th.QuerySLQ(in, nil)
+		require.NoError(t, err)
+		require.Equal(t, 128, len(sink.Recs), "distinct first names")
+
+		md, err = th.SourceMetadata(src)
+		require.NoError(t, err)
+		require.Equal(t, wantTbls, md.TableNames())
+	}
+}
@@ -0,0 +1,89 @@
+package libsq_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/sqlite3"
+	"github.com/neilotoole/sq/drivers/sqlserver"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/testh/sakila"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+//nolint:exhaustive,lll
+func TestQuery_subquery(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:         "in",
+			in:           `@sakila | .customer | where(.customer_id in (.payment | where(.amount > 10) | .customer_id)) | .first_name`,
+			wantSQL:      `SELECT "first_name" FROM "customer" WHERE "customer_id" IN (SELECT "customer_id" FROM "payment" WHERE "amount" > 10)`,
+			override:     driverMap{mysql.Type: "SELECT `first_name` FROM `customer` WHERE `customer_id` IN (SELECT `customer_id` FROM `payment` WHERE `amount` > 10)"},
+			wantRecCount: 107,
+		},
+		{
+			name:         "scalar",
+			in:           `@sakila | .payment | where(.amount > (.payment | avg(.amount))) | .payment_id`,
+			wantSQL:      `SELECT "payment_id" FROM "payment" WHERE "amount" > (SELECT avg("amount") AS "avg(.amount)" FROM "payment")`,
+			override:     driverMap{mysql.Type: "SELECT `payment_id` FROM `payment` WHERE `amount` > (SELECT avg(`amount`) AS `avg(.amount)` FROM `payment`)"},
+			wantRecCount: 7746,
+		},
+		{
+			name: "error/cross-source",
+			in: fmt.Sprintf(
+				`@sakila | .customer | where(.customer_id in (%s.payment | .customer_id))`,
+				sakila.Pg,
+			),
+			onlyFor: []source.DriverType{sqlite3.Type, mysql.Type, sqlserver.Type},
+			wantErr: true,
+		},
+		{
+			name:    "error/no-table",
+			in:      `@sakila | .customer | where(.customer_id in (@sakila | count))`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
+
+//nolint:exhaustive,lll
+func TestQuery_cte(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:         "single",
+			in:           `@sakila | with(.rentals, .rental | .customer_id, count:n | group_by(.customer_id)) | .rentals | where(.n > 40)`,
+			wantSQL:      `WITH "rentals" AS (SELECT "customer_id", count(*) AS "n" FROM "rental" GROUP BY "customer_id") SELECT * FROM "rentals" WHERE "n" > 40`,
+			override:     driverMap{mysql.Type: "WITH `rentals` AS (SELECT `customer_id`, count(*) AS `n` FROM `rental` GROUP BY `customer_id`) SELECT * FROM `rentals` WHERE `n` > 40"},
+			wantRecCount: 5,
+		},
+		{
+			name:         "subquery",
+			in:           `@sakila | with(.big, .payment | where(.amount > 10) | .customer_id) | .customer | where(.customer_id in (.big | .customer_id)) | .first_name`,
+			wantSQL:      `WITH "big" AS (SELECT "customer_id" FROM "payment" WHERE "amount" > 10) SELECT "first_name" FROM "customer" WHERE "customer_id" IN (SELECT "customer_id" FROM "big")`,
+			override:     driverMap{mysql.Type: "WITH `big` AS (SELECT `customer_id` FROM `payment` WHERE `amount` > 10) SELECT `first_name` FROM `customer` WHERE `customer_id` IN (SELECT `customer_id` FROM `big`)"},
+			wantRecCount: 107,
+		},
+		{
+			name:         "multiple",
+			in:           `@sakila | with(.big, .payment | where(.amount > 10)), with(.big_cust, .big | .customer_id) | .customer | where(.customer_id in (.big_cust | .customer_id)) | .first_name`,
+			wantSQL:      `WITH "big" AS (SELECT * FROM "payment" WHERE "amount" > 10), "big_cust" AS (SELECT "customer_id" FROM "big") SELECT "first_name" FROM "customer" WHERE "customer_id" IN (SELECT "customer_id" FROM "big_cust")`,
+			override:     driverMap{mysql.Type: "WITH `big` AS (SELECT * FROM `payment` WHERE `amount` > 10), `big_cust` AS (SELECT `customer_id` FROM `big`) SELECT `first_name` FROM `customer` WHERE `customer_id` IN (SELECT `customer_id` FROM `big_cust`)"},
+			wantRecCount: 107,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
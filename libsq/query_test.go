@@ -54,6 +54,10 @@ type queryTestCase struct {
 	// a separate wantSQL string.
 	override driverMap
 
+	// wantArgs, if non-nil, is the desired args of the bound
+	// parameter placeholders in the returned SQL.
+	wantArgs []any
+
 	// onlyFor indicates that this test should only run on sources of
 	// the specified types. When empty, the test is executed on all types.
 	onlyFor []source.DriverType
@@ -176,20 +180,24 @@ func doExecQueryTestCase(t *testing.T, tc queryTestCase) {
 				Args:            tc.args,
 			}
 
-			gotSQL, gotErr := libsq.SLQ2SQL(th.Context, qc, in)
+			gotSQL, gotArgs, gotErr := libsq.SLQ2SQL(th.Context, qc, in)
 			if tc.wantErr {
 				assert.Error(t, gotErr)
 				t.Logf("ERROR: %v", gotErr)
 				return
 			}
 
-			t.Logf("SQL:\n\n%s\n\n", gotSQL)
+			t.Logf("SQL:\n\n%s\n\nARGS: %v\n\n", gotSQL, gotArgs)
 			require.NoError(t, gotErr)
 
 			if want != "" {
 				require.Equal(t, want, gotSQL)
 			}
 
+			if tc.wantArgs != nil {
+				require.Equal(t, tc.wantArgs, gotArgs)
+			}
+
 			if tc.skipExec {
 				return
 			}
@@ -215,6 +223,28 @@ func assertSinkColValue(colIndex int, val any) SinkTestFunc {
 	}
 }
 
+// assertSinkColValues returns a SinkTestFunc that asserts that
+// the values of column colIndex, in record order, match vals.
+func assertSinkColValues(colIndex int, vals ...any) SinkTestFunc {
+	return func(t testing.TB, sink *testh.RecordSink) {
+		got := make([]any, len(sink.Recs))
+		for rowi, rec := range sink.Recs {
+			got[rowi] = rec[colIndex]
+		}
+		assert.Equal(t, vals, got, "column %d (%s)", colIndex, sink.RecMeta[colIndex].Name())
+	}
+}
+
+// assertSinkCellValue returns a SinkTestFunc that asserts that
+// the column colIndex of record rowIndex matches val.
+func assertSinkCellValue(rowIndex, colIndex int, val any) SinkTestFunc {
+	return func(t testing.TB, sink *testh.RecordSink) {
+		require.Greater(t, len(sink.Recs), rowIndex, "record[%d] doesn't exist", rowIndex)
+		assert.Equal(t, val, sink.Recs[rowIndex][colIndex], "record[%d:%d] (%s)",
+			rowIndex, colIndex, sink.RecMeta[colIndex].Name())
+	}
+}
+
 // assertSinkColValue returns a SinkTestFunc that asserts that
 // the name of column colIndex matches name.
 func assertSinkColName(colIndex int, name string) SinkTestFunc { //nolint:unparam
@@ -0,0 +1,83 @@
+package libsq_test
+
+import (
+	"testing"
+
+	"github.com/neilotoole/sq/testh/sakila"
+
+	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/sqlite3"
+	"github.com/neilotoole/sq/libsq/source"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+//nolint:exhaustive,lll
+func TestQuery_top(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:         "top/by-col",
+			in:           `@sakila | .payment | .customer_id, .amount | top(2, .customer_id) | order_by(.amount-, .payment_id)`,
+			wantSQL:      `SELECT "customer_id", "amount" FROM (SELECT "payment".*, ROW_NUMBER() OVER (PARTITION BY "customer_id" ORDER BY "amount" DESC, "payment_id") AS "sq_rn" FROM "payment") AS "payment" WHERE "sq_rn" <= 2 ORDER BY "customer_id", "amount" DESC, "payment_id"`,
+			override:     driverMap{mysql.Type: "SELECT `customer_id`, `amount` FROM (SELECT `payment`.*, ROW_NUMBER() OVER (PARTITION BY `customer_id` ORDER BY `amount` DESC, `payment_id`) AS `sq_rn` FROM `payment`) AS `payment` WHERE `sq_rn` <= 2 ORDER BY `customer_id`, `amount` DESC, `payment_id`"},
+			wantRecCount: sakila.TblCustomerCount * 2,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(1)),
+				assertSinkCellValue(0, 1, "9.99"),
+				assertSinkCellValue(1, 1, "7.99"),
+				assertSinkCellValue(2, 0, int64(2)),
+			},
+		},
+		{
+			name:         "top/by-col/all-cols",
+			in:           `@sakila | .payment | top(2, .customer_id)`,
+			wantRecCount: sakila.TblCustomerCount * 2,
+			sinkFns: []SinkTestFunc{
+				assertSinkColName(0, "payment_id"),
+			},
+		},
+		{
+			name:         "top/group_by",
+			in:           `@sakila | .payment | .customer_id, .staff_id, sum(.amount):total | group_by(.customer_id, .staff_id) | top(1, .customer_id) | order_by(.total-)`,
+			wantSQL:      `SELECT "customer_id", "staff_id", "total" FROM (SELECT "sq_top".*, ROW_NUMBER() OVER (PARTITION BY "customer_id" ORDER BY "total" DESC) AS "sq_rn" FROM (SELECT "customer_id", "staff_id", sum("amount") AS "total" FROM "payment" GROUP BY "customer_id", "staff_id") AS "sq_top") AS "sq_top" WHERE "sq_rn" = 1 ORDER BY "customer_id", "total" DESC`,
+			override:     driverMap{mysql.Type: "SELECT `customer_id`, `staff_id`, `total` FROM (SELECT `sq_top`.*, ROW_NUMBER() OVER (PARTITION BY `customer_id` ORDER BY `total` DESC) AS `sq_rn` FROM (SELECT `customer_id`, `staff_id`, sum(`amount`) AS `total` FROM `payment` GROUP BY `customer_id`, `staff_id`) AS `sq_top`) AS `sq_top` WHERE `sq_rn` = 1 ORDER BY `customer_id`, `total` DESC"},
+			wantRecCount: sakila.TblCustomerCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(1)),
+				assertSinkCellValue(0, 1, int64(1)),
+			},
+		},
+		{
+			name:         "top/join",
+			in:           `@sakila | .actor:a | join(.film_actor:fa, .a.actor_id == .fa.actor_id) | .a.actor_id, .fa.film_id | top(2, .actor_id) | order_by(.film_id-)`,
+			wantSQL:      `SELECT "actor_id", "film_id" FROM (SELECT "sq_top".*, ROW_NUMBER() OVER (PARTITION BY "actor_id" ORDER BY "film_id" DESC) AS "sq_rn" FROM (SELECT "a"."actor_id", "fa"."film_id" FROM "actor" AS "a" INNER JOIN "film_actor" AS "fa" ON "a"."actor_id" = "fa"."actor_id") AS "sq_top") AS "sq_top" WHERE "sq_rn" <= 2 ORDER BY "actor_id", "film_id" DESC`,
+			override:     driverMap{mysql.Type: "SELECT `actor_id`, `film_id` FROM (SELECT `sq_top`.*, ROW_NUMBER() OVER (PARTITION BY `actor_id` ORDER BY `film_id` DESC) AS `sq_rn` FROM (SELECT `a`.`actor_id`, `fa`.`film_id` FROM `actor` AS `a` INNER JOIN `film_actor` AS `fa` ON `a`.`actor_id` = `fa`.`actor_id`) AS `sq_top`) AS `sq_top` WHERE `sq_rn` <= 2 ORDER BY `actor_id`, `film_id` DESC"},
+			wantRecCount: sakila.TblActorCount * 2,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(1)),
+				assertSinkCellValue(0, 1, int64(980)),
+				assertSinkCellValue(1, 1, int64(970)),
+			},
+		},
+		{
+			// The name of an unaliased count(.amount) column varies by DB.
+			name:    "top/group_by/unaliased",
+			in:      `@sakila | .payment | .customer_id, count(.amount) | group_by(.customer_id) | top(1, .customer_id)`,
+			onlyFor: []source.DriverType{sqlite3.Type},
+			wantErr: true,
+		},
+		{
+			name:    "top/unique",
+			in:      `@sakila | .payment | .customer_id | unique | top(1, .customer_id)`,
+			onlyFor: []source.DriverType{sqlite3.Type},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
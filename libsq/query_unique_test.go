@@ -6,6 +6,9 @@ import (
 	"github.com/neilotoole/sq/testh/sakila"
 
 	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/postgres"
+	"github.com/neilotoole/sq/drivers/sqlite3"
+	"github.com/neilotoole/sq/libsq/source"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -34,6 +37,59 @@ func TestQuery_unique(t *testing.T) {
 			override:     driverMap{mysql.Type: "SELECT DISTINCT * FROM `actor`"},
 			wantRecCount: sakila.TblActorCount,
 		},
+		{
+			name:    "unique/by-col",
+			in:      `@sakila | .payment | .customer_id, .amount | unique(.customer_id)`,
+			wantSQL: `SELECT "customer_id", "amount" FROM (SELECT "payment".*, ROW_NUMBER() OVER (PARTITION BY "customer_id" ORDER BY "customer_id") AS "sq_rn" FROM "payment") AS "payment" WHERE "sq_rn" = 1 ORDER BY "customer_id"`, //nolint:lll
+			override: driverMap{
+				postgres.Type: `SELECT DISTINCT ON ("customer_id") "customer_id", "amount" FROM "payment" ORDER BY "customer_id"`,
+				mysql.Type:    "SELECT `customer_id`, `amount` FROM (SELECT `payment`.*, ROW_NUMBER() OVER (PARTITION BY `customer_id` ORDER BY `customer_id`) AS `sq_rn` FROM `payment`) AS `payment` WHERE `sq_rn` = 1 ORDER BY `customer_id`", //nolint:lll
+			},
+			wantRecCount: sakila.TblCustomerCount,
+		},
+		{
+			name:    "unique/by-col/order_by",
+			in:      `@sakila | .payment | .customer_id, .amount | where(.amount > 0) | unique(.customer_id) | order_by(.amount-, .payment_id)`,
+			wantSQL: `SELECT "customer_id", "amount" FROM (SELECT "payment".*, ROW_NUMBER() OVER (PARTITION BY "customer_id" ORDER BY "amount" DESC, "payment_id") AS "sq_rn" FROM "payment" WHERE "amount" > 0) AS "payment" WHERE "sq_rn" = 1 ORDER BY "customer_id", "amount" DESC, "payment_id"`, //nolint:lll
+			override: driverMap{
+				postgres.Type: `SELECT DISTINCT ON ("customer_id") "customer_id", "amount" FROM "payment" WHERE "amount" > 0 ORDER BY "customer_id", "amount" DESC, "payment_id"`,                                                                                                                              //nolint:lll
+				mysql.Type:    "SELECT `customer_id`, `amount` FROM (SELECT `payment`.*, ROW_NUMBER() OVER (PARTITION BY `customer_id` ORDER BY `amount` DESC, `payment_id`) AS `sq_rn` FROM `payment` WHERE `amount` > 0) AS `payment` WHERE `sq_rn` = 1 ORDER BY `customer_id`, `amount` DESC, `payment_id`", //nolint:lll
+			},
+			wantRecCount: sakila.TblCustomerCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(1)),
+				assertSinkCellValue(0, 1, "9.99"),
+			},
+		},
+		{
+			name:    "unique/by-cols/order_by_desc",
+			in:      `@sakila | .payment | .customer_id, .staff_id | unique(.staff_id, .customer_id) | order_by(.customer_id-, .staff_id)`,
+			wantSQL: `SELECT "customer_id", "staff_id" FROM (SELECT "payment".*, ROW_NUMBER() OVER (PARTITION BY "staff_id", "customer_id" ORDER BY "customer_id" DESC, "staff_id") AS "sq_rn" FROM "payment") AS "payment" WHERE "sq_rn" = 1 ORDER BY "customer_id" DESC, "staff_id"`, //nolint:lll
+			override: driverMap{
+				postgres.Type: `SELECT DISTINCT ON ("staff_id", "customer_id") "customer_id", "staff_id" FROM "payment" ORDER BY "customer_id" DESC, "staff_id"`,                                                                                                                                 //nolint:lll
+				mysql.Type:    "SELECT `customer_id`, `staff_id` FROM (SELECT `payment`.*, ROW_NUMBER() OVER (PARTITION BY `staff_id`, `customer_id` ORDER BY `customer_id` DESC, `staff_id`) AS `sq_rn` FROM `payment`) AS `payment` WHERE `sq_rn` = 1 ORDER BY `customer_id` DESC, `staff_id`", //nolint:lll
+			},
+			wantRecCount: sakila.TblCustomerCount * 2,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(599)),
+			},
+		},
+		{
+			// The columns of "*" are listed explicitly in the rewrite
+			// query, so the SQL varies by the DB's sakila schema.
+			name:         "unique/by-col/all-cols",
+			in:           `@sakila | .payment | unique(.customer_id)`,
+			wantRecCount: sakila.TblCustomerCount,
+			sinkFns: []SinkTestFunc{
+				assertSinkColName(0, "payment_id"),
+			},
+		},
+		{
+			name:    "unique/by-col/group_by",
+			in:      `@sakila | .payment | .customer_id, sum(.amount) | group_by(.customer_id) | unique(.customer_id)`,
+			onlyFor: []source.DriverType{sqlite3.Type},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
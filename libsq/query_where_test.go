@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/postgres"
+	"github.com/neilotoole/sq/drivers/sqlserver"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -74,17 +76,21 @@ func TestQuery_where(t *testing.T) {
 			wantRecCount: 50,
 		},
 		{
-			name:         "where_compound_2",
-			in:           `@sakila | .actor | where(.actor_id >= 100 || (.actor_id < 150 && .first_name == "TOM"))`,
-			wantSQL:      `SELECT * FROM "actor" WHERE "actor_id" >= 100 OR ("actor_id" < 150 AND "first_name" = 'TOM')`,
-			override:     driverMap{mysql.Type: "SELECT * FROM `actor` WHERE `actor_id` >= 100 OR (`actor_id` < 150 AND `first_name` = 'TOM')"},
+			name:    "where_compound_2",
+			in:      `@sakila | .actor | where(.actor_id >= 100 || (.actor_id < 150 && .first_name == "TOM"))`,
+			wantSQL: `SELECT * FROM "actor" WHERE "actor_id" >= 100 OR ("actor_id" < 150 AND "first_name" = ?)`,
+			override: driverMap{
+				postgres.Type:  `SELECT * FROM "actor" WHERE "actor_id" >= 100 OR ("actor_id" < 150 AND "first_name" = $1)`,
+				mysql.Type:     "SELECT * FROM `actor` WHERE `actor_id` >= 100 OR (`actor_id` < 150 AND `first_name` = ?)",
+				sqlserver.Type: `SELECT * FROM "actor" WHERE "actor_id" >= 100 OR ("actor_id" < 150 AND "first_name" = @p1)`,
+			},
 			wantRecCount: 103,
 		},
 		{
 			name:         "where_using_col_alias",
 			in:           `@sakila | .actor | .first_name:given_name | where(.given_name == "TOM")`,
-			wantSQL:      `SELECT "first_name" AS "given_name" FROM "actor" WHERE "given_name" = 'TOM'`,
-			override:     driverMap{mysql.Type: "SELECT `first_name` AS `given_name` FROM `actor` WHERE `given_name` = 'TOM'"},
+			wantSQL:      `SELECT "first_name" AS "given_name" FROM "actor" WHERE "given_name" = ?`,
+			override:     driverMap{mysql.Type: "SELECT `first_name` AS `given_name` FROM `actor` WHERE `given_name` = ?"},
 			wantRecCount: 2,
 			// Skip because this only works on SQLite, not the other SQL databases.
 			// I'm not sure if this will ever be implemented. Perhaps sq could look at
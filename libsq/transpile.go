@@ -0,0 +1,52 @@
+package libsq
+
+import (
+	"context"
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/ast/render"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/driver"
+)
+
+// TranspileSLQ renders the SLQ query as SQL for drvr, using drvr's
+// render.Renderer and dialect.Dialect. Unlike SLQ2SQL, no source is
+// opened (or even looked up), so the query can be rendered for a DB that
+// isn't reachable, or that isn't configured at all. Any source handle in
+// the query is ignored, but the query must not reference more than one
+// source, as a cross-source query can't be performed in a single SQL
+// statement. String literals and args are inlined into the returned SQL,
+// rather than being rendered as bound parameter placeholders.
+func TranspileSLQ(ctx context.Context, drvr driver.SQLDriver, query string, args map[string]string) (string, error) {
+	a, err := ast.Parse(lg.FromContext(ctx), query)
+	if err != nil {
+		return "", err
+	}
+
+	qm, err := buildQueryModel(nil, a, "")
+	if err != nil {
+		return "", err
+	}
+
+	if handles := qm.allHandles(); len(handles) > 1 {
+		return "", errz.Errorf("transpile: cross-source query is not supported: %s",
+			strings.Join(handles, ", "))
+	}
+
+	rc := &render.Context{
+		Renderer: drvr.Renderer(),
+		Args:     args,
+		Dialect:  drvr.Dialect(),
+	}
+
+	frags := &render.Fragments{}
+	if qm.Table != nil {
+		if frags.From, err = renderFrom(rc, qm); err != nil {
+			return "", err
+		}
+	}
+
+	return renderQuery(rc, qm, frags)
+}
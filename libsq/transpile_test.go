@@ -0,0 +1,146 @@
+package libsq_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/postgres"
+	"github.com/neilotoole/sq/drivers/sqlite3"
+	"github.com/neilotoole/sq/drivers/sqlserver"
+	"github.com/neilotoole/sq/libsq"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/testh"
+)
+
+// TestTranspileSLQ verifies that libsq.TranspileSLQ renders SQL for
+// the given driver, without the query's sources being configured.
+func TestTranspileSLQ(t *testing.T) {
+	testCases := []struct {
+		name    string
+		in      string
+		args    map[string]string
+		want    map[source.DriverType]string
+		wantErr bool
+
+		// wantErrContains, if non-empty, is text that the error must contain.
+		wantErrContains string
+	}{
+		{
+			name: "unknown_handle",
+			in:   `@not_configured | .actor | where(.first_name == "TOM") | .actor_id | .[2:5]`,
+			want: map[source.DriverType]string{
+				sqlite3.Type:   `SELECT "actor_id" FROM "actor" WHERE "first_name" = 'TOM' LIMIT 3 OFFSET 2`,
+				postgres.Type:  `SELECT "actor_id" FROM "actor" WHERE "first_name" = 'TOM' LIMIT 3 OFFSET 2`,
+				mysql.Type:     "SELECT `actor_id` FROM `actor` WHERE `first_name` = 'TOM' LIMIT 3 OFFSET 2",
+				sqlserver.Type: `SELECT "actor_id" FROM "actor" WHERE "first_name" = 'TOM' ORDER BY (SELECT 0) OFFSET 2 ROWS FETCH NEXT 3 ROWS ONLY`, //nolint:lll
+			},
+		},
+		{
+			name: "no_handle_args",
+			in:   `.actor | where(.first_name == $first) | union(.customer | where(.first_name == $first))`,
+			args: map[string]string{"first": "TOM"},
+			want: map[source.DriverType]string{
+				postgres.Type: `SELECT * FROM "actor" WHERE "first_name" = 'TOM' UNION SELECT * FROM "customer" WHERE "first_name" = 'TOM'`, //nolint:lll
+			},
+		},
+		{
+			name: "no_table",
+			in:   `1+2`,
+			want: map[source.DriverType]string{
+				postgres.Type: `SELECT 1+2 AS "1+2"`,
+			},
+		},
+		{
+			name:    "cross_source",
+			in:      `@a.actor | join(@b.film_actor, .actor_id)`,
+			want:    map[source.DriverType]string{postgres.Type: ""},
+			wantErr: true,
+		},
+		{
+			// MySQL has no percentile_cont.
+			name:            "median/mysql",
+			in:              `.payment | .customer_id, median(.amount) | group_by(.customer_id)`,
+			want:            map[source.DriverType]string{mysql.Type: ""},
+			wantErr:         true,
+			wantErrContains: "function median: not supported by SQL dialect mysql",
+		},
+		{
+			name:            "percentile_cont/mysql",
+			in:              `.payment | percentile_cont(.amount, 0.9)`,
+			want:            map[source.DriverType]string{mysql.Type: ""},
+			wantErr:         true,
+			wantErrContains: "function percentile_cont: not supported by SQL dialect mysql",
+		},
+		{
+			// SQL Server's PERCENTILE_CONT is only a window function, so
+			// the grouped aggregate form is rejected.
+			name:            "median/sqlserver/group_by",
+			in:              `.payment | .customer_id, median(.amount) | group_by(.customer_id)`,
+			want:            map[source.DriverType]string{sqlserver.Type: ""},
+			wantErr:         true,
+			wantErrContains: "function median: SQL dialect sqlserver supports it only as a window function",
+		},
+		{
+			name: "median/sqlserver/window",
+			in:   `.payment | .customer_id, median(.amount) over(partition_by(.customer_id)):m`,
+			want: map[source.DriverType]string{
+				sqlserver.Type: `SELECT "customer_id", percentile_cont(0.5) WITHIN GROUP (ORDER BY "amount") OVER (PARTITION BY "customer_id") AS "m" FROM "payment"`, //nolint:lll
+			},
+		},
+		{
+			name:            "median/postgres/window",
+			in:              `.payment | .customer_id, median(.amount) over(partition_by(.customer_id)):m`,
+			want:            map[source.DriverType]string{postgres.Type: ""},
+			wantErr:         true,
+			wantErrContains: "function median: SQL dialect postgres doesn't support it as a window function",
+		},
+		{
+			name:            "percentile_cont/postgres/window",
+			in:              `.payment | percentile_cont(.amount, 0.9) over()`,
+			want:            map[source.DriverType]string{postgres.Type: ""},
+			wantErr:         true,
+			wantErrContains: "function percentile_cont: SQL dialect postgres doesn't support it as a window function",
+		},
+		{
+			// The separator's escape sequences are interpreted.
+			name: "string_agg/escape",
+			in:   `.actor | string_agg(.first_name, "\t\""):names`,
+			want: map[source.DriverType]string{
+				sqlite3.Type:  "SELECT group_concat(\"first_name\", '\t\"') AS \"names\" FROM \"actor\"",
+				postgres.Type: "SELECT string_agg(CAST(\"first_name\" AS TEXT), '\t\"') AS \"names\" FROM \"actor\"",
+				mysql.Type:    "SELECT GROUP_CONCAT(`first_name` SEPARATOR '\t\"') AS `names` FROM `actor`",
+			},
+		},
+		{
+			name:    "missing_arg",
+			in:      `.actor | where(.first_name == $first)`,
+			want:    map[source.DriverType]string{postgres.Type: ""},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			th := testh.New(t)
+			for typ, want := range tc.want {
+				drvr, err := th.Registry().SQLDriverFor(typ)
+				require.NoError(t, err)
+
+				got, err := libsq.TranspileSLQ(th.Context, drvr, tc.in, tc.args)
+				if tc.wantErr {
+					require.Error(t, err)
+					if tc.wantErrContains != "" {
+						require.ErrorContains(t, err, tc.wantErrContains)
+					}
+					continue
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, want, got, string(typ))
+			}
+		})
+	}
+}
@@ -127,6 +127,8 @@ const (
 	TblActorCount     = 200
 	TblAddress        = "address"
 	TblAddressCount   = 603
+	TblCustomer       = "customer"
+	TblCustomerCount  = 599
 	TblFilm           = "film"
 	TblFilmCount      = 1000
 	TblFilmActor      = "film_actor"